@@ -0,0 +1,7 @@
+// Package attrcmp contains github.com/google/go-cmp options for comparing
+// framework values, such as types.List and types.Object, in provider tests.
+//
+// Framework value implementations contain unexported fields, so comparing
+// structs containing those values without these options will either panic or
+// only report that the whole value differs.
+package attrcmp
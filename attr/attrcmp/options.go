@@ -0,0 +1,191 @@
+package attrcmp
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Value is the representation of an attr.Value used for comparisons and
+// shown in cmp.Diff output.
+type Value struct {
+	// Type is the string representation of the attr.Type of the value, so
+	// values of different types, such as a types.String and a custom
+	// string type, are reported as different.
+	Type string
+
+	// State is the null, unknown, or known state of the value.
+	State string
+
+	// Data is the underlying data of a known value. Primitive values are
+	// represented as bool, string, or a number string. List, set, and tuple
+	// values are represented as []Value, while map and object values are
+	// represented as map[string]Value. Set elements are sorted, so element
+	// ordering does not affect comparisons.
+	Data any
+
+	// Error is any error which occurred while converting the value.
+	Error string
+}
+
+// Options returns the go-cmp options for comparing attr.Value. Each value is
+// transformed into a Value, based on its type and Terraform value, so that
+// differences within collection and object values are reported individually.
+//
+// Example usage:
+//
+//	if diff := cmp.Diff(got, expected, attrcmp.Options()); diff != "" {
+//		t.Errorf("unexpected difference: %s", diff)
+//	}
+func Options() cmp.Options {
+	return cmp.Options{
+		cmp.Transformer("attr.Value", Transform),
+	}
+}
+
+// Transform returns the Value representation of an attr.Value. A nil value
+// returns a Value with all fields empty.
+func Transform(value attr.Value) Value {
+	if value == nil {
+		return Value{}
+	}
+
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Pointer && v.IsNil() {
+		return Value{}
+	}
+
+	ctx := context.Background()
+	result := Value{
+		Type: typeString(ctx, value),
+	}
+
+	// Zero-value collection and object values are null without a type, so
+	// the Terraform value cannot be created.
+	if value.IsNull() {
+		result.State = attr.ValueStateNull.String()
+
+		return result
+	}
+
+	if value.IsUnknown() {
+		result.State = attr.ValueStateUnknown.String()
+
+		return result
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		result.Error = err.Error()
+
+		return result
+	}
+
+	transformed := transformTerraformValue(tfValue)
+
+	result.State = transformed.State
+	result.Data = transformed.Data
+	result.Error = transformed.Error
+
+	return result
+}
+
+// typeString returns the string representation of the value type. An empty
+// string is returned if the type cannot be represented, such as the missing
+// element type of a zero-value types.List.
+func typeString(ctx context.Context, value attr.Value) (result string) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = ""
+		}
+	}()
+
+	return value.Type(ctx).String()
+}
+
+// transformTerraformValue returns the Value representation of a
+// tftypes.Value. The Type field is set to the tftypes.Type string.
+func transformTerraformValue(value tftypes.Value) Value {
+	result := Value{
+		Type: value.Type().String(),
+	}
+
+	if value.IsNull() {
+		result.State = attr.ValueStateNull.String()
+
+		return result
+	}
+
+	if !value.IsKnown() {
+		result.State = attr.ValueStateUnknown.String()
+
+		return result
+	}
+
+	result.State = attr.ValueStateKnown.String()
+
+	var err error
+
+	switch {
+	case value.Type().Is(tftypes.Bool):
+		var data bool
+
+		err = value.As(&data)
+		result.Data = data
+	case value.Type().Is(tftypes.Number):
+		data := new(big.Float)
+
+		err = value.As(&data)
+		result.Data = data.Text('g', -1)
+	case value.Type().Is(tftypes.String):
+		var data string
+
+		err = value.As(&data)
+		result.Data = data
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		err = value.As(&elements)
+
+		data := make([]Value, 0, len(elements))
+
+		for _, element := range elements {
+			data = append(data, transformTerraformValue(element))
+		}
+
+		if value.Type().Is(tftypes.Set{}) {
+			sort.SliceStable(data, func(i, j int) bool {
+				return fmt.Sprintf("%v", data[i]) < fmt.Sprintf("%v", data[j])
+			})
+		}
+
+		result.Data = data
+	case value.Type().Is(tftypes.Map{}), value.Type().Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		err = value.As(&elements)
+
+		data := make(map[string]Value, len(elements))
+
+		for key, element := range elements {
+			data[key] = transformTerraformValue(element)
+		}
+
+		result.Data = data
+	default:
+		err = fmt.Errorf("unhandled Terraform type: %s", value.Type())
+	}
+
+	if err != nil {
+		result.Data = nil
+		result.Error = err.Error()
+	}
+
+	return result
+}
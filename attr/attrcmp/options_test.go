@@ -0,0 +1,221 @@
+package attrcmp_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/attrcmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOptions(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		Name types.String
+		Tags types.List
+		Any  attr.Value
+	}
+
+	testCases := map[string]struct {
+		x            testModel
+		y            testModel
+		expectedDiff []string
+	}{
+		"equal": {
+			x: testModel{
+				Name: types.StringValue("test"),
+				Tags: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+				Any:  types.Int64Value(1),
+			},
+			y: testModel{
+				Name: types.StringValue("test"),
+				Tags: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+				Any:  types.Int64Value(1),
+			},
+		},
+		"list-element": {
+			x: testModel{
+				Tags: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one"), types.StringValue("two")}),
+			},
+			y: testModel{
+				Tags: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one"), types.StringValue("three")}),
+			},
+			expectedDiff: []string{
+				`-`, `"two"`,
+				`+`, `"three"`,
+			},
+		},
+		"null-unknown": {
+			x: testModel{
+				Name: types.StringNull(),
+			},
+			y: testModel{
+				Name: types.StringUnknown(),
+			},
+			expectedDiff: []string{
+				`-`, `"null"`,
+				`+`, `"unknown"`,
+			},
+		},
+		"nil-interface": {
+			x: testModel{
+				Any: nil,
+			},
+			y: testModel{
+				Any: types.BoolValue(true),
+			},
+			expectedDiff: []string{
+				`bool(true)`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diff := cmp.Diff(testCase.x, testCase.y, attrcmp.Options())
+
+			if len(testCase.expectedDiff) == 0 && diff != "" {
+				t.Fatalf("unexpected difference: %s", diff)
+			}
+
+			if len(testCase.expectedDiff) > 0 && diff == "" {
+				t.Fatal("expected difference, got none")
+			}
+
+			for _, expected := range testCase.expectedDiff {
+				if !strings.Contains(diff, expected) {
+					t.Errorf("expected difference to contain %q, got: %s", expected, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTransform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected attrcmp.Value
+	}{
+		"nil": {
+			value:    nil,
+			expected: attrcmp.Value{},
+		},
+		"zero-value": {
+			value: types.List{},
+			expected: attrcmp.Value{
+				State: "null",
+			},
+		},
+		"nil-pointer": {
+			value:    (*types.String)(nil),
+			expected: attrcmp.Value{},
+		},
+		"bool": {
+			value: types.BoolValue(true),
+			expected: attrcmp.Value{
+				Type:  "basetypes.BoolType",
+				State: "known",
+				Data:  true,
+			},
+		},
+		"number": {
+			value: types.Float64Value(1.5),
+			expected: attrcmp.Value{
+				Type:  "basetypes.Float64Type",
+				State: "known",
+				Data:  "1.5",
+			},
+		},
+		"string-null": {
+			value: types.StringNull(),
+			expected: attrcmp.Value{
+				Type:  "basetypes.StringType",
+				State: "null",
+			},
+		},
+		"string-unknown": {
+			value: types.StringUnknown(),
+			expected: attrcmp.Value{
+				Type:  "basetypes.StringType",
+				State: "unknown",
+			},
+		},
+		"object": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"name": types.StringType,
+				},
+				map[string]attr.Value{
+					"name": types.StringValue("test"),
+				},
+			),
+			expected: attrcmp.Value{
+				Type:  `types.ObjectType["name":basetypes.StringType]`,
+				State: "known",
+				Data: map[string]attrcmp.Value{
+					"name": {
+						Type:  "tftypes.String",
+						State: "known",
+						Data:  "test",
+					},
+				},
+			},
+		},
+		"set-ordering": {
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("two"),
+				types.StringValue("one"),
+			}),
+			expected: attrcmp.Value{
+				Type:  "types.SetType[basetypes.StringType]",
+				State: "known",
+				Data: []attrcmp.Value{
+					{
+						Type:  "tftypes.String",
+						State: "known",
+						Data:  "one",
+					},
+					{
+						Type:  "tftypes.String",
+						State: "known",
+						Data:  "two",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := attrcmp.Transform(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOptionsSetOrdering(t *testing.T) {
+	t.Parallel()
+
+	x := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one"), types.StringValue("two")})
+	y := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("two"), types.StringValue("one")})
+
+	if diff := cmp.Diff(x, y, attrcmp.Options()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,8 @@
+// Package attrgo contains functions for converting framework values, such as
+// types.List and types.Object, to and from generic Go values built from maps,
+// slices, and primitives.
+//
+// Generic Go values are useful for integrating with libraries that have no
+// knowledge of framework types, such as policy engines, template renderers,
+// and JSON patch libraries.
+package attrgo
@@ -0,0 +1,284 @@
+package attrgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// FromGoValue converts the generic Go value into a framework value of the
+// given type. It accepts the values returned by ToGoValue, along with:
+//
+//   - Any Go integer or floating point type, *big.Int, and json.Number for
+//     number values.
+//   - Missing object attributes, which are null.
+//
+// An error diagnostic is returned if the Go value cannot be converted into the
+// type, such as a string for a bool type or an object attribute which is not
+// defined in the type.
+func FromGoValue(ctx context.Context, typ attr.Type, value any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if typ == nil {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"missing type",
+		)
+
+		return nil, diags
+	}
+
+	tfValue, err := goToTerraformValue(tftypes.NewAttributePath(), typ.TerraformType(ctx), value)
+
+	if err != nil {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	result, err := typ.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return result, diags
+}
+
+// goToTerraformValue returns the Terraform value of the generic Go value.
+func goToTerraformValue(tfPath *tftypes.AttributePath, typ tftypes.Type, value any) (tftypes.Value, error) {
+	switch value.(type) {
+	case nil:
+		return tftypes.NewValue(typ, nil), nil
+	case Unknown:
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		if b, ok := value.(bool); ok {
+			return tftypes.NewValue(typ, b), nil
+		}
+	case typ.Is(tftypes.Number):
+		number, err := goToNumber(value)
+
+		if err != nil {
+			return tftypes.Value{}, goValueError(tfPath, err.Error())
+		}
+
+		if number != nil {
+			return tftypes.NewValue(typ, number), nil
+		}
+	case typ.Is(tftypes.String):
+		if s, ok := value.(string); ok {
+			return tftypes.NewValue(typ, s), nil
+		}
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		goElements, ok := value.([]any)
+
+		if !ok {
+			break
+		}
+
+		var elementType tftypes.Type
+
+		switch t := typ.(type) {
+		case tftypes.List:
+			elementType = t.ElementType
+		case tftypes.Set:
+			elementType = t.ElementType
+		}
+
+		elements := make([]tftypes.Value, 0, len(goElements))
+
+		for index, goElement := range goElements {
+			element, err := goToTerraformValue(tfPath.WithElementKeyInt(index), elementType, goElement)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elements = append(elements, element)
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	case typ.Is(tftypes.Tuple{}):
+		goElements, ok := value.([]any)
+
+		if !ok {
+			break
+		}
+
+		elementTypes := typ.(tftypes.Tuple).ElementTypes
+
+		if len(goElements) != len(elementTypes) {
+			return tftypes.Value{}, goValueError(tfPath, fmt.Sprintf("expected %d tuple elements, got %d", len(elementTypes), len(goElements)))
+		}
+
+		elements := make([]tftypes.Value, 0, len(goElements))
+
+		for index, goElement := range goElements {
+			element, err := goToTerraformValue(tfPath.WithElementKeyInt(index), elementTypes[index], goElement)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elements = append(elements, element)
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	case typ.Is(tftypes.Map{}):
+		goElements, ok := value.(map[string]any)
+
+		if !ok {
+			break
+		}
+
+		elementType := typ.(tftypes.Map).ElementType
+		elements := make(map[string]tftypes.Value, len(goElements))
+
+		for key, goElement := range goElements {
+			element, err := goToTerraformValue(tfPath.WithElementKeyString(key), elementType, goElement)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elements[key] = element
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	case typ.Is(tftypes.Object{}):
+		goAttributes, ok := value.(map[string]any)
+
+		if !ok {
+			break
+		}
+
+		attributeTypes := typ.(tftypes.Object).AttributeTypes
+		unexpected := make([]string, 0)
+
+		for name := range goAttributes {
+			if _, ok := attributeTypes[name]; !ok {
+				unexpected = append(unexpected, name)
+			}
+		}
+
+		if len(unexpected) > 0 {
+			sort.Strings(unexpected)
+
+			return tftypes.Value{}, goValueError(tfPath, fmt.Sprintf("unexpected object attributes: %q", unexpected))
+		}
+
+		attributes := make(map[string]tftypes.Value, len(attributeTypes))
+
+		for name, attributeType := range attributeTypes {
+			attribute, err := goToTerraformValue(tfPath.WithAttributeName(name), attributeType, goAttributes[name])
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			attributes[name] = attribute
+		}
+
+		return tftypes.NewValue(typ, attributes), nil
+	default:
+		return tftypes.Value{}, goValueError(tfPath, fmt.Sprintf("unhandled Terraform type: %s", typ))
+	}
+
+	return tftypes.Value{}, goValueError(tfPath, fmt.Sprintf("cannot convert Go value of type %T to %s", value, typ))
+}
+
+// goToNumber returns the Go value as a number. A nil number and no error is
+// returned if the Go value is not a number type.
+func goToNumber(value any) (*big.Float, error) {
+	switch v := value.(type) {
+	case int:
+		return big.NewFloat(0).SetInt64(int64(v)), nil
+	case int8:
+		return big.NewFloat(0).SetInt64(int64(v)), nil
+	case int16:
+		return big.NewFloat(0).SetInt64(int64(v)), nil
+	case int32:
+		return big.NewFloat(0).SetInt64(int64(v)), nil
+	case int64:
+		return big.NewFloat(0).SetInt64(v), nil
+	case uint:
+		return big.NewFloat(0).SetUint64(uint64(v)), nil
+	case uint8:
+		return big.NewFloat(0).SetUint64(uint64(v)), nil
+	case uint16:
+		return big.NewFloat(0).SetUint64(uint64(v)), nil
+	case uint32:
+		return big.NewFloat(0).SetUint64(uint64(v)), nil
+	case uint64:
+		return big.NewFloat(0).SetUint64(v), nil
+	case float32:
+		return goFloatToNumber(float64(v))
+	case float64:
+		return goFloatToNumber(v)
+	case *big.Float:
+		if v == nil {
+			return nil, fmt.Errorf("nil *big.Float")
+		}
+
+		return v, nil
+	case *big.Int:
+		if v == nil {
+			return nil, fmt.Errorf("nil *big.Int")
+		}
+
+		return new(big.Float).SetInt(v), nil
+	case json.Number:
+		number, _, err := big.ParseFloat(string(v), 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse json.Number %q: %w", v, err)
+		}
+
+		return number, nil
+	}
+
+	return nil, nil
+}
+
+// goFloatToNumber returns the float as a number, or an error if the float is
+// not a finite number.
+func goFloatToNumber(f float64) (*big.Float, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("cannot convert non-finite float %v", f)
+	}
+
+	return big.NewFloat(f), nil
+}
+
+// goValueError returns an error with the message, prefixed with the
+// attribute path if not the root of the value.
+func goValueError(tfPath *tftypes.AttributePath, message string) error {
+	if len(tfPath.Steps()) == 0 {
+		return errors.New(message)
+	}
+
+	return fmt.Errorf("%s: %s", tfPath, message)
+}
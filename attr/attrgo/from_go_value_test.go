@@ -0,0 +1,219 @@
+package attrgo_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/attrgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFromGoValue(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+			"tags": types.SetType{ElemType: types.StringType},
+		},
+	}
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		value         any
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"missing-type": {
+			typ:   nil,
+			value: "test",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"missing type",
+				),
+			},
+		},
+		"null": {
+			typ:      types.StringType,
+			value:    nil,
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			typ:      types.ListType{ElemType: types.StringType},
+			value:    attrgo.Unknown{},
+			expected: types.ListUnknown(types.StringType),
+		},
+		"bool": {
+			typ:      types.BoolType,
+			value:    true,
+			expected: types.BoolValue(true),
+		},
+		"int": {
+			typ:      types.Int64Type,
+			value:    123,
+			expected: types.Int64Value(123),
+		},
+		"uint8": {
+			typ:      types.NumberType,
+			value:    uint8(12),
+			expected: types.NumberValue(big.NewFloat(12)),
+		},
+		"float64": {
+			typ:      types.Float64Type,
+			value:    1.5,
+			expected: types.Float64Value(1.5),
+		},
+		"float64-nan": {
+			typ:   types.Float64Type,
+			value: math.NaN(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"cannot convert non-finite float NaN",
+				),
+			},
+		},
+		"json-number": {
+			typ:      types.Int64Type,
+			value:    json.Number("42"),
+			expected: types.Int64Value(42),
+		},
+		"string": {
+			typ:      types.StringType,
+			value:    "test",
+			expected: types.StringValue("test"),
+		},
+		"string-mismatch": {
+			typ:   types.StringType,
+			value: true,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"cannot convert Go value of type bool to tftypes.String",
+				),
+			},
+		},
+		"list": {
+			typ:   types.ListType{ElemType: types.StringType},
+			value: []any{"one", attrgo.Unknown{}, nil},
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringUnknown(),
+				types.StringNull(),
+			}),
+		},
+		"list-element-mismatch": {
+			typ:   types.ListType{ElemType: types.StringType},
+			value: []any{"one", 2},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"ElementKeyInt(1): cannot convert Go value of type int to tftypes.String",
+				),
+			},
+		},
+		"map": {
+			typ:   types.MapType{ElemType: types.Int64Type},
+			value: map[string]any{"key": int64(1)},
+			expected: types.MapValueMust(types.Int64Type, map[string]attr.Value{
+				"key": types.Int64Value(1),
+			}),
+		},
+		"object": {
+			typ: testObjectType,
+			value: map[string]any{
+				"tags": []any{"one"},
+			},
+			expected: types.ObjectValueMust(
+				testObjectType.AttrTypes,
+				map[string]attr.Value{
+					"name": types.StringNull(),
+					"tags": types.SetValueMust(types.StringType, []attr.Value{
+						types.StringValue("one"),
+					}),
+				},
+			),
+		},
+		"object-unexpected-attribute": {
+			typ: testObjectType,
+			value: map[string]any{
+				"name":  "test",
+				"other": "test",
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"unexpected object attributes: [\"other\"]",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := attrgo.FromGoValue(context.Background(), testCase.typ, testCase.value)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got == nil && testCase.expected == nil {
+				return
+			}
+
+			if got == nil || !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFromGoValue_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	expected := types.ObjectValueMust(
+		map[string]attr.Type{
+			"count": types.Int64Type,
+			"tags":  types.MapType{ElemType: types.StringType},
+		},
+		map[string]attr.Value{
+			"count": types.Int64Unknown(),
+			"tags": types.MapValueMust(types.StringType, map[string]attr.Value{
+				"key": types.StringValue("value"),
+			}),
+		},
+	)
+
+	goValue, diags := attrgo.ToGoValue(ctx, expected)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	got, diags := attrgo.FromGoValue(ctx, expected.Type(ctx), goValue)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
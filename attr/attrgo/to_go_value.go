@@ -0,0 +1,154 @@
+package attrgo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ToGoValue converts the framework value into a generic Go value:
+//
+//   - Null values are nil and unknown values are Unknown.
+//   - Bool values are bool and string values are string.
+//   - Number values are int64 if the number is an integer within the int64
+//     range, float64 if the number can be exactly represented as a float64,
+//     otherwise *big.Float.
+//   - List, set, and tuple values are []any. Set element ordering is not
+//     significant.
+//   - Map and object values are map[string]any.
+//
+// Custom value types are converted based on their Terraform value.
+func ToGoValue(ctx context.Context, value attr.Value) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value == nil || value.IsNull() {
+		return nil, diags
+	}
+
+	if value.IsUnknown() {
+		return Unknown{}, diags
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	result, err := terraformToGoValue(tfValue)
+
+	if err != nil {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return result, diags
+}
+
+// terraformToGoValue returns the generic Go value of the Terraform value.
+func terraformToGoValue(value tftypes.Value) (any, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	if !value.IsKnown() {
+		return Unknown{}, nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.Bool):
+		var result bool
+
+		err := value.As(&result)
+
+		return result, err
+	case value.Type().Is(tftypes.Number):
+		number := new(big.Float)
+
+		if err := value.As(&number); err != nil {
+			return nil, err
+		}
+
+		return numberToGoValue(number), nil
+	case value.Type().Is(tftypes.String):
+		var result string
+
+		err := value.As(&result)
+
+		return result, err
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+
+		result := make([]any, 0, len(elements))
+
+		for _, element := range elements {
+			goElement, err := terraformToGoValue(element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, goElement)
+		}
+
+		return result, nil
+	case value.Type().Is(tftypes.Map{}), value.Type().Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+
+		result := make(map[string]any, len(elements))
+
+		for key, element := range elements {
+			goElement, err := terraformToGoValue(element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = goElement
+		}
+
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("unhandled Terraform type: %s", value.Type())
+}
+
+// numberToGoValue returns the number as int64 or float64 where the value can
+// be represented exactly, otherwise the number itself.
+func numberToGoValue(number *big.Float) any {
+	if number.IsInt() {
+		if i, accuracy := number.Int64(); accuracy == big.Exact {
+			return i
+		}
+	}
+
+	if f, accuracy := number.Float64(); accuracy == big.Exact && !math.IsInf(f, 0) {
+		return f
+	}
+
+	return number
+}
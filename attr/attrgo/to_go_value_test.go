@@ -0,0 +1,112 @@
+package attrgo_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/attrgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestToGoValue(t *testing.T) {
+	t.Parallel()
+
+	largeNumber, _, _ := big.ParseFloat("1e400", 10, 512, big.ToNearestEven)
+
+	testCases := map[string]struct {
+		value         attr.Value
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			value:    nil,
+			expected: nil,
+		},
+		"null": {
+			value:    types.StringNull(),
+			expected: nil,
+		},
+		"unknown": {
+			value:    types.StringUnknown(),
+			expected: attrgo.Unknown{},
+		},
+		"bool": {
+			value:    types.BoolValue(true),
+			expected: true,
+		},
+		"int64": {
+			value:    types.Int64Value(123),
+			expected: int64(123),
+		},
+		"float64": {
+			value:    types.Float64Value(1.5),
+			expected: 1.5,
+		},
+		"number-large": {
+			value:    types.NumberValue(largeNumber),
+			expected: largeNumber,
+		},
+		"string": {
+			value:    types.StringValue("test"),
+			expected: "test",
+		},
+		"list": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringUnknown(),
+				types.StringNull(),
+			}),
+			expected: []any{"one", attrgo.Unknown{}, nil},
+		},
+		"set": {
+			value: types.SetValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(1),
+			}),
+			expected: []any{int64(1)},
+		},
+		"map": {
+			value: types.MapValueMust(types.BoolType, map[string]attr.Value{
+				"key": types.BoolValue(false),
+			}),
+			expected: map[string]any{"key": false},
+		},
+		"object": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"name": types.StringType,
+					"tags": types.ListType{ElemType: types.StringType},
+				},
+				map[string]attr.Value{
+					"name": types.StringValue("test"),
+					"tags": types.ListNull(types.StringType),
+				},
+			),
+			expected: map[string]any{
+				"name": "test",
+				"tags": nil,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := attrgo.ToGoValue(context.Background(), testCase.value)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.Comparer(func(x, y *big.Float) bool { return x.Cmp(y) == 0 })); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package attrgo
+
+// Unknown is the generic Go value representation of an unknown value, such as
+// a computed attribute value in a plan that will be known after apply. Null
+// values are represented as nil.
+type Unknown struct{}
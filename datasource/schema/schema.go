@@ -80,6 +80,15 @@ func (s Schema) AttributeAtTerraformPath(ctx context.Context, p *tftypes.Attribu
 	return fwschema.SchemaAttributeAtTerraformPath(ctx, s, p)
 }
 
+// AttributePaths returns the path expressions of all attributes and blocks in
+// the schema, including those nested underneath other attributes and blocks.
+// List, map, and set nesting is represented by the path.Expression type
+// AtAnyListIndex, AtAnyMapKey, and AtAnySetValue methods respectively. The
+// expressions are sorted by their string representation.
+func (s Schema) AttributePaths() path.Expressions {
+	return fwschema.SchemaAttributePaths(s)
+}
+
 // GetAttributes returns the Attributes field value.
 func (s Schema) GetAttributes() map[string]fwschema.Attribute {
 	return schemaAttributes(s.Attributes)
@@ -125,7 +134,9 @@ func (s Schema) TypeAtTerraformPath(ctx context.Context, p *tftypes.AttributePat
 	return fwschema.SchemaTypeAtTerraformPath(ctx, s, p)
 }
 
-// Validate verifies that the schema is not using a reserved field name for a top-level attribute.
+// Validate verifies that the schema is not using a reserved field name for a
+// top-level attribute and that all attributes are correctly implemented, such
+// as enabling one of Required, Optional, or Computed.
 func (s Schema) Validate() diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -154,6 +165,8 @@ func (s Schema) Validate() diag.Diagnostics {
 		d := validateAttributeFieldName(path.Root(k), k, v)
 
 		diags.Append(d...)
+
+		diags.Append(fwschema.ValidateAttributeImplementation(path.Root(k), v)...)
 	}
 
 	blocks := s.GetBlocks()
@@ -170,6 +183,8 @@ func (s Schema) Validate() diag.Diagnostics {
 		d := validateBlockFieldName(path.Root(k), k, v)
 
 		diags.Append(d...)
+
+		diags.Append(fwschema.ValidateBlockImplementation(path.Root(k), v)...)
 	}
 
 	return diags
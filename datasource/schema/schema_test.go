@@ -419,6 +419,64 @@ func TestSchemaAttributeAtTerraformPath(t *testing.T) {
 	}
 }
 
+func TestSchemaAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected path.Expressions
+	}{
+		"no-attributes": {
+			schema:   schema.Schema{},
+			expected: nil,
+		},
+		"attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"nested": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"testattr": schema.StringAttribute{},
+							},
+						},
+					},
+					"testattr": schema.StringAttribute{},
+				},
+				Blocks: map[string]schema.Block{
+					"block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"testattr": schema.StringAttribute{},
+							},
+						},
+					},
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("block"),
+				path.MatchRoot("block").AtAnyListIndex().AtName("testattr"),
+				path.MatchRoot("nested"),
+				path.MatchRoot("nested").AtAnyListIndex().AtName("testattr"),
+				path.MatchRoot("testattr"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.AttributePaths()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSchemaGetAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -1010,7 +1068,9 @@ func TestSchemaValidate(t *testing.T) {
 		"attribute-using-reserved-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1039,8 +1099,11 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"depends_on": schema.BoolAttribute{},
+							"depends_on": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1051,7 +1114,9 @@ func TestSchemaValidate(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"connection": schema.BoolAttribute{},
+							"connection": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1061,9 +1126,12 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"list_nested_attribute": schema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"depends_on": schema.Int64Attribute{},
+								"depends_on": schema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1076,7 +1144,9 @@ func TestSchemaValidate(t *testing.T) {
 					"list_nested_block": schema.ListNestedBlock{
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
-								"connection": schema.BoolAttribute{},
+								"connection": schema.BoolAttribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1086,7 +1156,9 @@ func TestSchemaValidate(t *testing.T) {
 		"attribute-and-blocks-using-reserved-field-names": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 				Blocks: map[string]schema.Block{
 					"connection": schema.ListNestedBlock{},
@@ -1108,7 +1180,9 @@ func TestSchemaValidate(t *testing.T) {
 		"attribute-using-invalid-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"^": schema.StringAttribute{},
+					"^": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1137,8 +1211,11 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1156,7 +1233,9 @@ func TestSchemaValidate(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1173,8 +1252,11 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"$": schema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1197,7 +1279,9 @@ func TestSchemaValidate(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"$": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1222,7 +1306,9 @@ func TestSchemaValidate(t *testing.T) {
 						Blocks: map[string]schema.Block{
 							"^": schema.SingleNestedBlock{
 								Attributes: map[string]schema.Attribute{
-									"!": schema.BoolAttribute{},
+									"!": schema.BoolAttribute{
+										Optional: true,
+									},
 								},
 							},
 						},
@@ -1251,9 +1337,12 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"list_nested_attribute": schema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"^": schema.Int64Attribute{},
+								"^": schema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1273,7 +1362,9 @@ func TestSchemaValidate(t *testing.T) {
 					"list_nested_block": schema.ListNestedBlock{
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
-								"^": schema.Int64Attribute{},
+								"^": schema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1291,9 +1382,12 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"$": schema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"^": schema.Int64Attribute{},
+								"^": schema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1318,7 +1412,9 @@ func TestSchemaValidate(t *testing.T) {
 					"$": schema.ListNestedBlock{
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
-								"^": schema.Int64Attribute{},
+								"^": schema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1345,7 +1441,9 @@ func TestSchemaValidate(t *testing.T) {
 							Blocks: map[string]schema.Block{
 								"^": schema.SingleNestedBlock{
 									Attributes: map[string]schema.Attribute{
-										"!": schema.BoolAttribute{},
+										"!": schema.BoolAttribute{
+											Optional: true,
+										},
 									},
 								},
 							},
@@ -48,3 +48,17 @@ type DiagnosticWithPath interface {
 	// supporting implementations such as Terraform CLI commands.
 	Path() path.Path
 }
+
+// DiagnosticWithCode is a diagnostic associated with a stable, machine
+// readable code, such as "EXAMPLE_NOT_FOUND".
+//
+// Since the protocol has no dedicated field for codes, the code is also
+// carried in the Detail of the diagnostic, so automation wrapping Terraform
+// can branch on specific provider failures. Use the Code() function to
+// retrieve the code of any diagnostic.
+type DiagnosticWithCode interface {
+	Diagnostic
+
+	// Code is the stable, machine readable code for the diagnostic.
+	Code() string
+}
@@ -76,6 +76,24 @@ func (diags Diagnostics) Equal(other Diagnostics) bool {
 	return true
 }
 
+// HasCode returns true if the collection has a Diagnostic with the given
+// code. Refer to the Code function for details about how codes are determined.
+// An empty code always returns false, as diagnostics without a code are not
+// considered to have the empty code.
+func (diags Diagnostics) HasCode(code string) bool {
+	if code == "" {
+		return false
+	}
+
+	for _, diag := range diags {
+		if Code(diag) == code {
+			return true
+		}
+	}
+
+	return false
+}
+
 // HasError returns true if the collection has an error severity Diagnostic.
 func (diags Diagnostics) HasError() bool {
 	for _, diag := range diags {
@@ -122,3 +140,22 @@ func (diags Diagnostics) Warnings() Diagnostics {
 
 	return dd
 }
+
+// WithCode returns all the Diagnostic in Diagnostics that have the given
+// code. Refer to the Code function for details about how codes are
+// determined. An empty code always returns an empty collection.
+func (diags Diagnostics) WithCode(code string) Diagnostics {
+	dd := Diagnostics{}
+
+	if code == "" {
+		return dd
+	}
+
+	for _, d := range diags {
+		if Code(d) == code {
+			dd = append(dd, d)
+		}
+	}
+
+	return dd
+}
@@ -599,6 +599,66 @@ func TestDiagnosticsEqual(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsHasCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		code     string
+		expected bool
+	}{
+		"matching-basic": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("one summary", "one detail"),
+				diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("two summary", "two detail")),
+			},
+			code:     "TEST_CODE",
+			expected: true,
+		},
+		"matching-attribute-path": {
+			diags: diag.Diagnostics{
+				diag.WithCode("TEST_CODE", diag.NewAttributeErrorDiagnostic(path.Root("error"), "one summary", "one detail")),
+			},
+			code:     "TEST_CODE",
+			expected: true,
+		},
+		"nil-diagnostics": {
+			diags:    nil,
+			code:     "TEST_CODE",
+			expected: false,
+		},
+		"different-code": {
+			diags: diag.Diagnostics{
+				diag.WithCode("OTHER_CODE", diag.NewErrorDiagnostic("one summary", "one detail")),
+				diag.NewErrorDiagnostic("two summary", "two detail"),
+			},
+			code:     "TEST_CODE",
+			expected: false,
+		},
+		"empty-code": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("two summary", "two detail")),
+			},
+			code:     "",
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diags.HasCode(tc.code)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsHasError(t *testing.T) {
 	t.Parallel()
 
@@ -822,3 +882,59 @@ func TestDiagnosticsWarnings(t *testing.T) {
 		})
 	}
 }
+
+func TestDiagnosticsWithCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		code     string
+		expected diag.Diagnostics
+	}{
+		"matching": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("two summary", "two detail")),
+				diag.WithCode("OTHER_CODE", diag.NewWarningDiagnostic("three summary", "three detail")),
+				diag.WithCode("TEST_CODE", diag.NewWarningDiagnostic("four summary", "four detail")),
+			},
+			code: "TEST_CODE",
+			expected: diag.Diagnostics{
+				diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("two summary", "two detail")),
+				diag.WithCode("TEST_CODE", diag.NewWarningDiagnostic("four summary", "four detail")),
+			},
+		},
+		"nil-diagnostics": {
+			diags:    nil,
+			code:     "TEST_CODE",
+			expected: diag.Diagnostics{},
+		},
+		"no-match": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+			code:     "TEST_CODE",
+			expected: diag.Diagnostics{},
+		},
+		"empty-code": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+			code:     "",
+			expected: diag.Diagnostics{},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diags.WithCode(tc.code)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
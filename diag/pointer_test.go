@@ -0,0 +1,5 @@
+package diag_test
+
+func pointer[T any](value T) *T {
+	return &value
+}
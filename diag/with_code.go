@@ -0,0 +1,103 @@
+package diag
+
+import (
+	"strings"
+)
+
+// CodeDetailPrefix is the prefix of the line appended to the Detail of
+// diagnostics created with WithCode(), which carries the code to Terraform.
+// The full line is the prefix followed by the code, for example:
+//
+//	Error Code: EXAMPLE_NOT_FOUND
+const CodeDetailPrefix = "Error Code: "
+
+var _ DiagnosticWithCode = withCode{}
+
+// withCode wraps a diagnostic with a code.
+type withCode struct {
+	Diagnostic
+
+	code string
+}
+
+// Code returns the diagnostic code.
+func (d withCode) Code() string {
+	return d.code
+}
+
+// Detail returns the diagnostic detail, with the code appended as a separate
+// line.
+func (d withCode) Detail() string {
+	codeLine := CodeDetailPrefix + d.code
+
+	if d.Diagnostic == nil || d.Diagnostic.Detail() == "" {
+		return codeLine
+	}
+
+	return d.Diagnostic.Detail() + "\n\n" + codeLine
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d withCode) Equal(other Diagnostic) bool {
+	o, ok := other.(withCode)
+
+	if !ok {
+		return false
+	}
+
+	if d.code != o.code {
+		return false
+	}
+
+	if d.Diagnostic == nil {
+		return d.Diagnostic == o.Diagnostic
+	}
+
+	return d.Diagnostic.Equal(o.Diagnostic)
+}
+
+// WithCode wraps a diagnostic with a code or overwrites the code. Any path
+// information of the diagnostic is preserved.
+func WithCode(code string, d Diagnostic) Diagnostic {
+	switch wd := d.(type) {
+	case withCode:
+		wd.code = code
+
+		return wd
+	case withPath:
+		wd.Diagnostic = WithCode(code, wd.Diagnostic)
+
+		return wd
+	}
+
+	return withCode{
+		Diagnostic: d,
+		code:       code,
+	}
+}
+
+// Code returns the code of the diagnostic, if any. Diagnostics implementing
+// DiagnosticWithCode return their code, otherwise the code is parsed from the
+// last line of the Detail beginning with CodeDetailPrefix. This enables codes
+// to be recovered after diagnostics are recreated from their summary and
+// detail, such as by diagnostic formatting. An empty string is returned if no
+// code is found.
+func Code(d Diagnostic) string {
+	if d == nil {
+		return ""
+	}
+
+	if dc, ok := d.(DiagnosticWithCode); ok {
+		return dc.Code()
+	}
+
+	lines := strings.Split(d.Detail(), "\n")
+
+	for i := len(lines) - 1; i >= 0; i-- {
+		if strings.HasPrefix(lines[i], CodeDetailPrefix) {
+			return strings.TrimSpace(strings.TrimPrefix(lines[i], CodeDetailPrefix))
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,178 @@
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestWithCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		code           string
+		diagnostic     diag.Diagnostic
+		expectedDetail string
+		expectedPath   *path.Path
+	}{
+		"error": {
+			code:           "TEST_CODE",
+			diagnostic:     diag.NewErrorDiagnostic("test summary", "test detail"),
+			expectedDetail: "test detail\n\nError Code: TEST_CODE",
+		},
+		"error-empty-detail": {
+			code:           "TEST_CODE",
+			diagnostic:     diag.NewErrorDiagnostic("test summary", ""),
+			expectedDetail: "Error Code: TEST_CODE",
+		},
+		"overwrite": {
+			code:           "TEST_CODE",
+			diagnostic:     diag.WithCode("OTHER_CODE", diag.NewWarningDiagnostic("test summary", "test detail")),
+			expectedDetail: "test detail\n\nError Code: TEST_CODE",
+		},
+		"attribute-error": {
+			code:           "TEST_CODE",
+			diagnostic:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expectedDetail: "test detail\n\nError Code: TEST_CODE",
+			expectedPath:   pointer(path.Root("test")),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.WithCode(testCase.code, testCase.diagnostic)
+
+			if got.Severity() != testCase.diagnostic.Severity() {
+				t.Errorf("expected severity %s, got %s", testCase.diagnostic.Severity(), got.Severity())
+			}
+
+			if got.Summary() != testCase.diagnostic.Summary() {
+				t.Errorf("expected summary %q, got %q", testCase.diagnostic.Summary(), got.Summary())
+			}
+
+			if diff := cmp.Diff(got.Detail(), testCase.expectedDetail); diff != "" {
+				t.Errorf("unexpected detail difference: %s", diff)
+			}
+
+			if code := diag.Code(got); code != testCase.code {
+				t.Errorf("expected code %q, got %q", testCase.code, code)
+			}
+
+			gotWithPath, ok := got.(diag.DiagnosticWithPath)
+
+			if testCase.expectedPath == nil {
+				if ok {
+					t.Fatalf("unexpected path: %s", gotWithPath.Path())
+				}
+
+				return
+			}
+
+			if !ok {
+				t.Fatalf("expected path %s, got none", testCase.expectedPath)
+			}
+
+			if !gotWithPath.Path().Equal(*testCase.expectedPath) {
+				t.Errorf("expected path %s, got %s", testCase.expectedPath, gotWithPath.Path())
+			}
+		})
+	}
+}
+
+func TestWithCodeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.Diagnostic
+		other    diag.Diagnostic
+		expected bool
+	}{
+		"matching": {
+			diag:     diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:    diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected: true,
+		},
+		"nil": {
+			diag:     diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:    nil,
+			expected: false,
+		},
+		"different-code": {
+			diag:     diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:    diag.WithCode("OTHER_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected: false,
+		},
+		"different-diagnostic": {
+			diag:     diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:    diag.WithCode("TEST_CODE", diag.NewWarningDiagnostic("test summary", "test detail")),
+			expected: false,
+		},
+		"no-code": {
+			diag:     diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:    diag.NewErrorDiagnostic("test summary", "test detail\n\nError Code: TEST_CODE"),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.Diagnostic
+		expected string
+	}{
+		"nil": {
+			diag:     nil,
+			expected: "",
+		},
+		"no-code": {
+			diag:     diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected: "",
+		},
+		"with-code": {
+			diag:     diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected: "TEST_CODE",
+		},
+		"detail-suffix": {
+			diag:     diag.NewErrorDiagnostic("test summary", "test detail\n\nError Code: TEST_CODE"),
+			expected: "TEST_CODE",
+		},
+		"detail-suffix-with-trailing-lines": {
+			diag:     diag.NewErrorDiagnostic("test summary", "test detail\n\nError Code: TEST_CODE\n\nSupport: https://example.com"),
+			expected: "TEST_CODE",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.Code(tc.diag)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %q, wanted: %q", got, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package diag
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SensitiveValueReplacement is the text which replaces sensitive values in the
+// Summary and Detail of diagnostics created with WithSensitiveValues().
+const SensitiveValueReplacement = "(sensitive value)"
+
+// withSensitiveValues wraps a diagnostic with values that must be redacted
+// from its summary and detail.
+type withSensitiveValues struct {
+	Diagnostic
+
+	values []string
+}
+
+// Detail returns the diagnostic detail, with sensitive values redacted.
+func (d withSensitiveValues) Detail() string {
+	if d.Diagnostic == nil {
+		return ""
+	}
+
+	return redactSensitiveValues(d.Diagnostic.Detail(), d.values)
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d withSensitiveValues) Equal(other Diagnostic) bool {
+	o, ok := other.(withSensitiveValues)
+
+	if !ok {
+		return false
+	}
+
+	if len(d.values) != len(o.values) {
+		return false
+	}
+
+	for i := range d.values {
+		if d.values[i] != o.values[i] {
+			return false
+		}
+	}
+
+	if d.Diagnostic == nil {
+		return d.Diagnostic == o.Diagnostic
+	}
+
+	return d.Diagnostic.Equal(o.Diagnostic)
+}
+
+// Summary returns the diagnostic summary, with sensitive values redacted.
+func (d withSensitiveValues) Summary() string {
+	if d.Diagnostic == nil {
+		return ""
+	}
+
+	return redactSensitiveValues(d.Diagnostic.Summary(), d.values)
+}
+
+// WithSensitiveValues wraps a diagnostic so that any occurrence of the given
+// values in its Summary or Detail is replaced with SensitiveValueReplacement.
+// This is intended for diagnostics which may include sensitive content, such
+// as an API error response echoing a password. Any path or code information of
+// the diagnostic is preserved.
+//
+// Values are only replaced where they appear as a whole token, meaning a value
+// starting or ending with a letter, digit, or underscore is not replaced when
+// directly adjacent to another letter, digit, or underscore. This prevents
+// short values, such as "1" or "abc", from mangling unrelated words. Empty
+// values are ignored and the diagnostic is returned unmodified if it does not
+// contain any of the values. Callers with non-string sensitive values must
+// convert them to their string representation in the diagnostic.
+func WithSensitiveValues(values []string, d Diagnostic) Diagnostic {
+	switch wd := d.(type) {
+	case withPath:
+		wd.Diagnostic = WithSensitiveValues(values, wd.Diagnostic)
+
+		return wd
+	case withCode:
+		wd.Diagnostic = WithSensitiveValues(values, wd.Diagnostic)
+
+		return wd
+	}
+
+	if d == nil {
+		return d
+	}
+
+	var found []string
+
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+
+		if indexToken(d.Summary(), value) < 0 && indexToken(d.Detail(), value) < 0 {
+			continue
+		}
+
+		found = append(found, value)
+	}
+
+	if len(found) == 0 {
+		return d
+	}
+
+	if wd, ok := d.(withSensitiveValues); ok {
+		found = append(found, wd.values...)
+		d = wd.Diagnostic
+	}
+
+	// Replace longer values first, so values containing other values are
+	// fully redacted.
+	sort.SliceStable(found, func(i, j int) bool {
+		return len(found[i]) > len(found[j])
+	})
+
+	return withSensitiveValues{
+		Diagnostic: d,
+		values:     found,
+	}
+}
+
+// redactSensitiveValues replaces all whole token occurrences of the values in
+// the text.
+func redactSensitiveValues(text string, values []string) string {
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+
+		var b strings.Builder
+
+		for {
+			i := indexToken(text, value)
+
+			if i < 0 {
+				b.WriteString(text)
+
+				break
+			}
+
+			b.WriteString(text[:i])
+			b.WriteString(SensitiveValueReplacement)
+			text = text[i+len(value):]
+		}
+
+		text = b.String()
+	}
+
+	return text
+}
+
+// indexToken returns the index of the first whole token occurrence of the
+// value in the text, or -1 if there is none.
+func indexToken(text string, value string) int {
+	if value == "" {
+		return -1
+	}
+
+	offset := 0
+
+	for {
+		i := strings.Index(text[offset:], value)
+
+		if i < 0 {
+			return -1
+		}
+
+		start := offset + i
+		end := start + len(value)
+
+		if isTokenBoundary(text[:start], value, text[end:]) {
+			return start
+		}
+
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+}
+
+// isTokenBoundary returns true if the value is not directly joined with the
+// word characters before or after it.
+func isTokenBoundary(before string, value string, after string) bool {
+	first, _ := utf8.DecodeRuneInString(value)
+	last, _ := utf8.DecodeLastRuneInString(value)
+
+	if isWordRune(first) {
+		if r, size := utf8.DecodeLastRuneInString(before); size > 0 && isWordRune(r) {
+			return false
+		}
+	}
+
+	if isWordRune(last) {
+		if r, size := utf8.DecodeRuneInString(after); size > 0 && isWordRune(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isWordRune returns true for letters, digits, and underscores.
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,183 @@
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestWithSensitiveValues(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		values          []string
+		diagnostic      diag.Diagnostic
+		expectedSummary string
+		expectedDetail  string
+		expectedCode    string
+		expectedPath    *path.Path
+	}{
+		"no-values": {
+			diagnostic:      diag.NewErrorDiagnostic("test summary", "test detail: secret"),
+			expectedSummary: "test summary",
+			expectedDetail:  "test detail: secret",
+		},
+		"empty-value": {
+			values:          []string{""},
+			diagnostic:      diag.NewErrorDiagnostic("test summary", "test detail"),
+			expectedSummary: "test summary",
+			expectedDetail:  "test detail",
+		},
+		"summary": {
+			values:          []string{"secret"},
+			diagnostic:      diag.NewErrorDiagnostic("test summary secret", "test detail"),
+			expectedSummary: "test summary (sensitive value)",
+			expectedDetail:  "test detail",
+		},
+		"detail": {
+			values:          []string{"secret", "unused"},
+			diagnostic:      diag.NewWarningDiagnostic("test summary", "test detail: secret, secret"),
+			expectedSummary: "test summary",
+			expectedDetail:  "test detail: (sensitive value), (sensitive value)",
+		},
+		"overlapping-values": {
+			values:          []string{"secret", "secret-longer"},
+			diagnostic:      diag.NewErrorDiagnostic("test summary", "test detail: secret-longer"),
+			expectedSummary: "test summary",
+			expectedDetail:  "test detail: (sensitive value)",
+		},
+		"short-value": {
+			values:          []string{"1"},
+			diagnostic:      diag.NewErrorDiagnostic("test summary 1", "test detail: port 1 of 10, id=1"),
+			expectedSummary: "test summary (sensitive value)",
+			expectedDetail:  "test detail: port (sensitive value) of 10, id=(sensitive value)",
+		},
+		"partial-word": {
+			values:          []string{"abc"},
+			diagnostic:      diag.NewErrorDiagnostic("test summary", "test detail: abcdef xabc"),
+			expectedSummary: "test summary",
+			expectedDetail:  "test detail: abcdef xabc",
+		},
+		"punctuation-edges": {
+			values:          []string{"-secret-"},
+			diagnostic:      diag.NewErrorDiagnostic("test summary", "test detail: a-secret-b"),
+			expectedSummary: "test summary",
+			expectedDetail:  "test detail: a(sensitive value)b",
+		},
+		"nested": {
+			values:          []string{"other"},
+			diagnostic:      diag.WithSensitiveValues([]string{"secret"}, diag.NewErrorDiagnostic("test summary", "test detail: secret, other")),
+			expectedSummary: "test summary",
+			expectedDetail:  "test detail: (sensitive value), (sensitive value)",
+		},
+		"attribute-error": {
+			values:          []string{"secret"},
+			diagnostic:      diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail: secret"),
+			expectedSummary: "test summary",
+			expectedDetail:  "test detail: (sensitive value)",
+			expectedPath:    pointer(path.Root("test")),
+		},
+		"code": {
+			values:          []string{"secret"},
+			diagnostic:      diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail: secret")),
+			expectedSummary: "test summary",
+			expectedDetail:  "test detail: (sensitive value)\n\nError Code: TEST_CODE",
+			expectedCode:    "TEST_CODE",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.WithSensitiveValues(testCase.values, testCase.diagnostic)
+
+			if got.Severity() != testCase.diagnostic.Severity() {
+				t.Errorf("expected severity %s, got %s", testCase.diagnostic.Severity(), got.Severity())
+			}
+
+			if diff := cmp.Diff(got.Summary(), testCase.expectedSummary); diff != "" {
+				t.Errorf("unexpected summary difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Detail(), testCase.expectedDetail); diff != "" {
+				t.Errorf("unexpected detail difference: %s", diff)
+			}
+
+			if code := diag.Code(got); code != testCase.expectedCode {
+				t.Errorf("expected code %q, got %q", testCase.expectedCode, code)
+			}
+
+			gotWithPath, ok := got.(diag.DiagnosticWithPath)
+
+			if testCase.expectedPath == nil {
+				if ok {
+					t.Fatalf("unexpected path: %s", gotWithPath.Path())
+				}
+
+				return
+			}
+
+			if !ok {
+				t.Fatalf("expected path %s, got none", testCase.expectedPath)
+			}
+
+			if !gotWithPath.Path().Equal(*testCase.expectedPath) {
+				t.Errorf("expected path %s, got %s", testCase.expectedPath, gotWithPath.Path())
+			}
+		})
+	}
+}
+
+func TestWithSensitiveValuesEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.Diagnostic
+		other    diag.Diagnostic
+		expected bool
+	}{
+		"matching": {
+			diag:     diag.WithSensitiveValues([]string{"secret"}, diag.NewErrorDiagnostic("test summary", "test detail: secret")),
+			other:    diag.WithSensitiveValues([]string{"secret"}, diag.NewErrorDiagnostic("test summary", "test detail: secret")),
+			expected: true,
+		},
+		"nil": {
+			diag:     diag.WithSensitiveValues([]string{"secret"}, diag.NewErrorDiagnostic("test summary", "test detail: secret")),
+			other:    nil,
+			expected: false,
+		},
+		"different-values": {
+			diag:     diag.WithSensitiveValues([]string{"secret"}, diag.NewErrorDiagnostic("test summary", "test detail: secret, other")),
+			other:    diag.WithSensitiveValues([]string{"other"}, diag.NewErrorDiagnostic("test summary", "test detail: secret, other")),
+			expected: false,
+		},
+		"different-diagnostic": {
+			diag:     diag.WithSensitiveValues([]string{"secret"}, diag.NewErrorDiagnostic("test summary", "test detail: secret")),
+			other:    diag.WithSensitiveValues([]string{"secret"}, diag.NewWarningDiagnostic("test summary", "test detail: secret")),
+			expected: false,
+		},
+		"redacted-text": {
+			diag:     diag.WithSensitiveValues([]string{"secret"}, diag.NewErrorDiagnostic("test summary", "test detail: secret")),
+			other:    diag.NewErrorDiagnostic("test summary", "test detail: (sensitive value)"),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
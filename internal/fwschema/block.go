@@ -19,7 +19,8 @@ import (
 // static analysis of blocks and errors generated occur before the provider
 // is called for configuration validation, which means that practitioners do
 // not get all configuration errors at the same time. Provider developers can
-// implement validators to achieve the same validation functionality.
+// use validators to achieve the same validation functionality, such as the
+// schema/listvalidator package SizeAtLeast and SizeAtMost validators.
 type Block interface {
 	// Implementations should include the tftypes.AttributePathStepper
 	// interface methods for proper path and data handling.
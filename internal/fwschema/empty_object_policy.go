@@ -0,0 +1,21 @@
+package fwschema
+
+// EmptyObjectPolicy is an enum type of the ways the framework can normalize
+// object values in resource state, which prevents differences between a
+// null object and an object with all null attributes from causing resource
+// drift or Terraform data consistency errors.
+type EmptyObjectPolicy uint8
+
+const (
+	// EmptyObjectPolicyUnset stores object values exactly as they were
+	// set by the provider. This is the default behavior.
+	EmptyObjectPolicyUnset EmptyObjectPolicy = 0
+
+	// EmptyObjectPolicyNull stores known object values where all
+	// attributes are null as a null object.
+	EmptyObjectPolicyNull EmptyObjectPolicy = 1
+
+	// EmptyObjectPolicyEmpty stores null object values as an object where
+	// all attributes are null.
+	EmptyObjectPolicyEmpty EmptyObjectPolicy = 2
+)
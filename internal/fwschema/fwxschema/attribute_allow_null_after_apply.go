@@ -0,0 +1,16 @@
+package fwxschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// AttributeWithAllowNullAfterApply is an optional interface on Attribute
+// which declares that a computed attribute may legitimately be null after a
+// resource is created or updated.
+type AttributeWithAllowNullAfterApply interface {
+	fwschema.Attribute
+
+	// GetAllowNullAfterApply should return true if the computed attribute
+	// may be null after resource creation or update.
+	GetAllowNullAfterApply() bool
+}
@@ -0,0 +1,14 @@
+package fwxschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// AttributeWithEmptyObjectPolicy is an optional interface on Attribute which
+// enables normalization of empty object values in resource state.
+type AttributeWithEmptyObjectPolicy interface {
+	fwschema.Attribute
+
+	// GetEmptyObjectPolicy should return the empty object policy.
+	GetEmptyObjectPolicy() fwschema.EmptyObjectPolicy
+}
@@ -0,0 +1,18 @@
+package fwxschema
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// AttributeWithEventualConsistencyWindow is an optional interface on
+// Attribute which declares that the remote system may briefly return stale
+// values after a resource is created or updated.
+type AttributeWithEventualConsistencyWindow interface {
+	fwschema.Attribute
+
+	// GetEventualConsistencyWindow should return the duration after resource
+	// creation or update where stale values are tolerated.
+	GetEventualConsistencyWindow() time.Duration
+}
@@ -3,6 +3,7 @@ package fwschema
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -208,6 +209,70 @@ func SchemaTypeAtTerraformPath(ctx context.Context, s Schema, p *tftypes.Attribu
 	}
 }
 
+// SchemaAttributePaths is a helper function to enumerate the paths of all
+// attributes and blocks in the schema, including those nested underneath
+// other attributes and blocks, using the GetAttributes and GetBlocks
+// methods. List, map, and set nesting is represented by the
+// path.Expression type AtAnyListIndex, AtAnyMapKey, and AtAnySetValue
+// methods respectively. The expressions are sorted by their string
+// representation.
+func SchemaAttributePaths(s Schema) path.Expressions {
+	var result path.Expressions
+
+	appendAttributePaths(&result, path.MatchRoot, s.GetAttributes(), s.GetBlocks())
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+
+	return result
+}
+
+// appendAttributePaths appends the paths of the given attributes and blocks,
+// and any nested attributes and blocks. The atName function returns the path
+// of an attribute or block name underneath the parent path.
+func appendAttributePaths(result *path.Expressions, atName func(string) path.Expression, attributes map[string]Attribute, blocks map[string]Block) {
+	for name, attribute := range attributes {
+		attributePath := atName(name)
+
+		*result = append(*result, attributePath)
+
+		nestedAttribute, ok := attribute.(NestedAttribute)
+
+		if !ok {
+			continue
+		}
+
+		switch nestedAttribute.GetNestingMode() {
+		case NestingModeList:
+			attributePath = attributePath.AtAnyListIndex()
+		case NestingModeMap:
+			attributePath = attributePath.AtAnyMapKey()
+		case NestingModeSet:
+			attributePath = attributePath.AtAnySetValue()
+		}
+
+		appendAttributePaths(result, attributePath.AtName, nestedAttribute.GetNestedObject().GetAttributes(), nil)
+	}
+
+	for name, block := range blocks {
+		blockPath := atName(name)
+
+		*result = append(*result, blockPath)
+
+		switch block.GetNestingMode() {
+		case BlockNestingModeList:
+			blockPath = blockPath.AtAnyListIndex()
+		case BlockNestingModeSet:
+			blockPath = blockPath.AtAnySetValue()
+		}
+
+		nestedObject := block.GetNestedObject()
+
+		appendAttributePaths(result, blockPath.AtName, nestedObject.GetAttributes(), nestedObject.GetBlocks())
+	}
+}
+
 // SchemaType is a helper function to perform base type handling using the
 // GetAttributes and GetBlocks methods.
 func SchemaType(s Schema) attr.Type {
@@ -0,0 +1,178 @@
+package fwschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ValidateAttributeImplementation returns error diagnostics for provider
+// developer mistakes in the attribute definition, and any nested attribute
+// definitions, which would otherwise cause unexpected errors at runtime:
+//
+//   - None of Required, Optional, or Computed is enabled.
+//   - Required is enabled with Optional or Computed.
+//   - A default value is set without Computed enabled.
+//   - A collection or object type is missing an element or attribute type.
+func ValidateAttributeImplementation(p path.Path, a Attribute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if a == nil {
+		diags.AddAttributeError(
+			p,
+			"Invalid Attribute Implementation",
+			fmt.Sprintf("Attribute %q is missing its definition. ", p)+
+				"This is always a problem with the provider and should be reported to the provider developer.",
+		)
+
+		return diags
+	}
+
+	switch {
+	case !a.IsRequired() && !a.IsOptional() && !a.IsComputed():
+		diags.AddAttributeError(
+			p,
+			"Invalid Attribute Implementation",
+			fmt.Sprintf("Attribute %q must enable one of Required, Optional, or Computed. ", p)+
+				"This is always a problem with the provider and should be reported to the provider developer.",
+		)
+	case a.IsRequired() && (a.IsOptional() || a.IsComputed()):
+		diags.AddAttributeError(
+			p,
+			"Invalid Attribute Implementation",
+			fmt.Sprintf("Attribute %q must not enable Required with Optional or Computed. ", p)+
+				"This is always a problem with the provider and should be reported to the provider developer.",
+		)
+	}
+
+	if attributeHasDefault(a) && !a.IsComputed() {
+		diags.AddAttributeError(
+			p,
+			"Invalid Attribute Implementation",
+			fmt.Sprintf("Attribute %q must enable Computed when using Default. ", p)+
+				"This is always a problem with the provider and should be reported to the provider developer.",
+		)
+	}
+
+	na, ok := a.(NestedAttribute)
+
+	if !ok {
+		if err := validateTypeImplementation(a.GetType()); err != nil {
+			diags.AddAttributeError(
+				p,
+				"Invalid Attribute Implementation",
+				fmt.Sprintf("Attribute %q has an invalid type: %s. ", p, err)+
+					"This is always a problem with the provider and should be reported to the provider developer.",
+			)
+		}
+
+		return diags
+	}
+
+	nestedObject := na.GetNestedObject()
+
+	if nestedObject == nil {
+		return diags
+	}
+
+	for name, nestedAttribute := range nestedObject.GetAttributes() {
+		diags.Append(ValidateAttributeImplementation(p.AtName(name), nestedAttribute)...)
+	}
+
+	return diags
+}
+
+// ValidateBlockImplementation returns error diagnostics for provider
+// developer mistakes in all nested attribute and block definitions of the
+// block, as described by ValidateAttributeImplementation.
+func ValidateBlockImplementation(p path.Path, b Block) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if b == nil {
+		return diags
+	}
+
+	nestedObject := b.GetNestedObject()
+
+	if nestedObject == nil {
+		return diags
+	}
+
+	for name, nestedAttribute := range nestedObject.GetAttributes() {
+		diags.Append(ValidateAttributeImplementation(p.AtName(name), nestedAttribute)...)
+	}
+
+	for name, nestedBlock := range nestedObject.GetBlocks() {
+		diags.Append(ValidateBlockImplementation(p.AtName(name), nestedBlock)...)
+	}
+
+	return diags
+}
+
+// attributeHasDefault returns true if the attribute has a default value.
+func attributeHasDefault(a Attribute) bool {
+	switch a := a.(type) {
+	case AttributeWithBoolDefaultValue:
+		return a.BoolDefaultValue() != nil
+	case AttributeWithFloat64DefaultValue:
+		return a.Float64DefaultValue() != nil
+	case AttributeWithInt64DefaultValue:
+		return a.Int64DefaultValue() != nil
+	case AttributeWithListDefaultValue:
+		return a.ListDefaultValue() != nil
+	case AttributeWithMapDefaultValue:
+		return a.MapDefaultValue() != nil
+	case AttributeWithNumberDefaultValue:
+		return a.NumberDefaultValue() != nil
+	case AttributeWithObjectDefaultValue:
+		return a.ObjectDefaultValue() != nil
+	case AttributeWithSetDefaultValue:
+		return a.SetDefaultValue() != nil
+	case AttributeWithStringDefaultValue:
+		return a.StringDefaultValue() != nil
+	default:
+		return false
+	}
+}
+
+// validateTypeImplementation returns an error if the type, or any element or
+// attribute type underneath it, is missing.
+func validateTypeImplementation(t attr.Type) error {
+	if t == nil {
+		return errors.New("missing type")
+	}
+
+	switch t := t.(type) {
+	case attr.TypeWithElementType:
+		if t.ElementType() == nil {
+			return errors.New("missing element type")
+		}
+
+		return validateTypeImplementation(t.ElementType())
+	case attr.TypeWithAttributeTypes:
+		for name, attributeType := range t.AttributeTypes() {
+			if attributeType == nil {
+				return fmt.Errorf("missing type for object attribute %q", name)
+			}
+
+			if err := validateTypeImplementation(attributeType); err != nil {
+				return fmt.Errorf("object attribute %q: %w", name, err)
+			}
+		}
+	case attr.TypeWithElementTypes:
+		for index, elementType := range t.ElementTypes() {
+			if elementType == nil {
+				return fmt.Errorf("missing type for tuple element %d", index)
+			}
+
+			if err := validateTypeImplementation(elementType); err != nil {
+				return fmt.Errorf("tuple element %d: %w", index, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,301 @@
+package fwschemadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TransformDefaults walks the schema and applies schema defined default
+// values when the given configuration value at the same path is null.
+func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var err error
+
+	d.TerraformValue, err = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
+		// Skip the root of the data, only applying defaults to attributes.
+		if len(tfTypePath.Steps()) < 1 {
+			return tfTypeValue, nil
+		}
+
+		configValueRaw, remaining, err := tftypes.WalkAttributePath(configRaw, tfTypePath)
+
+		// Values which are not present in the configuration, such as within
+		// null parent values, cannot have a default applied.
+		if err != nil || len(remaining.Steps()) > 0 {
+			return tfTypeValue, nil //nolint:nilerr // Intentionally ignoring the error
+		}
+
+		configValue, ok := configValueRaw.(tftypes.Value)
+
+		if !ok || !configValue.IsNull() {
+			return tfTypeValue, nil
+		}
+
+		attribute, err := d.Schema.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		if err != nil {
+			// Ignore blocks and values inside attributes without their own
+			// schema definition, such as collection elements.
+			if errors.Is(err, fwschema.ErrPathInsideAtomicAttribute) || errors.Is(err, fwschema.ErrPathIsBlock) {
+				return tfTypeValue, nil
+			}
+
+			return tfTypeValue, err
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
+
+		diags.Append(fwPathDiags...)
+
+		if fwPathDiags.HasError() {
+			return tfTypeValue, nil
+		}
+
+		ctx := logging.FrameworkWithAttributePath(ctx, fwPath.String())
+
+		defaultValue, defaultDiags := attributeDefaultValue(ctx, attribute, fwPath)
+
+		diags.Append(defaultDiags...)
+
+		if defaultValue == nil || defaultDiags.HasError() {
+			return tfTypeValue, nil
+		}
+
+		if !attribute.IsComputed() {
+			diags.AddAttributeError(
+				fwPath,
+				"Schema Using Attribute Default For Non-Computed Attribute",
+				fmt.Sprintf("Attribute %q must be computed when using default. ", fwPath)+
+					"This is an issue with the provider and should be reported to the provider developers.",
+			)
+
+			return tfTypeValue, nil
+		}
+
+		logging.FrameworkTrace(ctx, "setting attribute to default value")
+
+		defaultTfValue, err := defaultValue.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tfTypeValue, err
+		}
+
+		if !defaultTfValue.Type().Equal(tfTypeValue.Type()) {
+			diags.AddAttributeError(
+				fwPath,
+				"Invalid Attribute Default Value",
+				"The attribute default value type does not match the attribute type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Expected Type: %s\n", tfTypeValue.Type())+
+					fmt.Sprintf("Default Value Type: %s", defaultTfValue.Type()),
+			)
+
+			return tfTypeValue, nil
+		}
+
+		return defaultTfValue, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Error Handling Schema Defaults",
+			"An unexpected error occurred while handling schema default values. "+
+				"Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// attributeDefaultValue returns the default value of the attribute, if the
+// attribute implements a default value interface and the default is set.
+func attributeDefaultValue(ctx context.Context, attribute fwschema.Attribute, fwPath path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch a := attribute.(type) {
+	case fwschema.AttributeWithBoolDefaultValue:
+		defaultValue := a.BoolDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		req := defaults.BoolRequest{
+			Path: fwPath,
+		}
+		resp := &defaults.BoolResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Bool Default")
+		defaultValue.DefaultBool(ctx, req, resp)
+		logging.FrameworkDebug(ctx, "Called provider defined Bool Default")
+
+		diags.Append(resp.Diagnostics...)
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithFloat64DefaultValue:
+		defaultValue := a.Float64DefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		req := defaults.Float64Request{
+			Path: fwPath,
+		}
+		resp := &defaults.Float64Response{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Float64 Default")
+		defaultValue.DefaultFloat64(ctx, req, resp)
+		logging.FrameworkDebug(ctx, "Called provider defined Float64 Default")
+
+		diags.Append(resp.Diagnostics...)
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithInt64DefaultValue:
+		defaultValue := a.Int64DefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		req := defaults.Int64Request{
+			Path: fwPath,
+		}
+		resp := &defaults.Int64Response{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Int64 Default")
+		defaultValue.DefaultInt64(ctx, req, resp)
+		logging.FrameworkDebug(ctx, "Called provider defined Int64 Default")
+
+		diags.Append(resp.Diagnostics...)
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithListDefaultValue:
+		defaultValue := a.ListDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		req := defaults.ListRequest{
+			Path: fwPath,
+		}
+		resp := &defaults.ListResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined List Default")
+		defaultValue.DefaultList(ctx, req, resp)
+		logging.FrameworkDebug(ctx, "Called provider defined List Default")
+
+		diags.Append(resp.Diagnostics...)
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithMapDefaultValue:
+		defaultValue := a.MapDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		req := defaults.MapRequest{
+			Path: fwPath,
+		}
+		resp := &defaults.MapResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Map Default")
+		defaultValue.DefaultMap(ctx, req, resp)
+		logging.FrameworkDebug(ctx, "Called provider defined Map Default")
+
+		diags.Append(resp.Diagnostics...)
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithNumberDefaultValue:
+		defaultValue := a.NumberDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		req := defaults.NumberRequest{
+			Path: fwPath,
+		}
+		resp := &defaults.NumberResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Number Default")
+		defaultValue.DefaultNumber(ctx, req, resp)
+		logging.FrameworkDebug(ctx, "Called provider defined Number Default")
+
+		diags.Append(resp.Diagnostics...)
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithObjectDefaultValue:
+		defaultValue := a.ObjectDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		req := defaults.ObjectRequest{
+			Path: fwPath,
+		}
+		resp := &defaults.ObjectResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Object Default")
+		defaultValue.DefaultObject(ctx, req, resp)
+		logging.FrameworkDebug(ctx, "Called provider defined Object Default")
+
+		diags.Append(resp.Diagnostics...)
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithSetDefaultValue:
+		defaultValue := a.SetDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		req := defaults.SetRequest{
+			Path: fwPath,
+		}
+		resp := &defaults.SetResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Set Default")
+		defaultValue.DefaultSet(ctx, req, resp)
+		logging.FrameworkDebug(ctx, "Called provider defined Set Default")
+
+		diags.Append(resp.Diagnostics...)
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithStringDefaultValue:
+		defaultValue := a.StringDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		req := defaults.StringRequest{
+			Path: fwPath,
+		}
+		resp := &defaults.StringResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined String Default")
+		defaultValue.DefaultString(ctx, req, resp)
+		logging.FrameworkDebug(ctx, "Called provider defined String Default")
+
+		diags.Append(resp.Diagnostics...)
+
+		return resp.PlanValue, diags
+	}
+
+	return nil, diags
+}
@@ -0,0 +1,259 @@
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDataTransformDefaults(t *testing.T) {
+	t.Parallel()
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_string": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"bool_attribute":   tftypes.Bool,
+			"int64_attribute":  tftypes.Number,
+			"list_attribute":   tftypes.List{ElementType: tftypes.String},
+			"list_nested":      tftypes.List{ElementType: testNestedType},
+			"string_attribute": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bool_attribute": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"int64_attribute": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(123),
+			},
+			"list_attribute": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default: listdefault.StaticValue(
+					types.ListValueMust(types.StringType, []attr.Value{types.StringValue("default")}),
+				),
+			},
+			"list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"nested_string": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("nested-default"),
+						},
+					},
+				},
+				Optional: true,
+			},
+			"string_attribute": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testNested := func(value any) tftypes.Value {
+		return tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+			"nested_string": tftypes.NewValue(tftypes.String, value),
+		})
+	}
+
+	testCases := map[string]struct {
+		data          *fwschemadata.Data
+		config        tftypes.Value
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"config-null": {
+			data: &fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"bool_attribute":   tftypes.NewValue(tftypes.Bool, nil),
+					"int64_attribute":  tftypes.NewValue(tftypes.Number, 456),
+					"list_attribute":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+					"list_nested":      tftypes.NewValue(tftypes.List{ElementType: testNestedType}, nil),
+					"string_attribute": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			config: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"bool_attribute":   tftypes.NewValue(tftypes.Bool, nil),
+				"int64_attribute":  tftypes.NewValue(tftypes.Number, nil),
+				"list_attribute":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"list_nested":      tftypes.NewValue(tftypes.List{ElementType: testNestedType}, nil),
+				"string_attribute": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"bool_attribute":  tftypes.NewValue(tftypes.Bool, true),
+				"int64_attribute": tftypes.NewValue(tftypes.Number, 123),
+				"list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "default"),
+				}),
+				"list_nested":      tftypes.NewValue(tftypes.List{ElementType: testNestedType}, nil),
+				"string_attribute": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"config-known": {
+			data: &fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"bool_attribute":  tftypes.NewValue(tftypes.Bool, false),
+					"int64_attribute": tftypes.NewValue(tftypes.Number, 456),
+					"list_attribute":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+					"list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedType}, []tftypes.Value{
+						testNested("config"),
+						testNested(nil),
+					}),
+					"string_attribute": tftypes.NewValue(tftypes.String, "config"),
+				}),
+			},
+			config: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"bool_attribute":  tftypes.NewValue(tftypes.Bool, false),
+				"int64_attribute": tftypes.NewValue(tftypes.Number, 456),
+				"list_attribute":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+				"list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedType}, []tftypes.Value{
+					testNested("config"),
+					testNested(nil),
+				}),
+				"string_attribute": tftypes.NewValue(tftypes.String, "config"),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"bool_attribute":  tftypes.NewValue(tftypes.Bool, false),
+				"int64_attribute": tftypes.NewValue(tftypes.Number, 456),
+				"list_attribute":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+				"list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedType}, []tftypes.Value{
+					testNested("config"),
+					testNested("nested-default"),
+				}),
+				"string_attribute": tftypes.NewValue(tftypes.String, "config"),
+			}),
+		},
+		"non-computed": {
+			data: &fwschemadata.Data{
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"string_attribute": schema.StringAttribute{
+							Optional: true,
+							Default:  stringdefault.StaticString("default"),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"string_attribute": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"string_attribute": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			config: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"string_attribute": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"string_attribute": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"string_attribute": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"string_attribute": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("string_attribute"),
+					"Schema Using Attribute Default For Non-Computed Attribute",
+					"Attribute \"string_attribute\" must be computed when using default. "+
+						"This is an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"invalid-default-type": {
+			data: &fwschemadata.Data{
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"list_attribute": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Default: listdefault.StaticValue(
+								types.ListValueMust(types.BoolType, []attr.Value{types.BoolValue(true)}),
+							),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"list_attribute": tftypes.List{ElementType: tftypes.String},
+					},
+				}, map[string]tftypes.Value{
+					"list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				}),
+			},
+			config: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list_attribute": tftypes.List{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list_attribute": tftypes.List{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list_attribute"),
+					"Invalid Attribute Default Value",
+					"The attribute default value type does not match the attribute type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Expected Type: tftypes.List[tftypes.String]\n"+
+						"Default Value Type: tftypes.List[tftypes.Bool]",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.data.TransformDefaults(context.Background(), testCase.config)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.data.TerraformValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
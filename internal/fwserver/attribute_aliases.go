@@ -0,0 +1,447 @@
+package fwserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// resourceAttributeAliases returns the resource defined mapping of previous
+// attribute names to current attribute names, if implemented.
+func resourceAttributeAliases(ctx context.Context, r resource.Resource) map[string]string {
+	resourceWithAttributeAliases, ok := r.(resource.ResourceWithAttributeAliases)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithAttributeAliases")
+
+	return resourceWithAttributeAliases.AttributeAliases(ctx)
+}
+
+// attributeAlias is a previous and current attribute name within the same
+// object.
+type attributeAlias struct {
+	previousName string
+	currentName  string
+}
+
+// attributeAliasesByParent returns the attribute aliases grouped by the dot
+// separated attribute names of their parent object, which is empty for top
+// level attributes. Aliases which do not share the same parent object are
+// ignored.
+func attributeAliasesByParent(ctx context.Context, aliases map[string]string) map[string][]attributeAlias {
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	previousPaths := make([]string, 0, len(aliases))
+
+	for previousPath := range aliases {
+		previousPaths = append(previousPaths, previousPath)
+	}
+
+	sort.Strings(previousPaths)
+
+	result := make(map[string][]attributeAlias, len(aliases))
+
+	for _, previousPath := range previousPaths {
+		currentPath := aliases[previousPath]
+		previousParent, previousName := splitAttributeAliasPath(previousPath)
+		currentParent, currentName := splitAttributeAliasPath(currentPath)
+
+		if previousParent != currentParent || previousName == "" || currentName == "" {
+			logging.FrameworkWarn(
+				ctx,
+				"Ignoring attribute alias with a different parent object",
+				map[string]interface{}{
+					logging.KeyAttributePath: previousPath,
+				},
+			)
+
+			continue
+		}
+
+		result[previousParent] = append(result[previousParent], attributeAlias{
+			previousName: previousName,
+			currentName:  currentName,
+		})
+	}
+
+	return result
+}
+
+// splitAttributeAliasPath returns the parent object and attribute name of a
+// dot separated attribute alias path.
+func splitAttributeAliasPath(aliasPath string) (string, string) {
+	index := strings.LastIndex(aliasPath, ".")
+
+	if index == -1 {
+		return "", aliasPath
+	}
+
+	return aliasPath[:index], aliasPath[index+1:]
+}
+
+// attributeAliasParent returns the dot separated attribute names of the
+// given path, omitting element steps, so the aliases of nested attributes
+// apply to every element of a list, map, or set.
+func attributeAliasParent(p *tftypes.AttributePath) string {
+	var names []string
+
+	for _, step := range p.Steps() {
+		if name, ok := step.(tftypes.AttributeName); ok {
+			names = append(names, string(name))
+		}
+	}
+
+	return strings.Join(names, ".")
+}
+
+// rawStateWithAttributeAliases returns the raw state with the JSON value of
+// each previous attribute copied to the current attribute, if the current
+// attribute is missing or null. Previous attributes which are not defined in
+// the given type are removed. The raw state is returned unchanged if there
+// are no aliases or the JSON cannot be decoded, which is handled when
+// unmarshalling the raw state.
+func rawStateWithAttributeAliases(ctx context.Context, rawState *tfprotov6.RawState, aliases map[string]string, typ tftypes.Type) *tfprotov6.RawState {
+	aliasesByParent := attributeAliasesByParent(ctx, aliases)
+
+	if len(aliasesByParent) == 0 || rawState == nil || len(rawState.JSON) == 0 {
+		return rawState
+	}
+
+	var state interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(rawState.JSON))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&state); err != nil {
+		return rawState
+	}
+
+	if !jsonWithAttributeAliases(ctx, state, typ, "", aliasesByParent) {
+		return rawState
+	}
+
+	stateJSON, err := json.Marshal(state)
+
+	if err != nil {
+		return rawState
+	}
+
+	return &tfprotov6.RawState{
+		JSON:    stateJSON,
+		Flatmap: rawState.Flatmap,
+	}
+}
+
+// jsonWithAttributeAliases applies the attribute aliases to the decoded JSON
+// value in place, descending into nested objects of the given type. It
+// returns true if the value was changed.
+func jsonWithAttributeAliases(ctx context.Context, value interface{}, typ tftypes.Type, parent string, aliasesByParent map[string][]attributeAlias) bool {
+	var changed bool
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		attributes, ok := value.(map[string]interface{})
+
+		if !ok {
+			return false
+		}
+
+		for _, alias := range aliasesByParent[parent] {
+			previousValue, ok := attributes[alias.previousName]
+
+			if !ok {
+				continue
+			}
+
+			if currentValue, ok := attributes[alias.currentName]; !ok || currentValue == nil {
+				logging.FrameworkDebug(
+					ctx,
+					"Copying prior state attribute alias value",
+					map[string]interface{}{
+						logging.KeyAttributePath: joinAttributeAliasPath(parent, alias.currentName),
+					},
+				)
+
+				attributes[alias.currentName] = previousValue
+				changed = true
+			}
+
+			if _, ok := typ.AttributeTypes[alias.previousName]; !ok {
+				delete(attributes, alias.previousName)
+				changed = true
+			}
+		}
+
+		for name, attributeType := range typ.AttributeTypes {
+			if attributeValue, ok := attributes[name]; ok {
+				if jsonWithAttributeAliases(ctx, attributeValue, attributeType, joinAttributeAliasPath(parent, name), aliasesByParent) {
+					changed = true
+				}
+			}
+		}
+	case tftypes.List:
+		elements, _ := value.([]interface{})
+
+		for _, element := range elements {
+			if jsonWithAttributeAliases(ctx, element, typ.ElementType, parent, aliasesByParent) {
+				changed = true
+			}
+		}
+	case tftypes.Set:
+		elements, _ := value.([]interface{})
+
+		for _, element := range elements {
+			if jsonWithAttributeAliases(ctx, element, typ.ElementType, parent, aliasesByParent) {
+				changed = true
+			}
+		}
+	case tftypes.Map:
+		elements, _ := value.(map[string]interface{})
+
+		for _, element := range elements {
+			if jsonWithAttributeAliases(ctx, element, typ.ElementType, parent, aliasesByParent) {
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
+
+// joinAttributeAliasPath returns the dot separated attribute alias path of
+// the attribute name within the parent object.
+func joinAttributeAliasPath(parent string, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + "." + name
+}
+
+// valueWithAttributeAliases returns the value with each non-null previous
+// attribute value copied to the current attribute, if the current attribute
+// is null and has the same type. If shouldCopy is not nil, it must return
+// true for the current attribute path for the value to be copied.
+func valueWithAttributeAliases(ctx context.Context, value tftypes.Value, aliases map[string]string, shouldCopy func(*tftypes.AttributePath) bool) (tftypes.Value, error) {
+	aliasesByParent := attributeAliasesByParent(ctx, aliases)
+
+	if len(aliasesByParent) == 0 || value.IsNull() || !value.IsKnown() {
+		return value, nil
+	}
+
+	return tftypes.Transform(value, func(tfPath *tftypes.AttributePath, tfValue tftypes.Value) (tftypes.Value, error) {
+		if _, ok := tfValue.Type().(tftypes.Object); !ok || tfValue.IsNull() || !tfValue.IsKnown() {
+			return tfValue, nil
+		}
+
+		objectAliases := aliasesByParent[attributeAliasParent(tfPath)]
+
+		if len(objectAliases) == 0 {
+			return tfValue, nil
+		}
+
+		var attributes map[string]tftypes.Value
+
+		if err := tfValue.As(&attributes); err != nil {
+			return tfValue, err
+		}
+
+		var changed bool
+
+		for _, alias := range objectAliases {
+			previousValue, ok := attributes[alias.previousName]
+
+			if !ok || previousValue.IsNull() {
+				continue
+			}
+
+			currentValue, ok := attributes[alias.currentName]
+
+			if !ok || !currentValue.IsNull() || !currentValue.Type().Equal(previousValue.Type()) {
+				continue
+			}
+
+			currentPath := tfPath.WithAttributeName(alias.currentName)
+
+			if shouldCopy != nil && !shouldCopy(currentPath) {
+				continue
+			}
+
+			logging.FrameworkDebug(
+				ctx,
+				"Copying attribute alias value",
+				map[string]interface{}{
+					logging.KeyAttributePath: currentPath.String(),
+				},
+			)
+
+			attributes[alias.currentName] = previousValue
+			changed = true
+		}
+
+		if !changed {
+			return tfValue, nil
+		}
+
+		return tftypes.NewValue(tfValue.Type(), attributes), nil
+	})
+}
+
+// configWithAttributeAliases returns the configuration with each configured
+// previous attribute value copied to the current attribute, if the current
+// attribute is not configured. The configuration is returned unchanged if
+// the aliases cannot be applied, which is handled by other configuration
+// validation.
+func configWithAttributeAliases(ctx context.Context, config tfsdk.Config, aliases map[string]string) tfsdk.Config {
+	configValue, err := valueWithAttributeAliases(ctx, config.Raw, aliases, nil)
+
+	if err != nil {
+		logging.FrameworkDebug(ctx, "Unable to apply attribute aliases to configuration: "+err.Error())
+
+		return config
+	}
+
+	config.Raw = configValue
+
+	return config
+}
+
+// planWithAttributeAliases returns the plan with each previous attribute
+// value copied to the current attribute, if the current attribute is null
+// and Computed. Terraform requires the planned value of an attribute which
+// is not Computed to equal its configuration value.
+func planWithAttributeAliases(ctx context.Context, plan tfsdk.State, aliases map[string]string) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	shouldCopy := func(tfPath *tftypes.AttributePath) bool {
+		attribute, err := plan.Schema.AttributeAtTerraformPath(ctx, tfPath)
+
+		return err == nil && attribute.IsComputed()
+	}
+
+	planValue, err := valueWithAttributeAliases(ctx, plan.Raw, aliases, shouldCopy)
+
+	if err != nil {
+		diags.AddError(
+			"Error Applying Attribute Aliases",
+			"An unexpected error occurred while copying renamed attribute values into the plan. "+
+				"This is always an issue with terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return plan, diags
+	}
+
+	plan.Raw = planValue
+
+	return plan, diags
+}
+
+// stateWithAttributeAliases returns the upgraded state with each previous
+// attribute value copied to the current attribute, if the current attribute
+// is null.
+func stateWithAttributeAliases(ctx context.Context, state tfsdk.State, aliases map[string]string) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	stateValue, err := valueWithAttributeAliases(ctx, state.Raw, aliases, nil)
+
+	if err != nil {
+		diags.AddError(
+			"Error Applying Attribute Aliases",
+			"An unexpected error occurred while copying renamed attribute values into the upgraded state. "+
+				"This is always an issue with terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return state, diags
+	}
+
+	state.Raw = stateValue
+
+	return state, diags
+}
+
+// configAttributeAliasesDiags returns a warning diagnostic for each
+// configured previous attribute and an error diagnostic if the current
+// attribute is also configured.
+func configAttributeAliasesDiags(ctx context.Context, config tfsdk.Config, aliases map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	aliasesByParent := attributeAliasesByParent(ctx, aliases)
+
+	if len(aliasesByParent) == 0 || !config.Raw.IsKnown() || config.Raw.IsNull() {
+		return diags
+	}
+
+	// Errors are already handled by other configuration validation.
+	_ = tftypes.Walk(config.Raw, func(tfPath *tftypes.AttributePath, tfValue tftypes.Value) (bool, error) {
+		if _, ok := tfValue.Type().(tftypes.Object); !ok || tfValue.IsNull() || !tfValue.IsKnown() {
+			return true, nil
+		}
+
+		objectAliases := aliasesByParent[attributeAliasParent(tfPath)]
+
+		if len(objectAliases) == 0 {
+			return true, nil
+		}
+
+		var attributes map[string]tftypes.Value
+
+		if err := tfValue.As(&attributes); err != nil {
+			return false, err
+		}
+
+		for _, alias := range objectAliases {
+			previousValue, ok := attributes[alias.previousName]
+
+			if !ok || previousValue.IsNull() {
+				continue
+			}
+
+			previousPath, pathDiags := fromtftypes.AttributePath(ctx, tfPath.WithAttributeName(alias.previousName), config.Schema)
+
+			if pathDiags.HasError() {
+				continue
+			}
+
+			if currentValue, ok := attributes[alias.currentName]; ok && !currentValue.IsNull() {
+				diags.AddAttributeError(
+					previousPath,
+					"Conflicting Renamed Attribute Configuration",
+					fmt.Sprintf("The %q attribute has been renamed to %q and both attributes are configured. ", alias.previousName, alias.currentName)+
+						fmt.Sprintf("Remove the %q attribute from the configuration.", alias.previousName),
+				)
+
+				continue
+			}
+
+			diags.AddAttributeWarning(
+				previousPath,
+				"Attribute Renamed",
+				fmt.Sprintf("The %q attribute has been renamed to %q. ", alias.previousName, alias.currentName)+
+					fmt.Sprintf("Update the configuration to use the %q attribute, as the %q attribute may be removed in a future version.", alias.currentName, alias.previousName),
+			)
+		}
+
+		return true, nil
+	})
+
+	return diags
+}
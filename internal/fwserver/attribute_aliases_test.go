@@ -0,0 +1,326 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestRawStateWithAttributeAliases(t *testing.T) {
+	t.Parallel()
+
+	ruleType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"port": tftypes.Number,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"rule": tftypes.List{ElementType: ruleType},
+			"size": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		rawState *tfprotov6.RawState
+		aliases  map[string]string
+		expected *tfprotov6.RawState
+	}{
+		"no-aliases": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{"instance_size":"large"}`),
+			},
+			expected: &tfprotov6.RawState{
+				JSON: []byte(`{"instance_size":"large"}`),
+			},
+		},
+		"top-level": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{"instance_size":"large","rule":null,"size":null}`),
+			},
+			aliases: map[string]string{
+				"instance_size": "size",
+			},
+			expected: &tfprotov6.RawState{
+				JSON: []byte(`{"rule":null,"size":"large"}`),
+			},
+		},
+		"nested": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{"rule":[{"from_port":80},{"from_port":443,"port":8443}],"size":null}`),
+			},
+			aliases: map[string]string{
+				"rule.from_port": "rule.port",
+			},
+			expected: &tfprotov6.RawState{
+				JSON: []byte(`{"rule":[{"port":80},{"port":8443}],"size":null}`),
+			},
+		},
+		"different-parent": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{"port":80,"rule":null,"size":null}`),
+			},
+			aliases: map[string]string{
+				"port": "rule.port",
+			},
+			expected: &tfprotov6.RawState{
+				JSON: []byte(`{"port":80,"rule":null,"size":null}`),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := rawStateWithAttributeAliases(context.Background(), testCase.rawState, testCase.aliases, testType)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanWithAttributeAliases(t *testing.T) {
+	t.Parallel()
+
+	ruleType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"from_port": tftypes.Number,
+			"port":      tftypes.Number,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"instance_size": tftypes.String,
+			"rule":          tftypes.List{ElementType: ruleType},
+			"size":          tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"instance_size": schema.StringAttribute{
+				Optional: true,
+			},
+			"rule": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"from_port": schema.NumberAttribute{
+							Optional: true,
+						},
+						"port": schema.NumberAttribute{
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"size": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	aliases := map[string]string{
+		"instance_size":  "size",
+		"rule.from_port": "rule.port",
+	}
+
+	testValue := func(instanceSize, size interface{}, port interface{}) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"instance_size": tftypes.NewValue(tftypes.String, instanceSize),
+			"rule": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{
+				tftypes.NewValue(ruleType, map[string]tftypes.Value{
+					"from_port": tftypes.NewValue(tftypes.Number, 80),
+					"port":      tftypes.NewValue(tftypes.Number, port),
+				}),
+			}),
+			"size": tftypes.NewValue(tftypes.String, size),
+		})
+	}
+
+	testCases := map[string]struct {
+		config         tftypes.Value
+		plan           tftypes.Value
+		expectedConfig tftypes.Value
+		expectedPlan   tftypes.Value
+	}{
+		"previous-configured": {
+			config:         testValue("large", nil, nil),
+			plan:           testValue("large", nil, nil),
+			expectedConfig: testValue("large", "large", 80),
+			// The non-computed size attribute must match the configuration.
+			expectedPlan: testValue("large", nil, 80),
+		},
+		"current-configured": {
+			config:         testValue("large", "small", 443),
+			plan:           testValue("large", "small", 443),
+			expectedConfig: testValue("large", "small", 443),
+			expectedPlan:   testValue("large", "small", 443),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			gotConfig := configWithAttributeAliases(ctx, tfsdk.Config{Raw: testCase.config, Schema: testSchema}, aliases)
+
+			if diff := cmp.Diff(gotConfig.Raw, testCase.expectedConfig); diff != "" {
+				t.Errorf("unexpected config difference: %s", diff)
+			}
+
+			gotPlan, diags := planWithAttributeAliases(ctx, tfsdk.State{Raw: testCase.plan, Schema: testSchema}, aliases)
+
+			if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotPlan.Raw, testCase.expectedPlan); diff != "" {
+				t.Errorf("unexpected plan difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigAttributeAliasesDiags(t *testing.T) {
+	t.Parallel()
+
+	blockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"current":  tftypes.String,
+			"previous": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"block": tftypes.List{ElementType: blockType},
+		},
+	}
+
+	testSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"current": schema.StringAttribute{
+							Optional: true,
+						},
+						"previous": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	config := tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"block": tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{
+				tftypes.NewValue(blockType, map[string]tftypes.Value{
+					"current":  tftypes.NewValue(tftypes.String, nil),
+					"previous": tftypes.NewValue(tftypes.String, "one"),
+				}),
+				tftypes.NewValue(blockType, map[string]tftypes.Value{
+					"current":  tftypes.NewValue(tftypes.String, "two"),
+					"previous": tftypes.NewValue(tftypes.String, "two"),
+				}),
+			}),
+		}),
+		Schema: testSchema,
+	}
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeWarningDiagnostic(
+			path.Root("block").AtListIndex(0).AtName("previous"),
+			"Attribute Renamed",
+			`The "previous" attribute has been renamed to "current". `+
+				`Update the configuration to use the "current" attribute, as the "previous" attribute may be removed in a future version.`,
+		),
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("block").AtListIndex(1).AtName("previous"),
+			"Conflicting Renamed Attribute Configuration",
+			`The "previous" attribute has been renamed to "current" and both attributes are configured. `+
+				`Remove the "previous" attribute from the configuration.`,
+		),
+	}
+
+	got := configAttributeAliasesDiags(context.Background(), config, map[string]string{
+		"block.previous": "block.current",
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestStateWithAttributeAliases(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"instance_size": tftypes.String,
+			"size":          tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"instance_size": schema.StringAttribute{
+				Optional: true,
+			},
+			"size": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	state := tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"instance_size": tftypes.NewValue(tftypes.String, "large"),
+			"size":          tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchema,
+	}
+
+	expected := tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"instance_size": tftypes.NewValue(tftypes.String, "large"),
+			"size":          tftypes.NewValue(tftypes.String, "large"),
+		}),
+		Schema: testSchema,
+	}
+
+	got, diags := stateWithAttributeAliases(context.Background(), state, map[string]string{
+		"instance_size": "size",
+	})
+
+	if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
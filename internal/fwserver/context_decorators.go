@@ -0,0 +1,42 @@
+package fwserver
+
+import (
+	"context"
+)
+
+// RPCInfo describes an incoming RPC for context decorators.
+type RPCInfo struct {
+	// Name is the RPC name, such as ReadResource.
+	Name string
+
+	// TypeName is the resource or data source type name of the RPC. It is
+	// empty for provider-level RPCs.
+	TypeName string
+}
+
+// ContextDecorator returns a context derived from the RPC context.
+type ContextDecorator func(context.Context, RPCInfo) context.Context
+
+// ContextDecorators are context decorators which are applied, in order, to
+// the context of every incoming RPC.
+type ContextDecorators []ContextDecorator
+
+// Decorate returns the context after applying each decorator in order. Nil
+// decorators and decorators which return a nil context are skipped.
+func (d ContextDecorators) Decorate(ctx context.Context, info RPCInfo) context.Context {
+	for _, decorator := range d {
+		if decorator == nil {
+			continue
+		}
+
+		decoratedCtx := decorator(ctx, info)
+
+		if decoratedCtx == nil {
+			continue
+		}
+
+		ctx = decoratedCtx
+	}
+
+	return ctx
+}
@@ -0,0 +1,84 @@
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+)
+
+type testContextKey string
+
+func TestContextDecoratorsDecorate(t *testing.T) {
+	t.Parallel()
+
+	testInfo := fwserver.RPCInfo{
+		Name:     "ReadResource",
+		TypeName: "test_resource",
+	}
+
+	appendDecorator := func(value string) fwserver.ContextDecorator {
+		return func(ctx context.Context, info fwserver.RPCInfo) context.Context {
+			existing, _ := ctx.Value(testContextKey("test")).(string)
+
+			return context.WithValue(ctx, testContextKey("test"), existing+value+":"+info.Name+":"+info.TypeName+",")
+		}
+	}
+
+	testCases := map[string]struct {
+		decorators fwserver.ContextDecorators
+		expected   string
+	}{
+		"nil": {
+			decorators: nil,
+			expected:   "",
+		},
+		"one": {
+			decorators: fwserver.ContextDecorators{
+				appendDecorator("one"),
+			},
+			expected: "one:ReadResource:test_resource,",
+		},
+		"ordered": {
+			decorators: fwserver.ContextDecorators{
+				appendDecorator("one"),
+				appendDecorator("two"),
+			},
+			expected: "one:ReadResource:test_resource,two:ReadResource:test_resource,",
+		},
+		"nil-decorator": {
+			decorators: fwserver.ContextDecorators{
+				nil,
+				appendDecorator("one"),
+			},
+			expected: "one:ReadResource:test_resource,",
+		},
+		"nil-context": {
+			decorators: fwserver.ContextDecorators{
+				appendDecorator("one"),
+				func(_ context.Context, _ fwserver.RPCInfo) context.Context {
+					return nil
+				},
+			},
+			expected: "one:ReadResource:test_resource,",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testCase.decorators.Decorate(context.Background(), testInfo)
+
+			got, _ := ctx.Value(testContextKey("test")).(string)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
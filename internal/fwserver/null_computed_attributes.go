@@ -0,0 +1,95 @@
+package fwserver
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// nullComputedAttributeCheck returns true if the provider implements the
+// ProviderWithNullComputedAttributeCheck interface and enables the check.
+func (s *Server) nullComputedAttributeCheck(ctx context.Context) bool {
+	providerWithNullComputedAttributeCheck, ok := s.Provider.(provider.ProviderWithNullComputedAttributeCheck)
+
+	if !ok {
+		return false
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithNullComputedAttributeCheck")
+
+	return providerWithNullComputedAttributeCheck.NullComputedAttributeCheck(ctx)
+}
+
+// nullComputedAttributesDiags returns a warning diagnostic for each computed
+// attribute which is null in the new state after a resource Create or Update,
+// unless the attribute declares AllowNullAfterApply. Nested attributes are
+// only checked when their parent value is not null.
+func nullComputedAttributesDiags(ctx context.Context, s fwschema.Schema, newState *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if newState == nil || newState.Raw.IsNull() {
+		return diags
+	}
+
+	var nullPaths []*tftypes.AttributePath
+
+	err := tftypes.Walk(newState.Raw, func(tfTypePath *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		if len(tfTypePath.Steps()) == 0 || !value.IsNull() {
+			return true, nil
+		}
+
+		attribute, err := s.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		// Values which are not attributes, such as blocks, are not checked.
+		if err != nil || !attribute.IsComputed() {
+			return false, nil
+		}
+
+		if allowNullAttribute, ok := attribute.(fwxschema.AttributeWithAllowNullAfterApply); ok && allowNullAttribute.GetAllowNullAfterApply() {
+			return false, nil
+		}
+
+		nullPaths = append(nullPaths, tfTypePath)
+
+		return false, nil
+	})
+
+	if err != nil {
+		logging.FrameworkWarn(ctx, "Unable to check null computed attributes", map[string]interface{}{logging.KeyError: err.Error()})
+
+		return diags
+	}
+
+	// Object attributes are walked in an undefined order.
+	sort.Slice(nullPaths, func(i, j int) bool {
+		return nullPaths[i].String() < nullPaths[j].String()
+	})
+
+	for _, tfTypePath := range nullPaths {
+		summary := "Computed Attribute Not Set"
+		detail := "After applying the resource, this computed attribute is null in the resource state. " +
+			"This is typically caused by the provider not setting the value from the remote system response. " +
+			"This is always an issue with the provider and should be reported to the provider developers. " +
+			"If a null value is expected, the provider developer can set the attribute AllowNullAfterApply field."
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, s)
+
+		if fwPathDiags.HasError() {
+			diags.AddWarning(summary, detail+"\n\nAttribute Path: "+tfTypePath.String())
+
+			continue
+		}
+
+		diags.AddAttributeWarning(fwPath, summary, detail)
+	}
+
+	return diags
+}
@@ -0,0 +1,151 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestNullComputedAttributesDiags(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"computed_allow_null": schema.StringAttribute{
+				Computed:            true,
+				AllowNullAfterApply: true,
+			},
+			"optional": schema.StringAttribute{
+				Optional: true,
+			},
+			"nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"nested_computed": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"block": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"block_computed": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_computed": tftypes.String,
+		},
+	}
+
+	testBlockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"block_computed": tftypes.String,
+		},
+	}
+
+	testValue := func(computed any, nested any, block any) tftypes.Value {
+		return tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"computed":            tftypes.NewValue(tftypes.String, computed),
+			"computed_allow_null": tftypes.NewValue(tftypes.String, nil),
+			"optional":            tftypes.NewValue(tftypes.String, nil),
+			"nested":              tftypes.NewValue(tftypes.List{ElementType: testNestedType}, nested),
+			"block":               tftypes.NewValue(testBlockType, block),
+		})
+	}
+
+	testDiag := func(p path.Path) diag.Diagnostic {
+		return diag.NewAttributeWarningDiagnostic(
+			p,
+			"Computed Attribute Not Set",
+			"After applying the resource, this computed attribute is null in the resource state. "+
+				"This is typically caused by the provider not setting the value from the remote system response. "+
+				"This is always an issue with the provider and should be reported to the provider developers. "+
+				"If a null value is expected, the provider developer can set the attribute AllowNullAfterApply field.",
+		)
+	}
+
+	testCases := map[string]struct {
+		newState *tfsdk.State
+		expected diag.Diagnostics
+	}{
+		"nil": {},
+		"null": {
+			newState: &tfsdk.State{
+				Raw:    tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), nil),
+				Schema: testSchema,
+			},
+		},
+		"known": {
+			newState: &tfsdk.State{
+				Raw:    testValue("test", nil, nil),
+				Schema: testSchema,
+			},
+		},
+		"null-computed": {
+			newState: &tfsdk.State{
+				Raw:    testValue(nil, nil, nil),
+				Schema: testSchema,
+			},
+			expected: diag.Diagnostics{
+				testDiag(path.Root("computed")),
+			},
+		},
+		"null-nested-computed": {
+			newState: &tfsdk.State{
+				Raw: testValue(
+					"test",
+					[]tftypes.Value{
+						tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+							"nested_computed": tftypes.NewValue(tftypes.String, "test"),
+						}),
+						tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+							"nested_computed": tftypes.NewValue(tftypes.String, nil),
+						}),
+					},
+					map[string]tftypes.Value{
+						"block_computed": tftypes.NewValue(tftypes.String, nil),
+					},
+				),
+				Schema: testSchema,
+			},
+			expected: diag.Diagnostics{
+				testDiag(path.Root("block").AtName("block_computed")),
+				testDiag(path.Root("nested").AtListIndex(1).AtName("nested_computed")),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := nullComputedAttributesDiags(context.Background(), testSchema, testCase.newState)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
+// decryptPrivateState decrypts the provider private state data from
+// Terraform with the PrivateStateCodec, if set, before it is passed to
+// resource methods.
+func (s *Server) decryptPrivateState(ctx context.Context, private *privatestate.Data) diag.Diagnostics {
+	if s.PrivateStateCodec == nil || private == nil {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Decrypting private state with PrivateStateCodec")
+
+	return private.Decrypt(ctx, s.PrivateStateCodec)
+}
+
+// encryptPrivateState encrypts the provider private state data with the
+// PrivateStateCodec, if set, before it is returned to Terraform.
+func (s *Server) encryptPrivateState(ctx context.Context, private *privatestate.Data) diag.Diagnostics {
+	if s.PrivateStateCodec == nil || private == nil {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Encrypting private state with PrivateStateCodec")
+
+	return private.Encrypt(ctx, s.PrivateStateCodec)
+}
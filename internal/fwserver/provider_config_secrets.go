@@ -0,0 +1,100 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// resolveProviderConfigSecrets returns a copy of the provider configuration
+// where string attribute values are replaced with any values resolved by the
+// SecretResolver, along with all resolved values. Resolved values are
+// intentionally never logged and callers should always mask and redact the
+// returned values, regardless of whether the attribute is marked sensitive.
+func (s *Server) resolveProviderConfigSecrets(ctx context.Context, config tfsdk.Config) (tfsdk.Config, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var secretValues []string
+
+	if s.SecretResolver == nil || config.Schema == nil || config.Raw.IsNull() || !config.Raw.IsKnown() {
+		return config, secretValues, diags
+	}
+
+	resolvedRaw, err := tftypes.Transform(config.Raw, func(tfPath *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if !value.Type().Is(tftypes.String) || value.IsNull() || !value.IsKnown() {
+			return value, nil
+		}
+
+		// Only attribute values are resolved, not collection elements.
+		if _, err := config.Schema.AttributeAtTerraformPath(ctx, tfPath); err != nil {
+			return value, nil //nolint:nilerr // Intentionally ignoring the error
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfPath, config.Schema)
+
+		if fwPathDiags.HasError() {
+			diags.Append(fwPathDiags...)
+
+			return value, nil
+		}
+
+		var reference string
+
+		if err := value.As(&reference); err != nil {
+			return value, err
+		}
+
+		req := provider.ResolveSecretRequest{
+			Path:      fwPath,
+			Reference: reference,
+		}
+		resp := &provider.ResolveSecretResponse{}
+
+		s.SecretResolver.ResolveSecret(ctx, req, resp)
+
+		diags.Append(resp.Diagnostics...)
+
+		if resp.Value.IsNull() || resp.Value.IsUnknown() {
+			return value, nil
+		}
+
+		if resp.Value.ValueString() != "" {
+			secretValues = append(secretValues, resp.Value.ValueString())
+		}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Resolved provider configuration secret",
+			map[string]interface{}{
+				logging.KeyAttributePath: fwPath.String(),
+			},
+		)
+
+		return tftypes.NewValue(tftypes.String, resp.Value.ValueString()), nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Resolve Provider Configuration Secrets",
+			"An unexpected error was encountered while resolving the provider configuration secrets. "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return config, secretValues, diags
+	}
+
+	if diags.HasError() {
+		return config, secretValues, diags
+	}
+
+	return tfsdk.Config{
+		Raw:    resolvedRaw,
+		Schema: config.Schema,
+	}, secretValues, diags
+}
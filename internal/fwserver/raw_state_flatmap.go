@@ -0,0 +1,226 @@
+package fwserver
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// flatmapUnknownValue is the value Terraform 0.11 and earlier saved in
+// flatmap states for unknown values.
+const flatmapUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// unmarshalRawState returns the raw state as a value of the given type.
+// Unlike the tfprotov6.RawState type UnmarshalWithOpts method, flatmap states
+// written by Terraform 0.11 and earlier are converted using the type, which
+// enables state upgrades of resources migrated from older SDKs.
+func unmarshalRawState(rawState *tfprotov6.RawState, typ tftypes.Type, opts tfprotov6.UnmarshalOpts) (tftypes.Value, error) {
+	if rawState.JSON == nil && rawState.Flatmap != nil {
+		return flatmapValue(rawState.Flatmap, "", typ)
+	}
+
+	return rawState.UnmarshalWithOpts(typ, opts)
+}
+
+// flatmapValue returns the value of the given type at the flatmap key
+// prefix, which is either empty or ends with a period. Flatmap keys which are
+// not part of the type are ignored.
+func flatmapValue(flatmap map[string]string, prefix string, typ tftypes.Type) (tftypes.Value, error) {
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		if prefix != "" && !strings.HasSuffix(prefix, ".") {
+			prefix += "."
+		}
+
+		attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+		for name, attributeType := range typ.AttributeTypes {
+			attribute, err := flatmapValue(flatmap, prefix+name, attributeType)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			attributes[name] = attribute
+		}
+
+		return tftypes.NewValue(typ, attributes), nil
+	case tftypes.List:
+		return flatmapList(flatmap, prefix, typ, typ.ElementType)
+	case tftypes.Set:
+		return flatmapList(flatmap, prefix, typ, typ.ElementType)
+	case tftypes.Map:
+		return flatmapMap(flatmap, prefix, typ)
+	}
+
+	rawValue, ok := flatmap[prefix]
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if rawValue == flatmapUnknownValue {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, rawValue), nil
+	case typ.Is(tftypes.Bool):
+		value, err := strconv.ParseBool(rawValue)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("invalid flatmap bool value at %q: %w", prefix, err)
+		}
+
+		return tftypes.NewValue(typ, value), nil
+	case typ.Is(tftypes.Number):
+		value, _, err := big.ParseFloat(rawValue, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("invalid flatmap number value at %q: %w", prefix, err)
+		}
+
+		return tftypes.NewValue(typ, value), nil
+	}
+
+	return tftypes.Value{}, fmt.Errorf("unsupported flatmap type %s at %q", typ, prefix)
+}
+
+// flatmapList returns the list or set value at the flatmap key prefix. The
+// element count is saved at the "#" key and elements at their index, or hash
+// code for sets.
+func flatmapList(flatmap map[string]string, prefix string, typ tftypes.Type, elementType tftypes.Type) (tftypes.Value, error) {
+	rawCount, ok := flatmap[prefix+".#"]
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if rawCount == flatmapUnknownValue {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	count, err := strconv.Atoi(rawCount)
+
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("invalid flatmap element count at %q: %w", prefix+".#", err)
+	}
+
+	keys := flatmapElementKeys(flatmap, prefix, "#", true)
+
+	if len(keys) > count {
+		keys = keys[:count]
+	}
+
+	elements := make([]tftypes.Value, 0, len(keys))
+
+	for _, key := range keys {
+		element, err := flatmapElement(flatmap, prefix+"."+key, elementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// flatmapMap returns the map value at the flatmap key prefix. The element
+// count is saved at the "%" key and elements at their map key.
+func flatmapMap(flatmap map[string]string, prefix string, typ tftypes.Map) (tftypes.Value, error) {
+	rawCount, ok := flatmap[prefix+".%"]
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if rawCount == flatmapUnknownValue {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	elements := make(map[string]tftypes.Value)
+
+	var nestedElements bool
+
+	switch typ.ElementType.(type) {
+	case tftypes.List, tftypes.Map, tftypes.Object, tftypes.Set:
+		nestedElements = true
+	}
+
+	for _, key := range flatmapElementKeys(flatmap, prefix, "%", nestedElements) {
+		element, err := flatmapElement(flatmap, prefix+"."+key, typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements[key] = element
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// flatmapElement returns the collection element value at the flatmap key.
+// Object elements are saved underneath the key, while other elements are
+// saved at the key.
+func flatmapElement(flatmap map[string]string, key string, elementType tftypes.Type) (tftypes.Value, error) {
+	if _, ok := elementType.(tftypes.Object); ok {
+		return flatmapValue(flatmap, key+".", elementType)
+	}
+
+	return flatmapValue(flatmap, key, elementType)
+}
+
+// flatmapElementKeys returns the sorted element keys underneath the flatmap
+// key prefix, excluding the given count key. If nested is true, keys are
+// truncated at the first period, since elements are saved underneath the
+// key. Numeric keys, such as list indices, are sorted numerically.
+func flatmapElementKeys(flatmap map[string]string, prefix string, countKey string, nested bool) []string {
+	keySet := make(map[string]struct{})
+
+	for flatmapKey := range flatmap {
+		if !strings.HasPrefix(flatmapKey, prefix+".") {
+			continue
+		}
+
+		key := strings.TrimPrefix(flatmapKey, prefix+".")
+
+		if key == countKey {
+			continue
+		}
+
+		// Nested collection or object elements, such as list.0.name.
+		if index := strings.Index(key, "."); nested && index != -1 {
+			key = key[:index]
+		}
+
+		keySet[key] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(keySet))
+
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		iIndex, iErr := strconv.Atoi(keys[i])
+		jIndex, jErr := strconv.Atoi(keys[j])
+
+		if iErr == nil && jErr == nil {
+			return iIndex < jIndex
+		}
+
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
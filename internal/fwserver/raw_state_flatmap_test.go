@@ -0,0 +1,160 @@
+package fwserver
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnmarshalRawStateFlatmap(t *testing.T) {
+	t.Parallel()
+
+	ruleType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"port":     tftypes.Number,
+			"protocol": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"id":      tftypes.String,
+			"labels":  tftypes.Map{ElementType: tftypes.String},
+			"missing": tftypes.String,
+			"names":   tftypes.Set{ElementType: tftypes.String},
+			"rule":    tftypes.List{ElementType: ruleType},
+			"size":    tftypes.Number,
+			"unknown": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		rawState      *tfprotov6.RawState
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"flatmap": {
+			rawState: &tfprotov6.RawState{
+				Flatmap: map[string]string{
+					"enabled":         "true",
+					"id":              "test-id",
+					"labels.%":        "2",
+					"labels.a.b":      "dotted",
+					"labels.c":        "plain",
+					"names.#":         "2",
+					"names.1234":      "one",
+					"names.5678":      "two",
+					"rule.#":          "2",
+					"rule.0.port":     "80",
+					"rule.0.protocol": "tcp",
+					"rule.1.port":     "443",
+					"rule.1.protocol": "tcp",
+					"size":            "1.5",
+					"unknown":         flatmapUnknownValue,
+				},
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"id":      tftypes.NewValue(tftypes.String, "test-id"),
+				"labels": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"a.b": tftypes.NewValue(tftypes.String, "dotted"),
+					"c":   tftypes.NewValue(tftypes.String, "plain"),
+				}),
+				"missing": tftypes.NewValue(tftypes.String, nil),
+				"names": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+				"rule": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{
+					tftypes.NewValue(ruleType, map[string]tftypes.Value{
+						"port":     tftypes.NewValue(tftypes.Number, big.NewFloat(80)),
+						"protocol": tftypes.NewValue(tftypes.String, "tcp"),
+					}),
+					tftypes.NewValue(ruleType, map[string]tftypes.Value{
+						"port":     tftypes.NewValue(tftypes.Number, big.NewFloat(443)),
+						"protocol": tftypes.NewValue(tftypes.String, "tcp"),
+					}),
+				}),
+				"size":    tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+				"unknown": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"flatmap-empty-collections": {
+			rawState: &tfprotov6.RawState{
+				Flatmap: map[string]string{
+					"id":       "test-id",
+					"labels.%": "0",
+					"names.#":  flatmapUnknownValue,
+					"rule.#":   "0",
+				},
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, nil),
+				"id":      tftypes.NewValue(tftypes.String, "test-id"),
+				"labels":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
+				"missing": tftypes.NewValue(tftypes.String, nil),
+				"names":   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"rule":    tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{}),
+				"size":    tftypes.NewValue(tftypes.Number, nil),
+				"unknown": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"flatmap-invalid-bool": {
+			rawState: &tfprotov6.RawState{
+				Flatmap: map[string]string{
+					"enabled": "invalid",
+				},
+			},
+			expectedError: `invalid flatmap bool value at "enabled": strconv.ParseBool: parsing "invalid": invalid syntax`,
+		},
+		"json": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{"id": "test-id"}`),
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, nil),
+				"id":      tftypes.NewValue(tftypes.String, "test-id"),
+				"labels":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"missing": tftypes.NewValue(tftypes.String, nil),
+				"names":   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"rule":    tftypes.NewValue(tftypes.List{ElementType: ruleType}, nil),
+				"size":    tftypes.NewValue(tftypes.Number, nil),
+				"unknown": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := unmarshalRawState(testCase.rawState, testType, tfprotov6.UnmarshalOpts{})
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package fwserver
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// applyCoordinator tracks the in-progress create, update, and delete
+// operations of each resource type, so resources implementing the
+// ResourceWithApplyDependsOn interface can wait for operations of other
+// resource types to finish. The zero value is ready for use.
+type applyCoordinator struct {
+	// changed is closed and replaced whenever an operation finishes, waking
+	// any waiting operations.
+	changed chan struct{}
+
+	// inProgress is the number of in-progress operations by resource type
+	// name.
+	inProgress map[string]int
+
+	// mutex protects concurrent access to the other fields.
+	mutex sync.Mutex
+}
+
+// acquire waits until no operations of the given resource types are in
+// progress, then records an in-progress operation of the resource type. The
+// returned function must be called once the operation finishes. An error is
+// returned if the context is cancelled while waiting.
+func (c *applyCoordinator) acquire(ctx context.Context, typeName string, applyDependsOn []string) (func(), error) {
+	c.mutex.Lock()
+
+	for {
+		if c.inProgress == nil {
+			c.inProgress = make(map[string]int)
+			c.changed = make(chan struct{})
+		}
+
+		var waitingOn []string
+
+		for _, applyDependsOnTypeName := range applyDependsOn {
+			if c.inProgress[applyDependsOnTypeName] > 0 {
+				waitingOn = append(waitingOn, applyDependsOnTypeName)
+			}
+		}
+
+		if len(waitingOn) == 0 {
+			c.inProgress[typeName]++
+			c.mutex.Unlock()
+
+			return func() { c.release(typeName) }, nil
+		}
+
+		changed := c.changed
+
+		c.mutex.Unlock()
+
+		logging.FrameworkDebug(ctx, "Waiting for in-progress operations of resource types: "+strings.Join(waitingOn, ", "))
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		c.mutex.Lock()
+	}
+}
+
+// release records that an operation of the resource type finished.
+func (c *applyCoordinator) release(typeName string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.inProgress[typeName]--
+
+	if c.inProgress[typeName] <= 0 {
+		delete(c.inProgress, typeName)
+	}
+
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// acquireApply records an in-progress operation of the resource type, first
+// waiting for in-progress operations of the resource types declared by the
+// ResourceWithApplyDependsOn interface, if implemented. The returned function
+// must be called once the operation finishes.
+func (s *Server) acquireApply(ctx context.Context, typeName string, r resource.Resource) (func(), diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var applyDependsOn []string
+
+	// Legacy type names share the operations of the current type name.
+	if currentTypeName, ok := s.ResourceLegacyTypeName(ctx, typeName); ok {
+		typeName = currentTypeName
+	}
+
+	if resourceWithApplyDependsOn, ok := r.(resource.ResourceWithApplyDependsOn); ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithApplyDependsOn")
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource ApplyDependsOn")
+		applyDependsOn = resourceWithApplyDependsOn.ApplyDependsOn(ctx)
+		logging.FrameworkDebug(ctx, "Called provider defined Resource ApplyDependsOn")
+	}
+
+	release, err := s.applyCoordinator.acquire(ctx, typeName, applyDependsOn)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Apply Resource Change",
+			"The operation was cancelled while waiting for in-progress operations of other resource types to finish, "+
+				"which the provider requires before this resource type can be changed.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return func() {}, diags
+	}
+
+	return release, diags
+}
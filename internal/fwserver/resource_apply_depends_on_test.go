@@ -0,0 +1,119 @@
+package fwserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestApplyCoordinatorAcquire(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no-in-progress", func(t *testing.T) {
+		t.Parallel()
+
+		var c applyCoordinator
+
+		release, err := c.acquire(context.Background(), "test_x", []string{"test_y"})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		release()
+
+		if len(c.inProgress) != 0 {
+			t.Errorf("unexpected in-progress operations: %v", c.inProgress)
+		}
+	})
+
+	t.Run("unrelated-in-progress", func(t *testing.T) {
+		t.Parallel()
+
+		var c applyCoordinator
+
+		releaseZ, err := c.acquire(context.Background(), "test_z", nil)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		defer releaseZ()
+
+		releaseX, err := c.acquire(context.Background(), "test_x", []string{"test_y"})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		releaseX()
+	})
+
+	t.Run("waits-for-in-progress", func(t *testing.T) {
+		t.Parallel()
+
+		var c applyCoordinator
+
+		releaseY, err := c.acquire(context.Background(), "test_y", nil)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		acquired := make(chan struct{})
+
+		go func() {
+			releaseX, err := c.acquire(context.Background(), "test_x", []string{"test_y"})
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			close(acquired)
+			releaseX()
+		}()
+
+		select {
+		case <-acquired:
+			t.Fatal("expected test_x to wait for test_y")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		releaseY()
+
+		select {
+		case <-acquired:
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected test_x to acquire after test_y finished")
+		}
+	})
+
+	t.Run("context-cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		var c applyCoordinator
+
+		releaseY, err := c.acquire(context.Background(), "test_y", nil)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		defer releaseY()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		cancel()
+
+		_, err = c.acquire(ctx, "test_x", []string{"test_y"})
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled error, got: %v", err)
+		}
+
+		if c.inProgress["test_x"] != 0 {
+			t.Errorf("unexpected in-progress test_x operations: %d", c.inProgress["test_x"])
+		}
+	})
+}
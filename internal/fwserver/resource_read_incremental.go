@@ -0,0 +1,218 @@
+package fwserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// privateStateKeyReadFingerprints is the framework private state key which
+// contains the fingerprints of each top level attribute and block value
+// following the last refresh of a resource that implements
+// resource.ResourceWithReadIncremental.
+const privateStateKeyReadFingerprints = ".read_fingerprints"
+
+// privateStateKeyReadFullRefresh is the framework private state key which
+// contains the time of the last full refresh of a resource that implements
+// resource.ResourceWithReadIncremental.
+const privateStateKeyReadFullRefresh = ".read_full_refresh"
+
+// readIncrementalMaxAge returns the maximum duration since the last full
+// refresh of the resource, or zero if there is no maximum.
+func readIncrementalMaxAge(ctx context.Context, r resource.ResourceWithReadIncremental) time.Duration {
+	maxAge := resource.DefaultReadIncrementalMaxAge
+
+	if resourceWithMaxAge, ok := r.(resource.ResourceWithReadIncrementalMaxAge); ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithReadIncrementalMaxAge")
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource ReadIncrementalMaxAge")
+		resourceMaxAge := resourceWithMaxAge.ReadIncrementalMaxAge(ctx)
+		logging.FrameworkDebug(ctx, "Called provider defined Resource ReadIncrementalMaxAge")
+
+		switch {
+		case resourceMaxAge < 0:
+			return 0
+		case resourceMaxAge > 0:
+			maxAge = resourceMaxAge
+		}
+	}
+
+	return maxAge
+}
+
+// readFingerprints returns a fingerprint of each top level attribute and block
+// value of the resource state, keyed by name.
+func readFingerprints(state tftypes.Value) (map[string]string, error) {
+	var attributes map[string]tftypes.Value
+
+	if err := state.As(&attributes); err != nil {
+		return nil, err
+	}
+
+	fingerprints := make(map[string]string, len(attributes))
+
+	for name, attribute := range attributes {
+		dynamicValue, err := tfprotov6.NewDynamicValue(attribute.Type(), attribute)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		sum := sha256.Sum256(dynamicValue.MsgPack)
+
+		fingerprints[name] = hex.EncodeToString(sum[:])
+	}
+
+	return fingerprints, nil
+}
+
+// readIncrementalChangedPaths returns the paths to values in the current state which
+// were changed since the last refresh, based on the recorded fingerprints in
+// the framework private state data. If no fingerprints were recorded or the
+// last full refresh is older than the maximum age, false is returned as the
+// resource must be fully refreshed.
+func readIncrementalChangedPaths(ctx context.Context, private *privatestate.Data, state tftypes.Value, maxAge time.Duration) (path.Paths, bool) {
+	if private == nil || len(private.Framework[privateStateKeyReadFingerprints]) == 0 {
+		return nil, false
+	}
+
+	var lastFullRefresh time.Time
+
+	if err := json.Unmarshal(private.Framework[privateStateKeyReadFullRefresh], &lastFullRefresh); err != nil {
+		logging.FrameworkDebug(ctx, "Missing or invalid last full refresh time in private state, fully refreshing")
+
+		return nil, false
+	}
+
+	if maxAge > 0 && time.Since(lastFullRefresh) > maxAge {
+		logging.FrameworkDebug(ctx, "Last full refresh is older than the maximum age, fully refreshing", map[string]interface{}{
+			"last_full_refresh": lastFullRefresh.Format(time.RFC3339),
+			"max_age":           maxAge.String(),
+		})
+
+		return nil, false
+	}
+
+	var priorFingerprints map[string]string
+
+	if err := json.Unmarshal(private.Framework[privateStateKeyReadFingerprints], &priorFingerprints); err != nil {
+		logging.FrameworkWarn(ctx, "Ignoring invalid read fingerprints in private state", map[string]interface{}{logging.KeyError: err.Error()})
+
+		return nil, false
+	}
+
+	currentFingerprints, err := readFingerprints(state)
+
+	if err != nil {
+		logging.FrameworkWarn(ctx, "Unable to fingerprint current state", map[string]interface{}{logging.KeyError: err.Error()})
+
+		return nil, false
+	}
+
+	names := make([]string, 0, len(currentFingerprints))
+
+	for name, fingerprint := range currentFingerprints {
+		if priorFingerprints[name] == fingerprint {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	paths := make(path.Paths, 0, len(names))
+
+	for _, name := range names {
+		paths = append(paths, path.Root(name))
+	}
+
+	return paths, true
+}
+
+// setReadFingerprints records the fingerprints of the new state in the
+// framework private state data, along with the current time if the resource
+// was fully refreshed. The fingerprints are removed if the resource was
+// removed from state.
+func setReadFingerprints(private *privatestate.Data, state tftypes.Value, fullRefresh bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state.IsNull() || !state.IsFullyKnown() {
+		delete(private.Framework, privateStateKeyReadFingerprints)
+		delete(private.Framework, privateStateKeyReadFullRefresh)
+
+		return diags
+	}
+
+	fingerprints, err := readFingerprints(state)
+
+	if err != nil {
+		diags.Append(readFingerprintsErrorDiag(err))
+
+		return diags
+	}
+
+	value, err := json.Marshal(fingerprints)
+
+	if err != nil {
+		diags.Append(readFingerprintsErrorDiag(err))
+
+		return diags
+	}
+
+	if private.Framework == nil {
+		private.Framework = make(map[string][]byte, 1)
+	}
+
+	private.Framework[privateStateKeyReadFingerprints] = value
+
+	if !fullRefresh {
+		return diags
+	}
+
+	lastFullRefresh, err := json.Marshal(time.Now().UTC())
+
+	if err != nil {
+		diags.Append(readFingerprintsErrorDiag(err))
+
+		return diags
+	}
+
+	private.Framework[privateStateKeyReadFullRefresh] = lastFullRefresh
+
+	return diags
+}
+
+// readFingerprintsErrorDiag returns an error diagnostic for unexpected errors
+// while recording the read fingerprints.
+func readFingerprintsErrorDiag(err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Error Recording Resource Read Fingerprints",
+		"An unexpected error was encountered when recording the resource state fingerprints for incremental reads. "+
+			"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// pathsStrings returns the string representation of each path, for logging.
+func pathsStrings(paths path.Paths) []string {
+	result := make([]string, 0, len(paths))
+
+	for _, p := range paths {
+		result = append(result, p.String())
+	}
+
+	return result
+}
@@ -0,0 +1,143 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// canonicalizeState applies the state canonicalizers of the resource, if it
+// implements the ResourceWithStateCanonicalizers interface, to the state. If
+// the planned state is given, values which are known in the planned state are
+// skipped, since Terraform requires the new state to match them. If the prior
+// state is given, such as during Read, values which have the same canonical
+// form as the prior state value keep the prior state value, since it may be
+// the configured value and canonicalizing it would cause a perpetual diff.
+func canonicalizeState(ctx context.Context, r resource.Resource, plannedState *tfsdk.Plan, priorState *tfsdk.State, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceWithStateCanonicalizers, ok := r.(resource.ResourceWithStateCanonicalizers)
+
+	if !ok || state == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithStateCanonicalizers")
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Resource StateCanonicalizers")
+	stateCanonicalizers := resourceWithStateCanonicalizers.StateCanonicalizers(ctx)
+	logging.FrameworkDebug(ctx, "Called provider defined Resource StateCanonicalizers")
+
+	for _, stateCanonicalizer := range stateCanonicalizers {
+		if stateCanonicalizer.Canonicalize == nil {
+			continue
+		}
+
+		matchedPaths, matchedPathsDiags := state.PathMatches(ctx, stateCanonicalizer.PathExpression)
+
+		diags.Append(matchedPathsDiags...)
+
+		if matchedPathsDiags.HasError() {
+			return diags
+		}
+
+		for _, matchedPath := range matchedPaths {
+			ctx := logging.FrameworkWithAttributePath(ctx, matchedPath.String())
+
+			var value attr.Value
+
+			getAttributeDiags := state.GetAttribute(ctx, matchedPath, &value)
+
+			diags.Append(getAttributeDiags...)
+
+			if getAttributeDiags.HasError() {
+				return diags
+			}
+
+			if value == nil || value.IsNull() || value.IsUnknown() {
+				continue
+			}
+
+			if plannedState != nil {
+				var plannedValue attr.Value
+
+				// Values which cannot be found in the plan, such as within
+				// unknown parent values, were not known in the plan.
+				getPlannedAttributeDiags := plannedState.GetAttribute(ctx, matchedPath, &plannedValue)
+
+				if !getPlannedAttributeDiags.HasError() && plannedValue != nil && !plannedValue.IsUnknown() {
+					logging.FrameworkTrace(ctx, "Skipping state canonicalization of value known in plan")
+
+					continue
+				}
+			}
+
+			canonicalValue, canonicalizeDiags := canonicalizeValue(ctx, stateCanonicalizer, matchedPath, value)
+
+			diags.Append(canonicalizeDiags...)
+
+			if canonicalizeDiags.HasError() {
+				return diags
+			}
+
+			if priorState != nil && !priorState.Raw.IsNull() {
+				var priorValue attr.Value
+
+				// Values which cannot be found in the prior state, such as
+				// new list elements, have no prior form to preserve.
+				getPriorAttributeDiags := priorState.GetAttribute(ctx, matchedPath, &priorValue)
+
+				if !getPriorAttributeDiags.HasError() && priorValue != nil && !priorValue.IsNull() && !priorValue.IsUnknown() && priorValue.Type(ctx).Equal(value.Type(ctx)) {
+					canonicalPriorValue, canonicalizePriorDiags := canonicalizeValue(ctx, stateCanonicalizer, matchedPath, priorValue)
+
+					// Prior state values which cannot be canonicalized, such
+					// as invalid JSON, are not preserved.
+					if !canonicalizePriorDiags.HasError() && canonicalPriorValue.Equal(canonicalValue) {
+						canonicalValue = priorValue
+					}
+				}
+			}
+
+			if canonicalValue.Equal(value) {
+				continue
+			}
+
+			logging.FrameworkDebug(ctx, "State updated due to state canonicalization")
+
+			diags.Append(state.SetAttribute(ctx, matchedPath, canonicalValue)...)
+
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+// canonicalizeValue returns the value after calling the state canonicalizer.
+// The given value is returned if the canonicalizer does not set a value.
+func canonicalizeValue(ctx context.Context, stateCanonicalizer resource.StateCanonicalizer, p path.Path, value attr.Value) (attr.Value, diag.Diagnostics) {
+	canonicalizeReq := resource.CanonicalizeStateRequest{
+		Path:  p,
+		Value: value,
+	}
+	canonicalizeResp := &resource.CanonicalizeStateResponse{
+		Value: value,
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined StateCanonicalizer")
+	stateCanonicalizer.Canonicalize(ctx, canonicalizeReq, canonicalizeResp)
+	logging.FrameworkDebug(ctx, "Called provider defined StateCanonicalizer")
+
+	if canonicalizeResp.Value == nil {
+		return value, canonicalizeResp.Diagnostics
+	}
+
+	return canonicalizeResp.Value, canonicalizeResp.Diagnostics
+}
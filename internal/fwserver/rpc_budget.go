@@ -0,0 +1,131 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// RPCBudget is the development mode wall time and allocation budget of each
+// RPC. Zero values disable the associated check.
+type RPCBudget struct {
+	// MaxProcessAllocatedBytes is the maximum number of heap bytes allocated
+	// by the whole provider process while handling an RPC. This is a rough
+	// signal for debugging only, since concurrent RPCs and background work
+	// are included and reading the process memory statistics briefly stops
+	// the world.
+	MaxProcessAllocatedBytes uint64
+
+	// MaxDuration is the maximum wall time of handling an RPC.
+	MaxDuration time.Duration
+}
+
+// RPCBudgetMeasurement is the state at the beginning of an RPC, which is
+// compared against the RPCBudget when the RPC finishes.
+type RPCBudgetMeasurement struct {
+	rpc        string
+	start      time.Time
+	totalAlloc uint64
+	typeName   string
+}
+
+// Enabled returns true if any budget check is enabled.
+func (b RPCBudget) Enabled() bool {
+	return b.MaxProcessAllocatedBytes > 0 || b.MaxDuration > 0
+}
+
+// Start returns the measurement for the beginning of an RPC. Protocol
+// specific implementations should call this at the beginning of each RPC and
+// Finish after the RPC response is created.
+func (b RPCBudget) Start(rpc string, typeName string) RPCBudgetMeasurement {
+	measurement := RPCBudgetMeasurement{
+		rpc:      rpc,
+		typeName: typeName,
+	}
+
+	if b.MaxProcessAllocatedBytes > 0 {
+		measurement.totalAlloc = processAllocatedBytes()
+	}
+
+	measurement.start = time.Now()
+
+	return measurement
+}
+
+// Finish returns warning diagnostics if the RPC which began with the given
+// measurement exceeded the budget. The allocated bytes are measured across
+// the entire provider process, so RPCs which Terraform sends concurrently
+// can increase each others measurement.
+func (b RPCBudget) Finish(ctx context.Context, measurement RPCBudgetMeasurement) diag.Diagnostics {
+	duration := time.Since(measurement.start)
+
+	var allocatedBytes uint64
+
+	if b.MaxProcessAllocatedBytes > 0 {
+		allocatedBytes = processAllocatedBytes() - measurement.totalAlloc
+	}
+
+	return b.diagnostics(ctx, measurement, duration, allocatedBytes)
+}
+
+// diagnostics returns warning diagnostics for the given RPC duration and
+// allocated bytes which exceed the budget.
+func (b RPCBudget) diagnostics(ctx context.Context, measurement RPCBudgetMeasurement, duration time.Duration, allocatedBytes uint64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rpcDescription := measurement.rpc
+
+	if measurement.typeName != "" {
+		rpcDescription += " " + measurement.typeName
+	}
+
+	logFields := map[string]interface{}{
+		"rpc":                     measurement.rpc,
+		"duration":                duration.String(),
+		"process_allocated_bytes": allocatedBytes,
+	}
+
+	if measurement.typeName != "" {
+		logFields["type_name"] = measurement.typeName
+	}
+
+	if b.MaxDuration > 0 && duration > b.MaxDuration {
+		logging.FrameworkWarn(ctx, "RPC exceeded development mode duration budget", logFields)
+
+		diags.AddWarning(
+			"RPC Duration Budget Exceeded",
+			fmt.Sprintf("The %s request took %s, which exceeds the development mode budget of %s. ", rpcDescription, duration, b.MaxDuration)+
+				"Review the provider logic for unexpectedly slow operations, such as repeated API calls or conversions of large collections.\n\n"+
+				"This warning is only returned while the provider is running in debug mode with an RPC budget.",
+		)
+	}
+
+	if b.MaxProcessAllocatedBytes > 0 && allocatedBytes > b.MaxProcessAllocatedBytes {
+		logging.FrameworkWarn(ctx, "RPC exceeded development mode allocation budget", logFields)
+
+		diags.AddWarning(
+			"RPC Allocation Budget Exceeded",
+			fmt.Sprintf("The provider process allocated %d bytes while handling the %s request, which exceeds the development mode budget of %d bytes. ", allocatedBytes, rpcDescription, b.MaxProcessAllocatedBytes)+
+				"Review the provider logic for unexpectedly large allocations, such as repeated conversions of large collections with ElementsAs. "+
+				"Allocations are measured across the whole provider process, so concurrent requests and background work are included and this is only a rough signal.\n\n"+
+				"This warning is only returned while the provider is running in debug mode with an RPC budget.",
+		)
+	}
+
+	return diags
+}
+
+// processAllocatedBytes returns the cumulative bytes allocated for heap
+// objects by the process. runtime.ReadMemStats stops the world, so this
+// should only be called while the RPC budget is enabled in debug mode.
+func processAllocatedBytes() uint64 {
+	var memStats runtime.MemStats
+
+	runtime.ReadMemStats(&memStats)
+
+	return memStats.TotalAlloc
+}
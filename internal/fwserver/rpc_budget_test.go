@@ -0,0 +1,115 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestRPCBudgetDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testMeasurement := RPCBudgetMeasurement{
+		rpc:      "ReadResource",
+		typeName: "test_resource",
+	}
+
+	testCases := map[string]struct {
+		budget         RPCBudget
+		measurement    RPCBudgetMeasurement
+		duration       time.Duration
+		allocatedBytes uint64
+		expected       diag.Diagnostics
+	}{
+		"disabled": {
+			budget:         RPCBudget{},
+			measurement:    testMeasurement,
+			duration:       time.Hour,
+			allocatedBytes: 1 << 40,
+			expected:       nil,
+		},
+		"within-budget": {
+			budget: RPCBudget{
+				MaxProcessAllocatedBytes: 1024,
+				MaxDuration:              time.Second,
+			},
+			measurement:    testMeasurement,
+			duration:       time.Second,
+			allocatedBytes: 1024,
+			expected:       nil,
+		},
+		"duration-exceeded": {
+			budget: RPCBudget{
+				MaxDuration: time.Second,
+			},
+			measurement: testMeasurement,
+			duration:    2 * time.Second,
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"RPC Duration Budget Exceeded",
+					"The ReadResource test_resource request took 2s, which exceeds the development mode budget of 1s. "+
+						"Review the provider logic for unexpectedly slow operations, such as repeated API calls or conversions of large collections.\n\n"+
+						"This warning is only returned while the provider is running in debug mode with an RPC budget.",
+				),
+			},
+		},
+		"allocated-bytes-exceeded": {
+			budget: RPCBudget{
+				MaxProcessAllocatedBytes: 1024,
+			},
+			measurement: RPCBudgetMeasurement{
+				rpc: "GetProviderSchema",
+			},
+			allocatedBytes: 2048,
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"RPC Allocation Budget Exceeded",
+					"The provider process allocated 2048 bytes while handling the GetProviderSchema request, which exceeds the development mode budget of 1024 bytes. "+
+						"Review the provider logic for unexpectedly large allocations, such as repeated conversions of large collections with ElementsAs. "+
+						"Allocations are measured across the whole provider process, so concurrent requests and background work are included and this is only a rough signal.\n\n"+
+						"This warning is only returned while the provider is running in debug mode with an RPC budget.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.budget.diagnostics(context.Background(), testCase.measurement, testCase.duration, testCase.allocatedBytes)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRPCBudgetFinish(t *testing.T) {
+	t.Parallel()
+
+	budget := RPCBudget{
+		MaxProcessAllocatedBytes: 1,
+	}
+
+	measurement := budget.Start("GetProviderSchema", "")
+
+	allocations := make([][]byte, 0, 100)
+
+	for i := 0; i < 100; i++ {
+		allocations = append(allocations, make([]byte, 1024))
+	}
+
+	diags := budget.Finish(context.Background(), measurement)
+
+	if len(allocations) != 100 || len(diags) != 1 || diags[0].Summary() != "RPC Allocation Budget Exceeded" {
+		t.Errorf("expected allocation budget warning, got: %v", diags)
+	}
+}
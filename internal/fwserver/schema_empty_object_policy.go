@@ -0,0 +1,157 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SchemaEmptyObjectPolicyRequest represents a request for the framework to
+// normalize object values on schema-based data based on the attribute empty
+// object policy.
+type SchemaEmptyObjectPolicyRequest struct {
+	// PlannedData is the planned schema-based data, if available. Values are
+	// only normalized if the planned value is unknown, not present, or equal
+	// to the normalized value.
+	PlannedData fwschemadata.Data
+
+	// PriorData is the prior schema-based data, if available, such as the
+	// current state during Read. Values are only normalized if the prior
+	// value is not present or equal to the normalized value, since the prior
+	// value may be the configured value.
+	PriorData fwschemadata.Data
+
+	// ProposedNewData is the new schema-based data, such as the state
+	// returned by the provider.
+	ProposedNewData fwschemadata.Data
+}
+
+// SchemaEmptyObjectPolicyResponse represents a response to a
+// SchemaEmptyObjectPolicyRequest.
+type SchemaEmptyObjectPolicyResponse struct {
+	// NewData is the new schema-based data after normalization.
+	NewData fwschemadata.Data
+
+	// Diagnostics report errors or warnings related to normalizing values.
+	Diagnostics diag.Diagnostics
+}
+
+// SchemaEmptyObjectPolicy normalizes object values in the proposed new data
+// for attributes which implement the AttributeWithEmptyObjectPolicy
+// interface. Depending on the policy, a known object where all attributes
+// are null is replaced with a null object, or a null object is replaced with
+// an object where all attributes are null. Unknown values are never changed.
+func SchemaEmptyObjectPolicy(ctx context.Context, req SchemaEmptyObjectPolicyRequest, resp *SchemaEmptyObjectPolicyResponse) {
+	if req.ProposedNewData.Schema == nil || req.ProposedNewData.TerraformValue.Type() == nil {
+		return
+	}
+
+	newValue, err := tftypes.Transform(req.ProposedNewData.TerraformValue, func(tfTypePath *tftypes.AttributePath, proposedNewValue tftypes.Value) (tftypes.Value, error) {
+		objectType, ok := proposedNewValue.Type().(tftypes.Object)
+
+		if !ok || !proposedNewValue.IsKnown() || len(tfTypePath.Steps()) == 0 {
+			return proposedNewValue, nil
+		}
+
+		attribute, err := req.ProposedNewData.Schema.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		// Values which are not attributes, such as blocks or elements of
+		// nested attributes, do not have an empty object policy.
+		if err != nil {
+			return proposedNewValue, nil //nolint:nilerr // Intentionally ignoring the error
+		}
+
+		policyAttribute, ok := attribute.(fwxschema.AttributeWithEmptyObjectPolicy)
+
+		if !ok {
+			return proposedNewValue, nil
+		}
+
+		var normalizedValue tftypes.Value
+
+		switch policyAttribute.GetEmptyObjectPolicy() {
+		case fwschema.EmptyObjectPolicyNull:
+			if proposedNewValue.IsNull() {
+				return proposedNewValue, nil
+			}
+
+			var attributes map[string]tftypes.Value
+
+			if err := proposedNewValue.As(&attributes); err != nil {
+				return proposedNewValue, err
+			}
+
+			for _, attributeValue := range attributes {
+				if !attributeValue.IsNull() {
+					return proposedNewValue, nil
+				}
+			}
+
+			normalizedValue = tftypes.NewValue(objectType, nil)
+		case fwschema.EmptyObjectPolicyEmpty:
+			if !proposedNewValue.IsNull() {
+				return proposedNewValue, nil
+			}
+
+			attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+			for name, attributeType := range objectType.AttributeTypes {
+				attributes[name] = tftypes.NewValue(attributeType, nil)
+			}
+
+			normalizedValue = tftypes.NewValue(objectType, attributes)
+		default:
+			return proposedNewValue, nil
+		}
+
+		// Values which are present in the plan must remain consistent
+		// with the plan, unless the planned value is unknown.
+		if !emptyObjectPolicyNormalizable(req.PlannedData.TerraformValue, tfTypePath, normalizedValue) {
+			return proposedNewValue, nil
+		}
+
+		// Values which are present in the prior data must remain consistent
+		// with the prior data, otherwise configured values would be
+		// normalized on every refresh.
+		if !emptyObjectPolicyNormalizable(req.PriorData.TerraformValue, tfTypePath, normalizedValue) {
+			return proposedNewValue, nil
+		}
+
+		return normalizedValue, nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Empty Object Policy Error",
+			"An unexpected error was encountered while applying empty object policies. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.NewData.TerraformValue = newValue
+}
+
+// emptyObjectPolicyNormalizable returns false if the reference data contains
+// a known value at the path which differs from the normalized value.
+func emptyObjectPolicyNormalizable(reference tftypes.Value, tfTypePath *tftypes.AttributePath, normalizedValue tftypes.Value) bool {
+	if reference.Type() == nil || reference.IsNull() {
+		return true
+	}
+
+	referenceValueRaw, remaining, err := tftypes.WalkAttributePath(reference, tfTypePath)
+
+	if err != nil || len(remaining.Steps()) != 0 {
+		return true
+	}
+
+	referenceValue, ok := referenceValueRaw.(tftypes.Value)
+
+	return !ok || !referenceValue.IsKnown() || referenceValue.Equal(normalizedValue)
+}
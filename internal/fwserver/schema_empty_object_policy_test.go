@@ -0,0 +1,264 @@
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestSchemaEmptyObjectPolicy(t *testing.T) {
+	t.Parallel()
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested": tftypes.String,
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": testNestedType,
+		},
+	}
+
+	testSchema := func(policy schema.EmptyObjectPolicy) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"test_attribute": schema.SingleNestedAttribute{
+					Attributes: map[string]schema.Attribute{
+						"test_nested": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Optional:          true,
+					Computed:          true,
+					EmptyObjectPolicy: policy,
+				},
+			},
+		}
+	}
+
+	testValue := func(nested tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_attribute": nested,
+		})
+	}
+
+	testNull := tftypes.NewValue(testNestedType, nil)
+	testUnknown := tftypes.NewValue(testNestedType, tftypes.UnknownValue)
+	testEmpty := tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+		"test_nested": tftypes.NewValue(tftypes.String, nil),
+	})
+	testKnown := tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+		"test_nested": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testCases := map[string]struct {
+		schema        schema.Schema
+		planned       tftypes.Value
+		prior         tftypes.Value
+		proposedNew   tftypes.Value
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"unset-empty": {
+			schema:      testSchema(schema.EmptyObjectPolicyUnset),
+			proposedNew: testValue(testEmpty),
+			expected:    testValue(testEmpty),
+		},
+		"unset-null": {
+			schema:      testSchema(schema.EmptyObjectPolicyUnset),
+			proposedNew: testValue(testNull),
+			expected:    testValue(testNull),
+		},
+		"null-empty": {
+			schema:      testSchema(schema.EmptyObjectPolicyNull),
+			proposedNew: testValue(testEmpty),
+			expected:    testValue(testNull),
+		},
+		"null-known": {
+			schema:      testSchema(schema.EmptyObjectPolicyNull),
+			proposedNew: testValue(testKnown),
+			expected:    testValue(testKnown),
+		},
+		"null-unknown": {
+			schema:      testSchema(schema.EmptyObjectPolicyNull),
+			proposedNew: testValue(testUnknown),
+			expected:    testValue(testUnknown),
+		},
+		"null-empty-planned-null": {
+			schema:      testSchema(schema.EmptyObjectPolicyNull),
+			planned:     testValue(testNull),
+			proposedNew: testValue(testEmpty),
+			expected:    testValue(testNull),
+		},
+		"null-empty-planned-unknown": {
+			schema:      testSchema(schema.EmptyObjectPolicyNull),
+			planned:     testValue(testUnknown),
+			proposedNew: testValue(testEmpty),
+			expected:    testValue(testNull),
+		},
+		"null-empty-planned-empty": {
+			schema:      testSchema(schema.EmptyObjectPolicyNull),
+			planned:     testValue(testEmpty),
+			proposedNew: testValue(testEmpty),
+			expected:    testValue(testEmpty),
+		},
+		"null-empty-prior-null": {
+			schema:      testSchema(schema.EmptyObjectPolicyNull),
+			prior:       testValue(testNull),
+			proposedNew: testValue(testEmpty),
+			expected:    testValue(testNull),
+		},
+		"null-empty-prior-empty": {
+			schema:      testSchema(schema.EmptyObjectPolicyNull),
+			prior:       testValue(testEmpty),
+			proposedNew: testValue(testEmpty),
+			expected:    testValue(testEmpty),
+		},
+		"null-empty-prior-known": {
+			schema:      testSchema(schema.EmptyObjectPolicyNull),
+			prior:       testValue(testKnown),
+			proposedNew: testValue(testEmpty),
+			expected:    testValue(testEmpty),
+		},
+		"empty-null": {
+			schema:      testSchema(schema.EmptyObjectPolicyEmpty),
+			proposedNew: testValue(testNull),
+			expected:    testValue(testEmpty),
+		},
+		"empty-known": {
+			schema:      testSchema(schema.EmptyObjectPolicyEmpty),
+			proposedNew: testValue(testKnown),
+			expected:    testValue(testKnown),
+		},
+		"empty-unknown": {
+			schema:      testSchema(schema.EmptyObjectPolicyEmpty),
+			proposedNew: testValue(testUnknown),
+			expected:    testValue(testUnknown),
+		},
+		"empty-null-planned-null": {
+			schema:      testSchema(schema.EmptyObjectPolicyEmpty),
+			planned:     testValue(testNull),
+			proposedNew: testValue(testNull),
+			expected:    testValue(testNull),
+		},
+		"empty-null-planned-unknown": {
+			schema:      testSchema(schema.EmptyObjectPolicyEmpty),
+			planned:     testValue(testUnknown),
+			proposedNew: testValue(testNull),
+			expected:    testValue(testEmpty),
+		},
+		"empty-null-prior-null": {
+			schema:      testSchema(schema.EmptyObjectPolicyEmpty),
+			prior:       testValue(testNull),
+			proposedNew: testValue(testNull),
+			expected:    testValue(testNull),
+		},
+		"empty-null-prior-empty": {
+			schema:      testSchema(schema.EmptyObjectPolicyEmpty),
+			prior:       testValue(testEmpty),
+			proposedNew: testValue(testNull),
+			expected:    testValue(testEmpty),
+		},
+		"nested-list": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_list": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"test_attribute": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"test_nested": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+									Optional:          true,
+									EmptyObjectPolicy: schema.EmptyObjectPolicyNull,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			proposedNew: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_list": tftypes.List{ElementType: testType},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_list": tftypes.NewValue(
+						tftypes.List{ElementType: testType},
+						[]tftypes.Value{
+							testValue(testEmpty),
+							testValue(testKnown),
+						},
+					),
+				},
+			),
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_list": tftypes.List{ElementType: testType},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_list": tftypes.NewValue(
+						tftypes.List{ElementType: testType},
+						[]tftypes.Value{
+							testValue(testNull),
+							testValue(testKnown),
+						},
+					),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := fwserver.SchemaEmptyObjectPolicyRequest{
+				PlannedData: fwschemadata.Data{
+					Description:    fwschemadata.DataDescriptionPlan,
+					Schema:         testCase.schema,
+					TerraformValue: testCase.planned,
+				},
+				PriorData: fwschemadata.Data{
+					Description:    fwschemadata.DataDescriptionState,
+					Schema:         testCase.schema,
+					TerraformValue: testCase.prior,
+				},
+				ProposedNewData: fwschemadata.Data{
+					Description:    fwschemadata.DataDescriptionState,
+					Schema:         testCase.schema,
+					TerraformValue: testCase.proposedNew,
+				},
+			}
+			resp := &fwserver.SchemaEmptyObjectPolicyResponse{
+				NewData: req.ProposedNewData,
+			}
+
+			fwserver.SchemaEmptyObjectPolicy(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.NewData.TerraformValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
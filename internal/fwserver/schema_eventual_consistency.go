@@ -0,0 +1,211 @@
+package fwserver
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// privateStateKeyEventualConsistency is the framework private state key
+// which contains the deadline of each attribute value that implements
+// fwxschema.AttributeWithEventualConsistencyWindow, keyed by the attribute
+// path, following the last resource Create or Update.
+const privateStateKeyEventualConsistency = ".eventual_consistency"
+
+// eventualConsistencyWindow returns the eventual consistency window of the
+// attribute at the given path, or zero if the attribute does not declare one.
+func eventualConsistencyWindow(ctx context.Context, s fwschema.Schema, tfTypePath *tftypes.AttributePath) time.Duration {
+	if len(tfTypePath.Steps()) == 0 {
+		return 0
+	}
+
+	attribute, err := s.AttributeAtTerraformPath(ctx, tfTypePath)
+
+	// Values which are not attributes, such as blocks, do not have an
+	// eventual consistency window.
+	if err != nil {
+		return 0
+	}
+
+	windowAttribute, ok := attribute.(fwxschema.AttributeWithEventualConsistencyWindow)
+
+	if !ok {
+		return 0
+	}
+
+	return windowAttribute.GetEventualConsistencyWindow()
+}
+
+// eventualConsistencyApply records the window deadline of each attribute
+// with an eventual consistency window in the framework private state data,
+// which is created if necessary, following the resource Create or Update
+// method. The new state values are not modified, so they are only
+// substituted by eventualConsistencyRead.
+func eventualConsistencyApply(ctx context.Context, s fwschema.Schema, newState tftypes.Value, private *privatestate.Data) (*privatestate.Data, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	deadlines := make(map[string]time.Time)
+	now := time.Now()
+
+	err := tftypes.Walk(newState, func(tfTypePath *tftypes.AttributePath, _ tftypes.Value) (bool, error) {
+		window := eventualConsistencyWindow(ctx, s, tfTypePath)
+
+		if window > 0 {
+			deadlines[tfTypePath.String()] = now.Add(window)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		diags.Append(eventualConsistencyErrorDiag(err))
+
+		return private, diags
+	}
+
+	private, setDiags := setEventualConsistencyDeadlines(ctx, private, newState, deadlines)
+
+	diags.Append(setDiags...)
+
+	return private, diags
+}
+
+// eventualConsistencyRead keeps the prior state value of each attribute
+// with an eventual consistency window if the new state value returned by the
+// resource Read method differs and the window deadline recorded by the last
+// resource Create or Update has not passed. Passed deadlines are removed from
+// the framework private state data.
+func eventualConsistencyRead(ctx context.Context, s fwschema.Schema, priorState tftypes.Value, newState tftypes.Value, private *privatestate.Data) (tftypes.Value, *privatestate.Data, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if private == nil || len(private.Framework[privateStateKeyEventualConsistency]) == 0 {
+		return newState, private, diags
+	}
+
+	var deadlines map[string]time.Time
+
+	if err := json.Unmarshal(private.Framework[privateStateKeyEventualConsistency], &deadlines); err != nil {
+		logging.FrameworkWarn(ctx, "Ignoring invalid eventual consistency deadlines in private state", map[string]interface{}{logging.KeyError: err.Error()})
+
+		delete(private.Framework, privateStateKeyEventualConsistency)
+
+		return newState, private, diags
+	}
+
+	now := time.Now()
+
+	for key, deadline := range deadlines {
+		if !now.Before(deadline) {
+			delete(deadlines, key)
+		}
+	}
+
+	newValue, err := tftypes.Transform(newState, func(tfTypePath *tftypes.AttributePath, newStateValue tftypes.Value) (tftypes.Value, error) {
+		if _, ok := deadlines[tfTypePath.String()]; !ok {
+			return newStateValue, nil
+		}
+
+		if eventualConsistencyWindow(ctx, s, tfTypePath) <= 0 {
+			return newStateValue, nil
+		}
+
+		priorValue, ok := eventualConsistencyValueAtPath(priorState, tfTypePath)
+
+		if !ok || priorValue.IsNull() || priorValue.Equal(newStateValue) {
+			return newStateValue, nil
+		}
+
+		logging.FrameworkWarn(
+			ctx,
+			"Keeping prior state value of eventually consistent attribute which differs from the resource Read value",
+			map[string]interface{}{
+				logging.KeyAttributePath: tfTypePath.String(),
+			},
+		)
+
+		return priorValue, nil
+	})
+
+	if err != nil {
+		diags.Append(eventualConsistencyErrorDiag(err))
+
+		return newState, private, diags
+	}
+
+	private, setDiags := setEventualConsistencyDeadlines(ctx, private, newValue, deadlines)
+
+	diags.Append(setDiags...)
+
+	return newValue, private, diags
+}
+
+// eventualConsistencyValueAtPath returns the value at the given path, if
+// present.
+func eventualConsistencyValueAtPath(value tftypes.Value, tfTypePath *tftypes.AttributePath) (tftypes.Value, bool) {
+	if value.Type() == nil {
+		return tftypes.Value{}, false
+	}
+
+	valueAtPathRaw, remaining, err := tftypes.WalkAttributePath(value, tfTypePath)
+
+	if err != nil || len(remaining.Steps()) > 0 {
+		return tftypes.Value{}, false
+	}
+
+	valueAtPath, ok := valueAtPathRaw.(tftypes.Value)
+
+	return valueAtPath, ok
+}
+
+// setEventualConsistencyDeadlines records the deadlines in the framework
+// private state data, which is created if necessary. The deadlines are
+// removed if there are none or the resource was removed from state.
+func setEventualConsistencyDeadlines(ctx context.Context, private *privatestate.Data, state tftypes.Value, deadlines map[string]time.Time) (*privatestate.Data, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(deadlines) == 0 || state.IsNull() {
+		if private != nil {
+			delete(private.Framework, privateStateKeyEventualConsistency)
+		}
+
+		return private, diags
+	}
+
+	value, err := json.Marshal(deadlines)
+
+	if err != nil {
+		diags.Append(eventualConsistencyErrorDiag(err))
+
+		return private, diags
+	}
+
+	if private == nil {
+		private = privatestate.EmptyData(ctx)
+	}
+
+	if private.Framework == nil {
+		private.Framework = make(map[string][]byte, 1)
+	}
+
+	private.Framework[privateStateKeyEventualConsistency] = value
+
+	return private, diags
+}
+
+// eventualConsistencyErrorDiag returns an error diagnostic for unexpected
+// errors while applying eventual consistency windows.
+func eventualConsistencyErrorDiag(err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Eventual Consistency Error",
+		"An unexpected error was encountered while applying attribute eventual consistency windows. "+
+			"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+			"Error: "+err.Error(),
+	)
+}
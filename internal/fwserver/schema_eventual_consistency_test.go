@@ -0,0 +1,201 @@
+package fwserver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestEventualConsistencyApply(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"eventual": schema.StringAttribute{
+				Optional:                  true,
+				EventualConsistencyWindow: time.Hour,
+			},
+			"other": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"eventual": tftypes.String,
+			"other":    tftypes.String,
+		},
+	}
+
+	testValue := func(eventual interface{}, other interface{}) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"eventual": tftypes.NewValue(tftypes.String, eventual),
+			"other":    tftypes.NewValue(tftypes.String, other),
+		})
+	}
+
+	testCases := map[string]struct {
+		newState          tftypes.Value
+		expectedDeadlines []string
+	}{
+		"known": {
+			newState:          testValue("new", "new"),
+			expectedDeadlines: []string{`AttributeName("eventual")`},
+		},
+		"null": {
+			newState:          testValue(nil, "new"),
+			expectedDeadlines: []string{`AttributeName("eventual")`},
+		},
+		"removed": {
+			newState: tftypes.NewValue(testType, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			private, diags := eventualConsistencyApply(context.Background(), testSchema, testCase.newState, nil)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			var gotDeadlines []string
+
+			if private != nil && len(private.Framework[privateStateKeyEventualConsistency]) > 0 {
+				var deadlines map[string]time.Time
+
+				if err := json.Unmarshal(private.Framework[privateStateKeyEventualConsistency], &deadlines); err != nil {
+					t.Fatalf("unexpected error decoding deadlines: %s", err)
+				}
+
+				for key, deadline := range deadlines {
+					if !deadline.After(time.Now()) {
+						t.Errorf("expected future deadline for %s, got: %s", key, deadline)
+					}
+
+					gotDeadlines = append(gotDeadlines, key)
+				}
+			}
+
+			if diff := cmp.Diff(gotDeadlines, testCase.expectedDeadlines); diff != "" {
+				t.Errorf("unexpected deadlines difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEventualConsistencyRead(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"eventual": schema.StringAttribute{
+				Optional:                  true,
+				EventualConsistencyWindow: time.Hour,
+			},
+			"other": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"eventual": tftypes.String,
+			"other":    tftypes.String,
+		},
+	}
+
+	testValue := func(eventual interface{}, other interface{}) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"eventual": tftypes.NewValue(tftypes.String, eventual),
+			"other":    tftypes.NewValue(tftypes.String, other),
+		})
+	}
+
+	testPrivate := func(deadline time.Time) *privatestate.Data {
+		value, err := json.Marshal(map[string]time.Time{
+			`AttributeName("eventual")`: deadline,
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error encoding deadlines: %s", err)
+		}
+
+		return &privatestate.Data{
+			Framework: map[string][]byte{
+				privateStateKeyEventualConsistency: value,
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		priorState       tftypes.Value
+		newState         tftypes.Value
+		private          *privatestate.Data
+		expected         tftypes.Value
+		expectedDeadline bool
+	}{
+		"no-deadlines": {
+			priorState: testValue("prior", "prior"),
+			newState:   testValue("stale", "new"),
+			expected:   testValue("stale", "new"),
+		},
+		"within-window": {
+			priorState:       testValue("prior", "prior"),
+			newState:         testValue("stale", "new"),
+			private:          testPrivate(time.Now().Add(time.Hour)),
+			expected:         testValue("prior", "new"),
+			expectedDeadline: true,
+		},
+		"window-passed": {
+			priorState: testValue("prior", "prior"),
+			newState:   testValue("new", "new"),
+			private:    testPrivate(time.Now().Add(-time.Minute)),
+			expected:   testValue("new", "new"),
+		},
+		"removed": {
+			priorState: testValue("prior", "prior"),
+			newState:   tftypes.NewValue(testType, nil),
+			private:    testPrivate(time.Now().Add(time.Hour)),
+			expected:   tftypes.NewValue(testType, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, private, diags := eventualConsistencyRead(context.Background(), testSchema, testCase.priorState, testCase.newState, testCase.private)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+
+			gotDeadline := private != nil && len(private.Framework[privateStateKeyEventualConsistency]) > 0
+
+			if gotDeadline != testCase.expectedDeadline {
+				t.Errorf("expected deadline recorded: %t, got: %t", testCase.expectedDeadline, gotDeadline)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SchemaSemanticEqualityRequest represents a request for the framework to
+// perform semantic equality logic on schema-based data.
+type SchemaSemanticEqualityRequest struct {
+	// PriorData is the prior schema-based data, such as the prior state or
+	// planned state.
+	PriorData fwschemadata.Data
+
+	// ProposedNewData is the new schema-based data, such as the state
+	// returned by the provider.
+	ProposedNewData fwschemadata.Data
+}
+
+// SchemaSemanticEqualityResponse represents a response to a
+// SchemaSemanticEqualityRequest.
+type SchemaSemanticEqualityResponse struct {
+	// NewData is the new schema-based data after semantic equality logic.
+	// Any semantically equal values are replaced with the prior value.
+	NewData fwschemadata.Data
+
+	// Diagnostics report errors or warnings related to running semantic
+	// equality logic.
+	Diagnostics diag.Diagnostics
+}
+
+// SchemaSemanticEquality replaces known values in the proposed new data with
+// the prior value at the same path, if the value type implements semantic
+// equality and the values are semantically equal. This prevents value
+// differences which are inconsequential, such as differing formatting, from
+// causing Terraform data consistency errors or resource drift.
+//
+// Null and unknown values are never compared, as changing a value's state
+// implicitly represents a different value.
+func SchemaSemanticEquality(ctx context.Context, req SchemaSemanticEqualityRequest, resp *SchemaSemanticEqualityResponse) {
+	if req.ProposedNewData.Schema == nil || req.ProposedNewData.TerraformValue.Type() == nil {
+		return
+	}
+
+	if req.PriorData.TerraformValue.Type() == nil {
+		return
+	}
+
+	newValue, err := tftypes.Transform(req.ProposedNewData.TerraformValue, func(tfTypePath *tftypes.AttributePath, proposedNewValue tftypes.Value) (tftypes.Value, error) {
+		// Only primitive string values currently support semantic equality.
+		if !proposedNewValue.Type().Is(tftypes.String) {
+			return proposedNewValue, nil
+		}
+
+		if proposedNewValue.IsNull() || !proposedNewValue.IsKnown() {
+			return proposedNewValue, nil
+		}
+
+		priorValueRaw, remaining, err := tftypes.WalkAttributePath(req.PriorData.TerraformValue, tfTypePath)
+
+		// Values which are not present in the prior data, such as new list
+		// elements, cannot be semantically equal.
+		if err != nil || len(remaining.Steps()) > 0 {
+			return proposedNewValue, nil //nolint:nilerr // Intentionally ignoring the error
+		}
+
+		priorValue, ok := priorValueRaw.(tftypes.Value)
+
+		if !ok || priorValue.IsNull() || !priorValue.IsKnown() || priorValue.Equal(proposedNewValue) {
+			return proposedNewValue, nil
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, req.ProposedNewData.Schema)
+
+		resp.Diagnostics.Append(fwPathDiags...)
+
+		if fwPathDiags.HasError() {
+			return proposedNewValue, nil
+		}
+
+		attrType, err := req.ProposedNewData.Schema.TypeAtTerraformPath(ctx, tfTypePath)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				fwPath,
+				"Semantic Equality Check Error",
+				"An unexpected error was encountered trying to retrieve type information at a given path. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return proposedNewValue, nil
+		}
+
+		priorAttrValue, err := attrType.ValueFromTerraform(ctx, priorValue)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				fwPath,
+				"Semantic Equality Check Error",
+				"An unexpected error was encountered trying to convert the prior value. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return proposedNewValue, nil
+		}
+
+		priorValuable, ok := priorAttrValue.(basetypes.StringValuableWithSemanticEquals)
+
+		if !ok {
+			return proposedNewValue, nil
+		}
+
+		proposedNewAttrValue, err := attrType.ValueFromTerraform(ctx, proposedNewValue)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				fwPath,
+				"Semantic Equality Check Error",
+				"An unexpected error was encountered trying to convert the new value. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return proposedNewValue, nil
+		}
+
+		proposedNewValuable, ok := proposedNewAttrValue.(basetypes.StringValuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				fwPath,
+				"Semantic Equality Check Error",
+				"An unexpected value type was received while performing semantic equality checks. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Expected Value Type: basetypes.StringValuable\n"+
+					fmt.Sprintf("Got Value Type: %T", proposedNewAttrValue),
+			)
+
+			return proposedNewValue, nil
+		}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined type-based StringSemanticEquals",
+			map[string]interface{}{
+				logging.KeyAttributePath: fwPath.String(),
+			},
+		)
+
+		equal, diags := priorValuable.StringSemanticEquals(ctx, proposedNewValuable)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined type-based StringSemanticEquals",
+			map[string]interface{}{
+				logging.KeyAttributePath: fwPath.String(),
+			},
+		)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() || !equal {
+			return proposedNewValue, nil
+		}
+
+		return priorValue, nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error was encountered while performing semantic equality checks. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.NewData.TerraformValue = newValue
+}
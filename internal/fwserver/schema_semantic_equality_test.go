@@ -0,0 +1,185 @@
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaSemanticEquality(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+			"test_list": tftypes.List{
+				ElementType: tftypes.String,
+			},
+		},
+	}
+
+	testSchema := func(attrType, elementType testtypes.StringTypeWithSemanticEquals) fwschema.Schema {
+		return testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"test_attribute": testschema.Attribute{
+					Optional: true,
+					Type:     attrType,
+				},
+				"test_list": testschema.Attribute{
+					Optional: true,
+					Type: types.ListType{
+						ElemType: elementType,
+					},
+				},
+			},
+		}
+	}
+
+	testValue := func(attrValue interface{}, listValues ...interface{}) tftypes.Value {
+		listElements := make([]tftypes.Value, 0, len(listValues))
+
+		for _, listValue := range listValues {
+			listElements = append(listElements, tftypes.NewValue(tftypes.String, listValue))
+		}
+
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_attribute": tftypes.NewValue(tftypes.String, attrValue),
+			"test_list":      tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, listElements),
+		})
+	}
+
+	semanticEqual := testtypes.StringTypeWithSemanticEquals{
+		SemanticEquals: true,
+	}
+	semanticNotEqual := testtypes.StringTypeWithSemanticEquals{
+		SemanticEquals: false,
+	}
+	semanticError := testtypes.StringTypeWithSemanticEquals{
+		SemanticEqualsDiagnostics: diag.Diagnostics{
+			diag.NewErrorDiagnostic("test summary", "test detail"),
+		},
+	}
+
+	testCases := map[string]struct {
+		schema        fwschema.Schema
+		prior         tftypes.Value
+		proposedNew   tftypes.Value
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"semantic-equal": {
+			schema:      testSchema(semanticEqual, semanticEqual),
+			prior:       testValue("prior", "prior-0", "prior-1"),
+			proposedNew: testValue("new", "new-0", "new-1", "new-2"),
+			expected:    testValue("prior", "prior-0", "prior-1", "new-2"),
+		},
+		"semantic-not-equal": {
+			schema:      testSchema(semanticNotEqual, semanticNotEqual),
+			prior:       testValue("prior", "prior-0"),
+			proposedNew: testValue("new", "new-0"),
+			expected:    testValue("new", "new-0"),
+		},
+		"semantic-equal-attribute-only": {
+			schema:      testSchema(semanticEqual, semanticNotEqual),
+			prior:       testValue("prior", "prior-0"),
+			proposedNew: testValue("new", "new-0"),
+			expected:    testValue("prior", "new-0"),
+		},
+		"prior-null": {
+			schema:      testSchema(semanticEqual, semanticEqual),
+			prior:       testValue(nil),
+			proposedNew: testValue("new"),
+			expected:    testValue("new"),
+		},
+		"prior-unknown": {
+			schema:      testSchema(semanticEqual, semanticEqual),
+			prior:       testValue(tftypes.UnknownValue),
+			proposedNew: testValue("new"),
+			expected:    testValue("new"),
+		},
+		"prior-data-null": {
+			schema:      testSchema(semanticEqual, semanticEqual),
+			prior:       tftypes.NewValue(testType, nil),
+			proposedNew: testValue("new", "new-0"),
+			expected:    testValue("new", "new-0"),
+		},
+		"proposed-new-null": {
+			schema:      testSchema(semanticEqual, semanticEqual),
+			prior:       testValue("prior"),
+			proposedNew: testValue(nil),
+			expected:    testValue(nil),
+		},
+		"base-type": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_attribute": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+					"test_list": testschema.Attribute{
+						Optional: true,
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+					},
+				},
+			},
+			prior:       testValue("prior", "prior-0"),
+			proposedNew: testValue("new", "new-0"),
+			expected:    testValue("new", "new-0"),
+		},
+		"diagnostics": {
+			schema:      testSchema(semanticError, semanticNotEqual),
+			prior:       testValue("prior"),
+			proposedNew: testValue("new"),
+			expected:    testValue("new"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := fwserver.SchemaSemanticEqualityRequest{
+				PriorData: fwschemadata.Data{
+					Description:    fwschemadata.DataDescriptionState,
+					Schema:         testCase.schema,
+					TerraformValue: testCase.prior,
+				},
+				ProposedNewData: fwschemadata.Data{
+					Description:    fwschemadata.DataDescriptionState,
+					Schema:         testCase.schema,
+					TerraformValue: testCase.proposedNew,
+				},
+			}
+			resp := &fwserver.SchemaSemanticEqualityResponse{
+				NewData: req.ProposedNewData,
+			}
+
+			fwserver.SchemaSemanticEquality(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.NewData.TerraformValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
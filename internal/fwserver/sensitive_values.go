@@ -0,0 +1,124 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// withSensitiveValues returns a context which masks the string values of all
+// sensitive attributes in the given data from framework and provider logs,
+// along with those values for later redaction from diagnostics via
+// redactSensitiveValues. The data may be *tfsdk.Config, *tfsdk.Plan, or
+// *tfsdk.State and nil data is skipped.
+func withSensitiveValues(ctx context.Context, data ...interface{}) (context.Context, []string) {
+	return addSensitiveValues(ctx, nil, data...)
+}
+
+// addSensitiveValues returns the context and values with the string values
+// of all sensitive attributes in the given data added, such as data returned
+// by the provider during the RPC. Only the added values are newly masked in
+// the returned context.
+func addSensitiveValues(ctx context.Context, values []string, data ...interface{}) (context.Context, []string) {
+	existing := len(values)
+
+	for _, d := range data {
+		switch d := d.(type) {
+		case *tfsdk.Config:
+			if d != nil {
+				values = appendSensitiveValues(ctx, values, d.Schema, d.Raw)
+			}
+		case *tfsdk.Plan:
+			if d != nil {
+				values = appendSensitiveValues(ctx, values, d.Schema, d.Raw)
+			}
+		case *tfsdk.State:
+			if d != nil {
+				values = appendSensitiveValues(ctx, values, d.Schema, d.Raw)
+			}
+		}
+	}
+
+	return logging.MaskLogStrings(ctx, values[existing:]...), values
+}
+
+// appendSensitiveValues appends all known, non-empty string values at or
+// underneath sensitive attributes in the schema which are not already in the
+// values. Only string values are collected. Bool and number values are not
+// redacted, as their text, such as "true" or "1", commonly appears in
+// unrelated diagnostic content.
+func appendSensitiveValues(ctx context.Context, values []string, s fwschema.Schema, raw tftypes.Value) []string {
+	if s == nil || raw.Type() == nil {
+		return values
+	}
+
+	seen := make(map[string]struct{}, len(values))
+
+	for _, value := range values {
+		seen[value] = struct{}{}
+	}
+
+	appendValue := func(value string) {
+		if value == "" {
+			return
+		}
+
+		if _, ok := seen[value]; ok {
+			return
+		}
+
+		seen[value] = struct{}{}
+		values = append(values, value)
+	}
+
+	_ = tftypes.Walk(raw, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (bool, error) {
+		// Attribute paths for the schema root and blocks return an error.
+		attribute, err := s.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		if err != nil || !attribute.IsSensitive() {
+			return true, nil
+		}
+
+		_ = tftypes.Walk(tfTypeValue, func(_ *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+			if !value.IsKnown() || value.IsNull() || !value.Type().Is(tftypes.String) {
+				return true, nil
+			}
+
+			var str string
+
+			if err := value.As(&str); err != nil {
+				return true, nil
+			}
+
+			appendValue(str)
+
+			return true, nil
+		})
+
+		// All values underneath a sensitive attribute were already handled.
+		return false, nil
+	})
+
+	return values
+}
+
+// redactSensitiveValues returns the diagnostics with the given values redacted
+// from each diagnostic summary and detail. Values are only redacted where they
+// appear as a whole token, as described by diag.WithSensitiveValues.
+func redactSensitiveValues(diags diag.Diagnostics, values []string) diag.Diagnostics {
+	if len(diags) == 0 || len(values) == 0 {
+		return diags
+	}
+
+	redacted := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		redacted = append(redacted, diag.WithSensitiveValues(values, d))
+	}
+
+	return redacted
+}
@@ -0,0 +1,246 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWithSensitiveValues(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"tokens": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"nested": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+	testType := testSchema.Type().TerraformType(context.Background())
+	testNestedType := testType.(tftypes.Object).AttributeTypes["nested"]
+
+	testValue := func(password any, tokens any, key any) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"name":     tftypes.NewValue(tftypes.String, "test-name"),
+			"password": tftypes.NewValue(tftypes.String, password),
+			"tokens":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tokens),
+			"nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+				"key": tftypes.NewValue(tftypes.String, key),
+			}),
+		})
+	}
+
+	testCases := map[string]struct {
+		data     []interface{}
+		expected []string
+	}{
+		"nil": {
+			data: []interface{}{(*tfsdk.Config)(nil), (*tfsdk.Plan)(nil), (*tfsdk.State)(nil)},
+		},
+		"null": {
+			data: []interface{}{
+				&tfsdk.State{
+					Raw:    tftypes.NewValue(testType, nil),
+					Schema: testSchema,
+				},
+			},
+		},
+		"values": {
+			data: []interface{}{
+				&tfsdk.Config{
+					Raw: testValue(
+						"test-password",
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "test-token"),
+							tftypes.NewValue(tftypes.String, ""),
+							tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						},
+						"test-key",
+					),
+					Schema: testSchema,
+				},
+			},
+			expected: []string{"test-key", "test-password", "test-token"},
+		},
+		"deduplicated": {
+			data: []interface{}{
+				&tfsdk.Config{
+					Raw:    testValue("test-password", nil, nil),
+					Schema: testSchema,
+				},
+				&tfsdk.Plan{
+					Raw:    testValue("test-password", nil, "test-key"),
+					Schema: testSchema,
+				},
+				&tfsdk.State{
+					Raw:    testValue(nil, nil, "test-key"),
+					Schema: testSchema,
+				},
+			},
+			expected: []string{"test-password", "test-key"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, got := withSensitiveValues(context.Background(), testCase.data...)
+
+			// Object attributes are walked without a defined ordering.
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAddSensitiveValues(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+	testType := testSchema.Type().TerraformType(context.Background())
+
+	testState := func(password any) *tfsdk.State {
+		return &tfsdk.State{
+			Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, password),
+			}),
+			Schema: testSchema,
+		}
+	}
+
+	testCases := map[string]struct {
+		values   []string
+		data     []interface{}
+		expected []string
+	}{
+		"nil": {
+			values:   []string{"test-existing"},
+			data:     []interface{}{(*tfsdk.State)(nil)},
+			expected: []string{"test-existing"},
+		},
+		"added": {
+			values:   []string{"test-existing"},
+			data:     []interface{}{testState("test-password")},
+			expected: []string{"test-existing", "test-password"},
+		},
+		"existing": {
+			values:   []string{"test-password"},
+			data:     []interface{}{testState("test-password")},
+			expected: []string{"test-password"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, got := addSensitiveValues(context.Background(), testCase.values, testCase.data...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRedactSensitiveValues(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		values   []string
+		expected diag.Diagnostics
+	}{
+		"nil": {
+			values: []string{"secret"},
+		},
+		"no-values": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail: secret"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail: secret"),
+			},
+		},
+		"values": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail: secret"),
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+			},
+			values: []string{"secret"},
+			expected: diag.Diagnostics{
+				diag.WithSensitiveValues([]string{"secret"}, diag.NewErrorDiagnostic("test summary", "test detail: secret")),
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+			},
+		},
+		"values-partial-word": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail: retry 1 of 10"),
+				diag.NewWarningDiagnostic("test summary", "test detail: 10 retries"),
+			},
+			values: []string{"1"},
+			expected: diag.Diagnostics{
+				diag.WithSensitiveValues([]string{"1"}, diag.NewErrorDiagnostic("test summary", "test detail: retry 1 of 10")),
+				diag.NewWarningDiagnostic("test summary", "test detail: 10 retries"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := redactSensitiveValues(testCase.diags, testCase.values)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			for _, d := range got {
+				if d.Detail() == "test detail: secret" && len(testCase.values) > 0 {
+					t.Errorf("expected redacted detail, got: %s", d.Detail())
+				}
+			}
+		})
+	}
+}
@@ -29,6 +29,23 @@ type Server struct {
 	// to [resource.ConfigureRequest.ProviderData].
 	ResourceConfigureData any
 
+	// PrivateStateCodec, if set, encrypts provider private state data before
+	// it is returned to Terraform and decrypts it before it is passed to
+	// resource methods.
+	PrivateStateCodec resource.PrivateStateCodec
+
+	// SecretResolver, if set, resolves provider configuration string
+	// attribute values before the provider Configure method is called.
+	SecretResolver provider.SecretResolver
+
+	// SupportBundleDir is the directory where support bundles are written by
+	// the WriteSupportBundle method.
+	SupportBundleDir string
+
+	// applyCoordinator tracks in-progress resource operations for the
+	// ResourceWithApplyDependsOn interface.
+	applyCoordinator applyCoordinator
+
 	// dataSourceSchemas is the cached DataSource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the DataSourceType.GetSchema() method.
@@ -57,6 +74,14 @@ type Server struct {
 	// access from race conditions.
 	dataSourceTypesMutex sync.Mutex
 
+	// eventSubscribers is the cached provider defined event subscribers, if
+	// the provider implemented the ProviderWithEventSubscribers interface.
+	eventSubscribers []provider.EventSubscriber
+
+	// eventSubscribersOnce ensures the provider defined EventSubscribers
+	// method is only called once.
+	eventSubscribersOnce sync.Once
+
 	// providerSchema is the cached Provider Schema for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the Provider.GetSchema() method.
@@ -108,6 +133,12 @@ type Server struct {
 	// Provider.Resources() method.
 	resourceFuncs map[string]func() resource.Resource
 
+	// resourceLegacyTypeNames is the cached mapping of legacy resource type
+	// names to current resource type names, if any resources implemented the
+	// ResourceWithLegacyTypeNames interface. It is populated alongside
+	// resourceFuncs.
+	resourceLegacyTypeNames map[string]string
+
 	// resourceTypesDiags is the cached Diagnostics obtained while populating
 	// resourceTypes. This is to ensure any warnings or errors are also
 	// returned appropriately when fetching resourceTypes.
@@ -345,6 +376,7 @@ func (s *Server) ResourceFuncs(ctx context.Context) (map[string]func() resource.
 	}
 
 	s.resourceFuncs = make(map[string]func() resource.Resource)
+	s.resourceLegacyTypeNames = make(map[string]string)
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider Resources")
 	resourceFuncsSlice := s.Provider.Resources(ctx)
@@ -382,11 +414,55 @@ func (s *Server) ResourceFuncs(ctx context.Context) (map[string]func() resource.
 		}
 
 		s.resourceFuncs[resourceTypeNameResp.TypeName] = resourceFunc
+
+		resourceWithLegacyTypeNames, ok := res.(resource.ResourceWithLegacyTypeNames)
+
+		if !ok {
+			continue
+		}
+
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithLegacyTypeNames", map[string]interface{}{logging.KeyResourceType: resourceTypeNameResp.TypeName})
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource LegacyTypeNames", map[string]interface{}{logging.KeyResourceType: resourceTypeNameResp.TypeName})
+		legacyTypeNames := resourceWithLegacyTypeNames.LegacyTypeNames(ctx)
+		logging.FrameworkDebug(ctx, "Called provider defined Resource LegacyTypeNames", map[string]interface{}{logging.KeyResourceType: resourceTypeNameResp.TypeName})
+
+		for _, legacyTypeName := range legacyTypeNames {
+			if _, ok := s.resourceFuncs[legacyTypeName]; ok {
+				s.resourceTypesDiags.AddError(
+					"Duplicate Resource Type Defined",
+					fmt.Sprintf("The %s resource type name was returned for multiple resources. ", legacyTypeName)+
+						"Resource type names, including legacy type names, must be unique. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				)
+				continue
+			}
+
+			s.resourceFuncs[legacyTypeName] = resourceFunc
+			s.resourceLegacyTypeNames[legacyTypeName] = resourceTypeNameResp.TypeName
+		}
 	}
 
 	return s.resourceFuncs, s.resourceTypesDiags
 }
 
+// ResourceLegacyTypeName returns the current resource type name and true if
+// the given type name is a legacy type name of a resource which implemented
+// the ResourceWithLegacyTypeNames interface.
+func (s *Server) ResourceLegacyTypeName(ctx context.Context, typeName string) (string, bool) {
+	if typeName == "" || s.Provider == nil {
+		return "", false
+	}
+
+	// Ensure the legacy type names are populated. Any diagnostics are
+	// returned by the other RPC handling.
+	_, _ = s.ResourceFuncs(ctx)
+
+	currentTypeName, ok := s.resourceLegacyTypeNames[typeName]
+
+	return currentTypeName, ok
+}
+
 // ResourceSchema returns the Schema associated with the ResourceType for
 // the given type name.
 func (s *Server) ResourceSchema(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // ApplyResourceChangeRequest is the framework server request for the
@@ -21,6 +22,10 @@ type ApplyResourceChangeRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+
+	// TypeName is the resource type name, which may be a legacy type name of
+	// the resource.
+	TypeName string
 }
 
 // ApplyResourceChangeResponse is the framework server response for the
@@ -37,6 +42,32 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		return
 	}
 
+	ctx, sensitiveValues := withSensitiveValues(ctx, req.Config, req.PlannedState, req.PriorState)
+
+	defer func() {
+		resp.Diagnostics = redactSensitiveValues(resp.Diagnostics, sensitiveValues)
+	}()
+
+	defer func() {
+		resp.Diagnostics.Append(s.encryptPrivateState(ctx, resp.Private)...)
+	}()
+
+	resp.Diagnostics.Append(s.decryptPrivateState(ctx, req.PlannedPrivate)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	release, acquireDiags := s.acquireApply(ctx, req.TypeName, req.Resource)
+
+	defer release()
+
+	resp.Diagnostics.Append(acquireDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// If PriorState is missing/null, its a Create request.
 	if req.PriorState == nil || req.PriorState.Raw.IsNull() {
 		logging.FrameworkTrace(ctx, "ApplyResourceChange received no PriorState, running CreateResource")
@@ -57,6 +88,8 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		resp.NewState = createResp.NewState
 		resp.Private = createResp.Private
 
+		s.resourceAfterApply(ctx, req, resp)
+
 		return
 	}
 
@@ -101,4 +134,65 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 	resp.Diagnostics = updateResp.Diagnostics
 	resp.NewState = updateResp.NewState
 	resp.Private = updateResp.Private
+
+	s.resourceAfterApply(ctx, req, resp)
+}
+
+// resourceAfterApply calls the provider defined AfterApply method, if the
+// Resource implements ResourceWithAfterApply and the create or update logic
+// completed without error diagnostics.
+func (s *Server) resourceAfterApply(ctx context.Context, req *ApplyResourceChangeRequest, resp *ApplyResourceChangeResponse) {
+	resourceWithAfterApply, ok := req.Resource.(resource.ResourceWithAfterApply)
+
+	if !ok || resp.Diagnostics.HasError() || resp.NewState == nil {
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithAfterApply")
+
+	nullSchemaData := tftypes.NewValue(req.ResourceSchema.Type().TerraformType(ctx), nil)
+
+	afterApplyReq := resource.AfterApplyRequest{
+		Config: tfsdk.Config{
+			Schema: req.ResourceSchema,
+			Raw:    nullSchemaData,
+		},
+		Plan: tfsdk.Plan{
+			Schema: req.ResourceSchema,
+			Raw:    nullSchemaData,
+		},
+		PriorState: tfsdk.State{
+			Schema: req.ResourceSchema,
+			Raw:    nullSchemaData,
+		},
+		State:   *resp.NewState,
+		Private: privatestate.EmptyProviderData(ctx),
+	}
+	afterApplyResp := resource.AfterApplyResponse{}
+
+	if req.Config != nil {
+		afterApplyReq.Config = *req.Config
+	}
+
+	if req.PlannedState != nil {
+		afterApplyReq.Plan = *req.PlannedState
+	}
+
+	if req.PriorState != nil {
+		afterApplyReq.PriorState = *req.PriorState
+	}
+
+	if req.ProviderMeta != nil {
+		afterApplyReq.ProviderMeta = *req.ProviderMeta
+	}
+
+	if resp.Private != nil && resp.Private.Provider != nil {
+		afterApplyReq.Private = resp.Private.Provider
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Resource AfterApply")
+	resourceWithAfterApply.AfterApply(ctx, afterApplyReq, &afterApplyResp)
+	logging.FrameworkDebug(ctx, "Called provider defined Resource AfterApply")
+
+	resp.Diagnostics.Append(afterApplyResp.Diagnostics...)
 }
@@ -2,6 +2,8 @@ package fwserver
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -9,16 +11,90 @@ import (
 
 // ConfigureProvider implements the framework server ConfigureProvider RPC.
 func (s *Server) ConfigureProvider(ctx context.Context, req *provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	logging.FrameworkDebug(ctx, "Calling provider defined Provider Configure")
+	configureReq := provider.ConfigureRequest{}
 
 	if req != nil {
-		s.Provider.Configure(ctx, *req, resp)
-	} else {
-		s.Provider.Configure(ctx, provider.ConfigureRequest{}, resp)
+		configureReq = *req
 	}
 
+	config, secretValues, diags := s.resolveProviderConfigSecrets(ctx, configureReq.Config)
+
+	// Resolved secrets are always masked and redacted, even when the
+	// attribute is not marked as sensitive in the schema.
+	ctx = logging.MaskLogStrings(ctx, secretValues...)
+	sensitiveValues := secretValues
+
+	defer func() {
+		resp.Diagnostics = redactSensitiveValues(resp.Diagnostics, sensitiveValues)
+	}()
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	configureReq.Config = config
+
+	ctx, schemaSensitiveValues := withSensitiveValues(ctx, &configureReq.Config)
+
+	sensitiveValues = append(sensitiveValues, schemaSensitiveValues...)
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider Configure")
+
+	s.Provider.Configure(ctx, configureReq, resp)
+
 	logging.FrameworkDebug(ctx, "Called provider defined Provider Configure")
 
 	s.DataSourceConfigureData = resp.DataSourceData
 	s.ResourceConfigureData = resp.ResourceData
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providerWithVerifyConfiguration, ok := s.Provider.(provider.ProviderWithVerifyConfiguration)
+
+	if !ok {
+		return
+	}
+
+	if resp.SkipVerifyConfiguration {
+		logging.FrameworkDebug(ctx, "Skipping provider defined Provider VerifyConfiguration")
+
+		return
+	}
+
+	timeout := resp.VerifyConfigurationTimeout
+
+	if timeout <= 0 {
+		timeout = provider.DefaultVerifyConfigurationTimeout
+	}
+
+	verifyCtx, cancel := context.WithTimeout(ctx, timeout)
+
+	defer cancel()
+
+	verifyReq := provider.VerifyConfigurationRequest{
+		Config:         configureReq.Config,
+		DataSourceData: resp.DataSourceData,
+		ResourceData:   resp.ResourceData,
+	}
+	verifyResp := provider.VerifyConfigurationResponse{}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider VerifyConfiguration")
+
+	providerWithVerifyConfiguration.VerifyConfiguration(verifyCtx, verifyReq, &verifyResp)
+
+	logging.FrameworkDebug(ctx, "Called provider defined Provider VerifyConfiguration")
+
+	resp.Diagnostics.Append(verifyResp.Diagnostics...)
+
+	if errors.Is(verifyCtx.Err(), context.DeadlineExceeded) {
+		resp.Diagnostics.AddError(
+			"Provider Configuration Verification Timeout",
+			fmt.Sprintf("The provider was unable to verify its configuration within %s. ", timeout)+
+				"Check the provider configuration and connectivity to the provider API, then try again.",
+		)
+	}
 }
@@ -2,7 +2,10 @@ package fwserver_test
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -79,6 +82,138 @@ func TestServerConfigureProvider(t *testing.T) {
 			},
 			expectedResponse: &provider.ConfigureResponse{},
 		},
+		"request-config-secretresolver": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+						resp.Schema = testSchema
+					},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						var got types.String
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+						if resp.Diagnostics.HasError() {
+							return
+						}
+
+						if got.ValueString() != "test-secret" {
+							resp.Diagnostics.AddError("Incorrect req.Config", "expected test-secret, got "+got.ValueString())
+						}
+					},
+				},
+				SecretResolver: &testprovider.SecretResolver{
+					ResolveSecretMethod: func(_ context.Context, req provider.ResolveSecretRequest, resp *provider.ResolveSecretResponse) {
+						if !req.Path.Equal(path.Root("test")) || req.Reference != "test-value" {
+							resp.Diagnostics.AddError("Incorrect req", "unexpected path "+req.Path.String()+" or reference "+req.Reference)
+
+							return
+						}
+
+						resp.Value = types.StringValue("test-secret")
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				Config: testConfig,
+			},
+			expectedResponse: &provider.ConfigureResponse{},
+		},
+		"request-config-secretresolver-redacted-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+						resp.Schema = testSchema
+					},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						var got types.String
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+						if resp.Diagnostics.HasError() {
+							return
+						}
+
+						resp.Diagnostics.AddError("Unable to Authenticate", "Invalid token: "+got.ValueString())
+					},
+				},
+				SecretResolver: &testprovider.SecretResolver{
+					ResolveSecretMethod: func(_ context.Context, req provider.ResolveSecretRequest, resp *provider.ResolveSecretResponse) {
+						resp.Value = types.StringValue("test-secret")
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				Config: testConfig,
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.WithSensitiveValues(
+						[]string{"test-secret"},
+						diag.NewErrorDiagnostic(
+							"Unable to Authenticate",
+							"Invalid token: test-secret",
+						),
+					),
+				},
+			},
+		},
+		"request-config-secretresolver-unresolved": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+						resp.Schema = testSchema
+					},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						var got types.String
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+						if resp.Diagnostics.HasError() {
+							return
+						}
+
+						if got.ValueString() != "test-value" {
+							resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.ValueString())
+						}
+					},
+				},
+				SecretResolver: &testprovider.SecretResolver{},
+			},
+			request: &provider.ConfigureRequest{
+				Config: testConfig,
+			},
+			expectedResponse: &provider.ConfigureResponse{},
+		},
+		"request-config-secretresolver-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+						resp.Schema = testSchema
+					},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						resp.Diagnostics.AddError("Unexpected Configure", "Configure should not be called")
+					},
+				},
+				SecretResolver: &testprovider.SecretResolver{
+					ResolveSecretMethod: func(_ context.Context, req provider.ResolveSecretRequest, resp *provider.ResolveSecretResponse) {
+						resp.Diagnostics.AddAttributeError(req.Path, "Unable to Resolve Secret", "Secret not found.")
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				Config: testConfig,
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Unable to Resolve Secret",
+						"Secret not found.",
+					),
+				},
+			},
+		},
 		"request-terraformversion": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -147,6 +282,145 @@ func TestServerConfigureProvider(t *testing.T) {
 				ResourceData: "test-provider-configure-value",
 			},
 		},
+		"verifyconfiguration-configure-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithVerifyConfiguration{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.Diagnostics.AddError("error summary", "error detail")
+						},
+					},
+					VerifyConfigurationMethod: func(_ context.Context, _ provider.VerifyConfigurationRequest, resp *provider.VerifyConfigurationResponse) {
+						resp.Diagnostics.AddError("Unexpected VerifyConfiguration", "VerifyConfiguration should not be called")
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{},
+			expectedResponse: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+			},
+		},
+		"verifyconfiguration-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithVerifyConfiguration{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {},
+					},
+					VerifyConfigurationMethod: func(_ context.Context, _ provider.VerifyConfigurationRequest, resp *provider.VerifyConfigurationResponse) {
+						resp.Diagnostics.AddAttributeError(path.Root("test"), "Invalid Credentials", "The API returned 401 Unauthorized.")
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				Config: testConfig,
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Credentials",
+						"The API returned 401 Unauthorized.",
+					),
+				},
+			},
+		},
+		"verifyconfiguration-request": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithVerifyConfiguration{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.DataSourceData = "test-datasource-data"
+							resp.ResourceData = "test-resource-data"
+						},
+					},
+					VerifyConfigurationMethod: func(ctx context.Context, req provider.VerifyConfigurationRequest, resp *provider.VerifyConfigurationResponse) {
+						var got types.String
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+						if got.ValueString() != "test-value" {
+							resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.ValueString())
+						}
+
+						if req.DataSourceData != "test-datasource-data" {
+							resp.Diagnostics.AddError("Incorrect req.DataSourceData", fmt.Sprintf("got %v", req.DataSourceData))
+						}
+
+						if req.ResourceData != "test-resource-data" {
+							resp.Diagnostics.AddError("Incorrect req.ResourceData", fmt.Sprintf("got %v", req.ResourceData))
+						}
+
+						if _, ok := ctx.Deadline(); !ok {
+							resp.Diagnostics.AddError("Missing Deadline", "expected context deadline")
+						}
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				Config: testConfig,
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				DataSourceData: "test-datasource-data",
+				ResourceData:   "test-resource-data",
+			},
+		},
+		"verifyconfiguration-skip": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithVerifyConfiguration{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.SkipVerifyConfiguration = true
+						},
+					},
+					VerifyConfigurationMethod: func(_ context.Context, _ provider.VerifyConfigurationRequest, resp *provider.VerifyConfigurationResponse) {
+						resp.Diagnostics.AddError("Unexpected VerifyConfiguration", "VerifyConfiguration should not be called")
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{},
+			expectedResponse: &provider.ConfigureResponse{
+				SkipVerifyConfiguration: true,
+			},
+		},
+		"verifyconfiguration-timeout": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithVerifyConfiguration{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.VerifyConfigurationTimeout = time.Millisecond
+						},
+					},
+					VerifyConfigurationMethod: func(ctx context.Context, _ provider.VerifyConfigurationRequest, resp *provider.VerifyConfigurationResponse) {
+						<-ctx.Done()
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{},
+			expectedResponse: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Configuration Verification Timeout",
+						"The provider was unable to verify its configuration within 1ms. "+
+							"Check the provider configuration and connectivity to the provider API, then try again.",
+					),
+				},
+				VerifyConfigurationTimeout: time.Millisecond,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -162,6 +436,12 @@ func TestServerConfigureProvider(t *testing.T) {
 				t.Errorf("unexpected difference: %s", diff)
 			}
 
+			for _, d := range response.Diagnostics {
+				if strings.Contains(d.Detail(), "test-secret") {
+					t.Errorf("expected redacted diagnostic detail, got: %s", d.Detail())
+				}
+			}
+
 			if diff := cmp.Diff(testCase.server.DataSourceConfigureData, testCase.expectedResponse.DataSourceData); diff != "" {
 				t.Errorf("unexpected server.DataSourceConfigureData difference: %s", diff)
 			}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -123,4 +124,90 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 
 		resp.Private.Provider = createResp.Private
 	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.PlannedState == nil {
+		return
+	}
+
+	emptyObjectPolicyReq := SchemaEmptyObjectPolicyRequest{
+		PlannedData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionPlan,
+			Schema:         req.PlannedState.Schema,
+			TerraformValue: req.PlannedState.Raw.Copy(),
+		},
+		ProposedNewData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionState,
+			Schema:         resp.NewState.Schema,
+			TerraformValue: resp.NewState.Raw.Copy(),
+		},
+	}
+	emptyObjectPolicyResp := &SchemaEmptyObjectPolicyResponse{
+		NewData: emptyObjectPolicyReq.ProposedNewData,
+	}
+
+	SchemaEmptyObjectPolicy(ctx, emptyObjectPolicyReq, emptyObjectPolicyResp)
+
+	resp.Diagnostics.Append(emptyObjectPolicyResp.Diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure new data is updated if empty object policies changed any values.
+	if !emptyObjectPolicyResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
+		logging.FrameworkDebug(ctx, "State updated due to empty object policy")
+
+		resp.NewState.Raw = emptyObjectPolicyResp.NewData.TerraformValue
+	}
+
+	private, diags := eventualConsistencyApply(ctx, resp.NewState.Schema, resp.NewState.Raw, resp.Private)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Private = private
+
+	semanticEqualityReq := SchemaSemanticEqualityRequest{
+		PriorData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionPlan,
+			Schema:         req.PlannedState.Schema,
+			TerraformValue: req.PlannedState.Raw.Copy(),
+		},
+		ProposedNewData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionState,
+			Schema:         resp.NewState.Schema,
+			TerraformValue: resp.NewState.Raw.Copy(),
+		},
+	}
+	semanticEqualityResp := &SchemaSemanticEqualityResponse{
+		NewData: semanticEqualityReq.ProposedNewData,
+	}
+
+	SchemaSemanticEquality(ctx, semanticEqualityReq, semanticEqualityResp)
+
+	resp.Diagnostics.Append(semanticEqualityResp.Diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure new data is updated if semantic equality changed any values.
+	if !semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
+		logging.FrameworkDebug(ctx, "State updated due to semantic equality")
+
+		resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	}
+
+	resp.Diagnostics.Append(canonicalizeState(ctx, req.Resource, req.PlannedState, nil, resp.NewState)...)
+
+	if !resp.Diagnostics.HasError() && s.nullComputedAttributeCheck(ctx) {
+		resp.Diagnostics.Append(nullComputedAttributesDiags(ctx, req.ResourceSchema, resp.NewState)...)
+	}
 }
@@ -0,0 +1,75 @@
+package fwserver
+
+import (
+	"context"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// DescriptionTemplate resolves text/template placeholders in schema
+// descriptions using the provider defined description template data.
+type DescriptionTemplate struct {
+	data map[string]any
+}
+
+// Execute returns the description with all template placeholders resolved.
+// Descriptions without placeholders are returned unmodified.
+func (t *DescriptionTemplate) Execute(description string) (string, error) {
+	if t == nil || !strings.Contains(description, "{{") {
+		return description, nil
+	}
+
+	tmpl, err := template.New("description").Option("missingkey=error").Parse(description)
+
+	if err != nil {
+		return description, err
+	}
+
+	var result strings.Builder
+
+	if err := tmpl.Execute(&result, t.data); err != nil {
+		return description, err
+	}
+
+	return result.String(), nil
+}
+
+// DescriptionTemplate returns the DescriptionTemplate from the provider
+// defined DescriptionTemplateData method, or nil if the provider does not
+// implement ProviderWithDescriptionTemplateData.
+func (s *Server) DescriptionTemplate(ctx context.Context) (*DescriptionTemplate, diag.Diagnostics) {
+	providerWithDescriptionTemplateData, ok := s.Provider.(provider.ProviderWithDescriptionTemplateData)
+
+	if !ok {
+		return nil, nil
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithDescriptionTemplateData")
+
+	req := provider.DescriptionTemplateDataRequest{}
+	resp := &provider.DescriptionTemplateDataResponse{}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider DescriptionTemplateData")
+	providerWithDescriptionTemplateData.DescriptionTemplateData(ctx, req, resp)
+	logging.FrameworkDebug(ctx, "Called provider defined Provider DescriptionTemplateData")
+
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	data := make(map[string]any, len(resp.Data)+1)
+
+	for key, value := range resp.Data {
+		data[key] = value
+	}
+
+	if _, ok := data["ProviderTypeName"]; !ok {
+		data["ProviderTypeName"] = s.providerTypeName
+	}
+
+	return &DescriptionTemplate{data: data}, resp.Diagnostics
+}
@@ -0,0 +1,144 @@
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+func TestServerDescriptionTemplate(t *testing.T) {
+	t.Parallel()
+
+	testProvider := &testprovider.Provider{
+		MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+			resp.TypeName = "examplecloud"
+		},
+	}
+
+	testCases := map[string]struct {
+		server        *fwserver.Server
+		description   string
+		expected      string
+		expectedErr   string
+		expectedDiags diag.Diagnostics
+	}{
+		"not-implemented": {
+			server: &fwserver.Server{
+				Provider: testProvider,
+			},
+			description: "Manages a {{ .ProviderTypeName }} thing.",
+			expected:    "Manages a {{ .ProviderTypeName }} thing.",
+		},
+		"provider-type-name": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDescriptionTemplateData{
+					Provider: testProvider,
+				},
+			},
+			description: "Manages a {{ .ProviderTypeName }} thing.",
+			expected:    "Manages a examplecloud thing.",
+		},
+		"data": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDescriptionTemplateData{
+					Provider: testProvider,
+					DescriptionTemplateDataMethod: func(_ context.Context, _ provider.DescriptionTemplateDataRequest, resp *provider.DescriptionTemplateDataResponse) {
+						resp.Data = map[string]any{
+							"DocsBaseURL":      "https://example.com/docs",
+							"ProviderTypeName": "Example Cloud",
+						}
+					},
+				},
+			},
+			description: "Manages a {{ .ProviderTypeName }} thing. Refer to {{ .DocsBaseURL }}/thing for details.",
+			expected:    "Manages a Example Cloud thing. Refer to https://example.com/docs/thing for details.",
+		},
+		"no-placeholders": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDescriptionTemplateData{
+					Provider: testProvider,
+				},
+			},
+			description: "Manages a thing.",
+			expected:    "Manages a thing.",
+		},
+		"missing-key": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDescriptionTemplateData{
+					Provider: testProvider,
+				},
+			},
+			description: "Refer to {{ .DocsBaseURL }}.",
+			expectedErr: `template: description:1:12: executing "description" at <.DocsBaseURL>: map has no entry for key "DocsBaseURL"`,
+		},
+		"invalid-template": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDescriptionTemplateData{
+					Provider: testProvider,
+				},
+			},
+			description: "Refer to {{ .DocsBaseURL",
+			expectedErr: `template: description:1: unclosed action`,
+		},
+		"diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDescriptionTemplateData{
+					Provider: testProvider,
+					DescriptionTemplateDataMethod: func(_ context.Context, _ provider.DescriptionTemplateDataRequest, resp *provider.DescriptionTemplateDataResponse) {
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &fwserver.GetProviderSchemaResponse{}
+
+			testCase.server.GetProviderSchema(context.Background(), &fwserver.GetProviderSchemaRequest{}, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			got, err := resp.DescriptionTemplate.Execute(testCase.description)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got: %q", testCase.expected, got)
+			}
+		})
+	}
+}
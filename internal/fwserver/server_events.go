@@ -0,0 +1,104 @@
+package fwserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// eventRPCContextKey is the context key for the eventRPC of the RPC being
+// handled.
+type eventRPCContextKey struct{}
+
+// eventRPC is the RPC information included with all events sent while
+// handling an RPC.
+type eventRPC struct {
+	name     string
+	typeName string
+	start    time.Time
+}
+
+// EventRPCStart sends the provider.EventTypeRPCStart event to any provider
+// defined event subscribers. The returned context must be used for the
+// remainder of the RPC handling, so later events include the RPC information.
+// Protocol specific implementations should call this at the beginning of each
+// RPC and EventRPCFinish before returning the response.
+func (s *Server) EventRPCStart(ctx context.Context, rpc string, typeName string) context.Context {
+	ctx = context.WithValue(ctx, eventRPCContextKey{}, eventRPC{
+		name:     rpc,
+		typeName: typeName,
+		start:    time.Now(),
+	})
+
+	s.sendEvent(ctx, provider.Event{
+		Type: provider.EventTypeRPCStart,
+	})
+
+	return ctx
+}
+
+// EventRPCFinish sends the provider.EventTypeRPCFinish event to any provider
+// defined event subscribers.
+func (s *Server) EventRPCFinish(ctx context.Context, diags diag.Diagnostics) {
+	event := provider.Event{
+		Type:        provider.EventTypeRPCFinish,
+		Diagnostics: diags,
+	}
+
+	if rpc, ok := ctx.Value(eventRPCContextKey{}).(eventRPC); ok {
+		event.Duration = time.Since(rpc.start)
+	}
+
+	s.sendEvent(ctx, event)
+}
+
+// eventValidationFailure sends the provider.EventTypeValidationFailure event
+// to any provider defined event subscribers, if the diagnostics contain an
+// error.
+func (s *Server) eventValidationFailure(ctx context.Context, diags diag.Diagnostics) {
+	if !diags.HasError() {
+		return
+	}
+
+	s.sendEvent(ctx, provider.Event{
+		Type:        provider.EventTypeValidationFailure,
+		Diagnostics: diags,
+	})
+}
+
+// sendEvent calls all provider defined event subscribers with the event. The
+// RPC information is populated from the context, if available.
+func (s *Server) sendEvent(ctx context.Context, event provider.Event) {
+	s.eventSubscribersOnce.Do(func() {
+		providerWithEventSubscribers, ok := s.Provider.(provider.ProviderWithEventSubscribers)
+
+		if !ok {
+			return
+		}
+
+		logging.FrameworkTrace(ctx, "Provider implements ProviderWithEventSubscribers")
+		logging.FrameworkDebug(ctx, "Calling provider defined Provider EventSubscribers")
+		s.eventSubscribers = providerWithEventSubscribers.EventSubscribers(ctx)
+		logging.FrameworkDebug(ctx, "Called provider defined Provider EventSubscribers")
+	})
+
+	if len(s.eventSubscribers) == 0 {
+		return
+	}
+
+	if rpc, ok := ctx.Value(eventRPCContextKey{}).(eventRPC); ok {
+		event.RPC = rpc.name
+		event.TypeName = rpc.typeName
+	}
+
+	for _, subscriber := range s.eventSubscribers {
+		if subscriber == nil {
+			continue
+		}
+
+		subscriber(ctx, event)
+	}
+}
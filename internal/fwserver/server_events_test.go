@@ -0,0 +1,185 @@
+package fwserver_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestServerEvents(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Version: 1,
+	}
+
+	testConfig := &tfsdk.Config{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"id": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchema,
+	}
+
+	testCases := map[string]struct {
+		run      func(context.Context, *fwserver.Server)
+		expected []provider.Event
+	}{
+		"rpc": {
+			run: func(ctx context.Context, s *fwserver.Server) {
+				ctx = s.EventRPCStart(ctx, "ReadResource", "test_resource")
+
+				s.EventRPCFinish(ctx, diag.Diagnostics{
+					diag.NewWarningDiagnostic("test summary", "test detail"),
+				})
+			},
+			expected: []provider.Event{
+				{
+					Type:     provider.EventTypeRPCStart,
+					RPC:      "ReadResource",
+					TypeName: "test_resource",
+				},
+				{
+					Type:     provider.EventTypeRPCFinish,
+					RPC:      "ReadResource",
+					TypeName: "test_resource",
+					Diagnostics: diag.Diagnostics{
+						diag.NewWarningDiagnostic("test summary", "test detail"),
+					},
+				},
+			},
+		},
+		"validation-failure": {
+			run: func(ctx context.Context, s *fwserver.Server) {
+				ctx = s.EventRPCStart(ctx, "ValidateResourceConfig", "test_resource")
+
+				req := &fwserver.ValidateResourceConfigRequest{
+					Config: testConfig,
+					Resource: &testprovider.ResourceWithValidateConfig{
+						Resource: &testprovider.Resource{},
+						ValidateConfigMethod: func(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+							resp.Diagnostics.AddError("test summary", "test detail")
+						},
+					},
+				}
+
+				s.ValidateResourceConfig(ctx, req, &fwserver.ValidateResourceConfigResponse{})
+			},
+			expected: []provider.Event{
+				{
+					Type:     provider.EventTypeRPCStart,
+					RPC:      "ValidateResourceConfig",
+					TypeName: "test_resource",
+				},
+				{
+					Type:     provider.EventTypeValidationFailure,
+					RPC:      "ValidateResourceConfig",
+					TypeName: "test_resource",
+					Diagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+			},
+		},
+		"validation-success": {
+			run: func(ctx context.Context, s *fwserver.Server) {
+				req := &fwserver.ValidateResourceConfigRequest{
+					Config:   testConfig,
+					Resource: &testprovider.Resource{},
+				}
+
+				s.ValidateResourceConfig(ctx, req, &fwserver.ValidateResourceConfigResponse{})
+			},
+			expected: nil,
+		},
+		"state-upgrade": {
+			run: func(ctx context.Context, s *fwserver.Server) {
+				req := &fwserver.UpgradeResourceStateRequest{
+					RawState: testNewRawState(t, map[string]interface{}{
+						"id": "test-id-value",
+					}),
+					ResourceSchema: testSchema,
+					Resource: &testprovider.ResourceWithUpgradeState{
+						Resource: &testprovider.Resource{},
+						UpgradeStateMethod: func(_ context.Context) map[int64]resource.StateUpgrader {
+							return map[int64]resource.StateUpgrader{
+								0: {
+									StateUpgrader: func(_ context.Context, _ resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+										resp.Diagnostics.AddWarning("test summary", "test detail")
+									},
+								},
+							}
+						},
+					},
+					Version: 0,
+				}
+
+				s.UpgradeResourceState(ctx, req, &fwserver.UpgradeResourceStateResponse{})
+			},
+			expected: []provider.Event{
+				{
+					Type: provider.EventTypeStateUpgrade,
+					Diagnostics: diag.Diagnostics{
+						diag.NewWarningDiagnostic("test summary", "test detail"),
+					},
+					PriorStateVersion: 0,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				got   []provider.Event
+				mutex sync.Mutex
+			)
+
+			server := &fwserver.Server{
+				Provider: &testprovider.ProviderWithEventSubscribers{
+					Provider: &testprovider.Provider{},
+					EventSubscribersMethod: func(_ context.Context) []provider.EventSubscriber {
+						return []provider.EventSubscriber{
+							func(_ context.Context, event provider.Event) {
+								mutex.Lock()
+								defer mutex.Unlock()
+
+								got = append(got, event)
+							},
+						}
+					},
+				},
+			}
+
+			testCase.run(context.Background(), server)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreFields(provider.Event{}, "Duration")); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package fwserver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// FormatDiagnostics returns the given diagnostics after calling the
+// provider defined FormatDiagnostic method, if implemented, for each
+// diagnostic. The protocol specific format diagnostics servers call this for
+// every outgoing response diagnostic.
+func (s *Server) FormatDiagnostics(ctx context.Context, diags diag.Diagnostics) diag.Diagnostics {
+	providerWithFormatDiagnostic, ok := s.Provider.(provider.ProviderWithFormatDiagnostic)
+
+	if !ok || len(diags) == 0 {
+		return diags
+	}
+
+	result := make(diag.Diagnostics, 0, len(diags))
+
+	logging.FrameworkTrace(ctx, "Provider implements FormatDiagnostic")
+
+	for _, diagnostic := range diags {
+		if diagnostic == nil {
+			continue
+		}
+
+		// Diagnostic codes are carried in the detail, so the code line is
+		// removed before formatting and appended again afterwards.
+		code, detail := diagnosticCodeAndDetail(diagnostic)
+
+		req := provider.FormatDiagnosticRequest{
+			Diagnostic: diagnostic,
+		}
+		resp := &provider.FormatDiagnosticResponse{
+			Summary: diagnostic.Summary(),
+			Detail:  detail,
+		}
+
+		providerWithFormatDiagnostic.FormatDiagnostic(ctx, req, resp)
+
+		if resp.Summary == diagnostic.Summary() && resp.Detail == detail {
+			result = append(result, diagnostic)
+
+			continue
+		}
+
+		result = append(result, formattedDiagnostic(diagnostic, code, resp.Summary, resp.Detail))
+	}
+
+	return result
+}
+
+// diagnosticCodeAndDetail returns the code of the diagnostic and its detail
+// without the trailing code line, if the detail ends with the line appended
+// by diag.WithCode. Otherwise, an empty code and the unmodified detail are
+// returned.
+func diagnosticCodeAndDetail(d diag.Diagnostic) (string, string) {
+	code := diag.Code(d)
+	detail := d.Detail()
+
+	if code == "" {
+		return "", detail
+	}
+
+	codeLine := diag.CodeDetailPrefix + code
+
+	if detail == codeLine {
+		return code, ""
+	}
+
+	if strings.HasSuffix(detail, "\n\n"+codeLine) {
+		return code, strings.TrimSuffix(detail, "\n\n"+codeLine)
+	}
+
+	return "", detail
+}
+
+// formattedDiagnostic returns a new diagnostic with the given summary and
+// detail, preserving the severity, path, and code, if any, of the original
+// diagnostic.
+func formattedDiagnostic(original diag.Diagnostic, code string, summary string, detail string) diag.Diagnostic {
+	var result diag.Diagnostic
+
+	switch original.Severity() {
+	case diag.SeverityWarning:
+		result = diag.NewWarningDiagnostic(summary, detail)
+	default:
+		result = diag.NewErrorDiagnostic(summary, detail)
+	}
+
+	if code != "" {
+		result = diag.WithCode(code, result)
+	}
+
+	if diagWithPath, ok := original.(diag.DiagnosticWithPath); ok {
+		result = diag.WithPath(diagWithPath.Path(), result)
+	}
+
+	return result
+}
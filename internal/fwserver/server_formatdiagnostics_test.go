@@ -0,0 +1,137 @@
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+func TestServerFormatDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic("error summary", "error detail"),
+		diag.NewWarningDiagnostic("warning summary", "warning detail"),
+		diag.NewAttributeErrorDiagnostic(path.Root("test"), "attribute error summary", "attribute error detail"),
+		diag.NewAttributeWarningDiagnostic(path.Root("test"), "attribute warning summary", "attribute warning detail"),
+	}
+
+	testCases := map[string]struct {
+		server   *fwserver.Server
+		diags    diag.Diagnostics
+		expected diag.Diagnostics
+	}{
+		"not-implemented": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			diags:    testDiags,
+			expected: testDiags,
+		},
+		"nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithFormatDiagnostic{
+					Provider: &testprovider.Provider{},
+					FormatDiagnosticMethod: func(_ context.Context, _ provider.FormatDiagnosticRequest, resp *provider.FormatDiagnosticResponse) {
+						resp.Summary = "unexpected"
+					},
+				},
+			},
+			diags:    nil,
+			expected: nil,
+		},
+		"unchanged": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithFormatDiagnostic{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			diags:    testDiags,
+			expected: testDiags,
+		},
+		"formatted": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithFormatDiagnostic{
+					Provider: &testprovider.Provider{},
+					FormatDiagnosticMethod: func(_ context.Context, req provider.FormatDiagnosticRequest, resp *provider.FormatDiagnosticResponse) {
+						resp.Summary = "[EXAMPLE] " + resp.Summary
+						resp.Detail += "\n\nSupport: https://example.com/support"
+					},
+				},
+			},
+			diags: testDiags,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("[EXAMPLE] error summary", "error detail\n\nSupport: https://example.com/support"),
+				diag.NewWarningDiagnostic("[EXAMPLE] warning summary", "warning detail\n\nSupport: https://example.com/support"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "[EXAMPLE] attribute error summary", "attribute error detail\n\nSupport: https://example.com/support"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "[EXAMPLE] attribute warning summary", "attribute warning detail\n\nSupport: https://example.com/support"),
+			},
+		},
+		"formatted-code": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithFormatDiagnostic{
+					Provider: &testprovider.Provider{},
+					FormatDiagnosticMethod: func(_ context.Context, req provider.FormatDiagnosticRequest, resp *provider.FormatDiagnosticResponse) {
+						resp.Summary = "[EXAMPLE] " + resp.Summary
+						resp.Detail += "\n\nSupport: https://example.com/support"
+					},
+				},
+			},
+			diags: diag.Diagnostics{
+				diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("error summary", "error detail")),
+				diag.WithCode("TEST_CODE", diag.NewAttributeWarningDiagnostic(path.Root("test"), "attribute warning summary", "attribute warning detail")),
+			},
+			expected: diag.Diagnostics{
+				diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("[EXAMPLE] error summary", "error detail\n\nSupport: https://example.com/support")),
+				diag.WithCode("TEST_CODE", diag.NewAttributeWarningDiagnostic(path.Root("test"), "[EXAMPLE] attribute warning summary", "attribute warning detail\n\nSupport: https://example.com/support")),
+			},
+		},
+		"formatted-errors-only": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithFormatDiagnostic{
+					Provider: &testprovider.Provider{},
+					FormatDiagnosticMethod: func(_ context.Context, req provider.FormatDiagnosticRequest, resp *provider.FormatDiagnosticResponse) {
+						if req.Diagnostic.Severity() != diag.SeverityError {
+							return
+						}
+
+						resp.Detail = "translated"
+					},
+				},
+			},
+			diags: testDiags,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "translated"),
+				diag.NewWarningDiagnostic("warning summary", "warning detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "attribute error summary", "translated"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "attribute warning summary", "attribute warning detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.server.FormatDiagnostics(context.Background(), testCase.diags)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			for i, d := range got {
+				if code, expectedCode := diag.Code(d), diag.Code(testCase.expected[i]); code != expectedCode {
+					t.Errorf("expected diagnostic %d code %q, got %q", i, expectedCode, code)
+				}
+			}
+		})
+	}
+}
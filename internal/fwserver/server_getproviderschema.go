@@ -21,7 +21,12 @@ type GetProviderSchemaResponse struct {
 	ProviderMeta       fwschema.Schema
 	ResourceSchemas    map[string]fwschema.Schema
 	DataSourceSchemas  map[string]fwschema.Schema
-	Diagnostics        diag.Diagnostics
+
+	// DescriptionTemplate, if not nil, should be used to resolve template
+	// placeholders in all schema descriptions.
+	DescriptionTemplate *DescriptionTemplate
+
+	Diagnostics diag.Diagnostics
 }
 
 // GetProviderSchema implements the framework server GetProviderSchema RPC.
@@ -78,4 +83,14 @@ func (s *Server) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRe
 	}
 
 	resp.DataSourceSchemas = dataSourceSchemas
+
+	descriptionTemplate, diags := s.DescriptionTemplate(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.DescriptionTemplate = descriptionTemplate
 }
@@ -603,6 +603,107 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 			},
 		},
+		"resourceschemas-legacy-type-names": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.ResourceWithLegacyTypeNames{
+									Resource: &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = resourceschema.Schema{
+												Attributes: map[string]resourceschema.Attribute{
+													"test1": resourceschema.StringAttribute{
+														Required: true,
+													},
+												},
+											}
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									},
+									LegacyTypeNamesMethod: func(_ context.Context) []string {
+										return []string{"test_legacy_resource"}
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{},
+				Provider:          providerschema.Schema{},
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_legacy_resource": resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test1": resourceschema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+					"test_resource": resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test1": resourceschema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					PlanDestroy: true,
+				},
+			},
+		},
+		"resourceschemas-legacy-type-names-duplicate": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.Resource{
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource1"
+									},
+								}
+							},
+							func() resource.Resource {
+								return &testprovider.ResourceWithLegacyTypeNames{
+									Resource: &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource2"
+										},
+									},
+									LegacyTypeNamesMethod: func(_ context.Context) []string {
+										return []string{"test_resource1"}
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: nil,
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Duplicate Resource Type Defined",
+						"The test_resource1 resource type name was returned for multiple resources. "+
+							"Resource type names, including legacy type names, must be unique. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Provider:        providerschema.Schema{},
+				ResourceSchemas: nil,
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					PlanDestroy: true,
+				},
+			},
+		},
 		"resourceschemas-empty-type-name": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -0,0 +1,92 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// GetResourceSchemaHistoriesRequest is the framework server request for
+// introspecting resource schema version history. This is not a protocol RPC.
+type GetResourceSchemaHistoriesRequest struct{}
+
+// GetResourceSchemaHistoriesResponse is the framework server response for
+// introspecting resource schema version history. This is not a protocol RPC.
+type GetResourceSchemaHistoriesResponse struct {
+	// ResourceSchemaHistories is a mapping of resource type names to their
+	// schema changes, sorted by version. Only resources implementing
+	// resource.ResourceWithSchemaHistory are included.
+	ResourceSchemaHistories map[string][]resource.SchemaChange
+	Diagnostics             diag.Diagnostics
+}
+
+// GetResourceSchemaHistories returns the schema version history of all
+// resources which implement resource.ResourceWithSchemaHistory.
+func (s *Server) GetResourceSchemaHistories(ctx context.Context, req *GetResourceSchemaHistoriesRequest, resp *GetResourceSchemaHistoriesResponse) {
+	metadataReq := provider.MetadataRequest{}
+	metadataResp := provider.MetadataResponse{}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider Metadata")
+	s.Provider.Metadata(ctx, metadataReq, &metadataResp)
+	logging.FrameworkDebug(ctx, "Called provider defined Provider Metadata")
+
+	s.providerTypeName = metadataResp.TypeName
+
+	resourceFuncs, diags := s.ResourceFuncs(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.ResourceSchemaHistories = make(map[string][]resource.SchemaChange)
+
+	for resourceTypeName, resourceFunc := range resourceFuncs {
+		if _, ok := s.ResourceLegacyTypeName(ctx, resourceTypeName); ok {
+			continue
+		}
+
+		resourceWithSchemaHistory, ok := resourceFunc().(resource.ResourceWithSchemaHistory)
+
+		if !ok {
+			continue
+		}
+
+		resourceSchema, diags := s.ResourceSchema(ctx, resourceTypeName)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource SchemaHistory", map[string]interface{}{logging.KeyResourceType: resourceTypeName})
+		schemaChanges := resourceWithSchemaHistory.SchemaHistory(ctx)
+		logging.FrameworkDebug(ctx, "Called provider defined Resource SchemaHistory", map[string]interface{}{logging.KeyResourceType: resourceTypeName})
+
+		for _, schemaChange := range schemaChanges {
+			if schemaChange.Version > resourceSchema.GetVersion() {
+				resp.Diagnostics.AddError(
+					"Invalid Resource Schema History",
+					fmt.Sprintf("The %s resource returned a schema change for version %d, which is greater than the current schema version %d. ", resourceTypeName, schemaChange.Version, resourceSchema.GetVersion())+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				)
+			}
+		}
+
+		sortedChanges := make([]resource.SchemaChange, len(schemaChanges))
+		copy(sortedChanges, schemaChanges)
+
+		sort.SliceStable(sortedChanges, func(i, j int) bool {
+			return sortedChanges[i].Version < sortedChanges[j].Version
+		})
+
+		resp.ResourceSchemaHistories[resourceTypeName] = sortedChanges
+	}
+}
@@ -0,0 +1,152 @@
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestServerGetResourceSchemaHistories(t *testing.T) {
+	t.Parallel()
+
+	testResource := func(typeName string, version int64, history []resource.SchemaChange) func() resource.Resource {
+		return func() resource.Resource {
+			return &testprovider.ResourceWithSchemaHistory{
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = schema.Schema{
+							Attributes: map[string]schema.Attribute{
+								"test": schema.StringAttribute{
+									Required: true,
+								},
+							},
+							Version: version,
+						}
+					},
+					MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+						resp.TypeName = req.ProviderTypeName + "_" + typeName
+					},
+				},
+				SchemaHistoryMethod: func(_ context.Context) []resource.SchemaChange {
+					return history
+				},
+			}
+		}
+	}
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		expectedResponse *fwserver.GetResourceSchemaHistoriesResponse
+	}{
+		"empty-provider": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.GetResourceSchemaHistoriesResponse{
+				ResourceSchemaHistories: map[string][]resource.SchemaChange{},
+			},
+		},
+		"resource-without-schema-history": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.Resource{
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.GetResourceSchemaHistoriesResponse{
+				ResourceSchemaHistories: map[string][]resource.SchemaChange{},
+			},
+		},
+		"resources": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+						resp.TypeName = "testprovider"
+					},
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							testResource("resource1", 2, []resource.SchemaChange{
+								{Version: 2, ProviderVersion: "1.2.0", Summary: "Added test attribute"},
+								{Version: 1, ProviderVersion: "1.1.0", Summary: "Renamed name attribute"},
+								{Version: 2, ProviderVersion: "1.2.0", Summary: "Removed other attribute"},
+							}),
+							testResource("resource2", 0, nil),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.GetResourceSchemaHistoriesResponse{
+				ResourceSchemaHistories: map[string][]resource.SchemaChange{
+					"testprovider_resource1": {
+						{Version: 1, ProviderVersion: "1.1.0", Summary: "Renamed name attribute"},
+						{Version: 2, ProviderVersion: "1.2.0", Summary: "Added test attribute"},
+						{Version: 2, ProviderVersion: "1.2.0", Summary: "Removed other attribute"},
+					},
+					"testprovider_resource2": {},
+				},
+			},
+		},
+		"invalid-version": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+						resp.TypeName = "testprovider"
+					},
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							testResource("resource1", 1, []resource.SchemaChange{
+								{Version: 2, Summary: "Added test attribute"},
+							}),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.GetResourceSchemaHistoriesResponse{
+				ResourceSchemaHistories: map[string][]resource.SchemaChange{
+					"testprovider_resource1": {
+						{Version: 2, Summary: "Added test attribute"},
+					},
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Resource Schema History",
+						"The testprovider_resource1 resource returned a schema change for version 2, which is greater than the current schema version 1. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.GetResourceSchemaHistoriesResponse{}
+			testCase.server.GetResourceSchemaHistories(context.Background(), &fwserver.GetResourceSchemaHistoriesRequest{}, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -47,6 +47,18 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		return
 	}
 
+	ctx, sensitiveValues := withSensitiveValues(ctx, &req.EmptyState)
+
+	defer func() {
+		resp.Diagnostics = redactSensitiveValues(resp.Diagnostics, sensitiveValues)
+	}()
+
+	defer func() {
+		for _, importedResource := range resp.ImportedResources {
+			resp.Diagnostics.Append(s.encryptPrivateState(ctx, importedResource.Private)...)
+		}
+	}()
+
 	if _, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -104,6 +116,8 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 	resourceWithImportState.ImportState(ctx, importReq, &importResp)
 	logging.FrameworkDebug(ctx, "Called provider defined Resource ImportState")
 
+	ctx, sensitiveValues = addSensitiveValues(ctx, sensitiveValues, &importResp.State)
+
 	resp.Diagnostics.Append(importResp.Diagnostics...)
 
 	if resp.Diagnostics.HasError() {
@@ -65,6 +65,31 @@ func TestServerImportResourceState(t *testing.T) {
 		Schema: testSchema,
 	}
 
+	testSchemaSensitive := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"optional": schema.StringAttribute{
+				Optional: true,
+			},
+			"required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testEmptyStateSensitive := &tfsdk.State{
+		Raw:    testEmptyStateValue,
+		Schema: testSchemaSensitive,
+	}
+
+	testStateSensitive := &tfsdk.State{
+		Raw:    testStateValue,
+		Schema: testSchemaSensitive,
+	}
+
 	testProviderKeyValue := privatestate.MustMarshalToJson(map[string][]byte{
 		"providerKeyOne": []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`),
 	})
@@ -242,6 +267,41 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"response-importedresources-sensitive-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyStateSensitive,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+						resp.Diagnostics.AddWarning("warning summary", "warning detail: test-id")
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.WithSensitiveValues(
+						[]string{"test-id"},
+						diag.NewWarningDiagnostic(
+							"warning summary",
+							"warning detail: test-id",
+						),
+					),
+				},
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State:    *testStateSensitive,
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
 		"response-importedresources-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
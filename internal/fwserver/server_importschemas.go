@@ -0,0 +1,53 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// ImportSchemas caches the given precomputed schemas, such as the
+// GetProviderSchema response of another Server for the same provider, so the
+// provider defined Schema methods are not called again by this Server. The
+// schemas must not contain error diagnostics.
+func (s *Server) ImportSchemas(ctx context.Context, schemas *GetProviderSchemaResponse) {
+	if schemas == nil {
+		return
+	}
+
+	logging.FrameworkDebug(ctx, "Importing precomputed schemas")
+
+	s.providerSchemaMutex.Lock()
+	s.providerSchema = schemas.Provider
+	s.providerSchemaDiags = nil
+	s.providerSchemaMutex.Unlock()
+
+	s.providerMetaSchemaMutex.Lock()
+	s.providerMetaSchema = schemas.ProviderMeta
+	s.providerMetaSchemaDiags = nil
+	s.providerMetaSchemaMutex.Unlock()
+
+	s.resourceSchemasMutex.Lock()
+	s.resourceSchemas = copySchemas(schemas.ResourceSchemas)
+	s.resourceSchemasDiags = nil
+	s.resourceSchemasMutex.Unlock()
+
+	s.dataSourceSchemasMutex.Lock()
+	s.dataSourceSchemas = copySchemas(schemas.DataSourceSchemas)
+	s.dataSourceSchemasDiags = nil
+	s.dataSourceSchemasMutex.Unlock()
+}
+
+// copySchemas returns a shallow copy of the schemas, so the caches of
+// multiple servers do not share a map. A nil map is returned as an empty map
+// so it is still considered cached.
+func copySchemas(schemas map[string]fwschema.Schema) map[string]fwschema.Schema {
+	result := make(map[string]fwschema.Schema, len(schemas))
+
+	for typeName, schema := range schemas {
+		result[typeName] = schema
+	}
+
+	return result
+}
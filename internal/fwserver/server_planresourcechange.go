@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,6 +28,7 @@ type PlanResourceChangeRequest struct {
 	ProviderMeta     *tfsdk.Config
 	ResourceSchema   fwschema.Schema
 	Resource         resource.Resource
+	TypeName         string
 }
 
 // PlanResourceChangeResponse is the framework server response for the
@@ -44,6 +46,22 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		return
 	}
 
+	ctx, sensitiveValues := withSensitiveValues(ctx, req.Config, req.PriorState, req.ProposedNewState)
+
+	defer func() {
+		resp.Diagnostics = redactSensitiveValues(resp.Diagnostics, sensitiveValues)
+	}()
+
+	defer func() {
+		resp.Diagnostics.Append(s.encryptPrivateState(ctx, resp.PlannedPrivate)...)
+	}()
+
+	resp.Diagnostics.Append(s.decryptPrivateState(ctx, req.PriorPrivate)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if _, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -104,6 +122,49 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 
 	resp.PlannedState = planToState(*req.ProposedNewState)
 
+	// Copy previous attribute values to the current attribute paths, so
+	// defaults and the resource logic only need to handle the current
+	// attributes.
+	if attributeAliases := resourceAttributeAliases(ctx, req.Resource); len(attributeAliases) > 0 && !resp.PlannedState.Raw.IsNull() {
+		aliasedConfig := configWithAttributeAliases(ctx, *req.Config, attributeAliases)
+		req.Config = &aliasedConfig
+
+		aliasedPlan, diags := planWithAttributeAliases(ctx, *resp.PlannedState, attributeAliases)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.PlannedState = &aliasedPlan
+	}
+
+	// Set Defaults.
+	//
+	// If the planned state is not null (i.e., not a destroy operation),
+	// traverse the schema, identifying any attributes which are null within
+	// the configuration, and if the attribute has a default value specified
+	// by the Default field on the attribute then the default value is
+	// assigned.
+	if !resp.PlannedState.Raw.IsNull() {
+		data := fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionPlan,
+			Schema:         req.ResourceSchema,
+			TerraformValue: resp.PlannedState.Raw,
+		}
+
+		diags := data.TransformDefaults(ctx, req.Config.Raw)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		resp.PlannedState.Raw = data.TerraformValue
+	}
+
 	// Execute any AttributePlanModifiers.
 	//
 	// This pass is before any Computed-only attributes are marked as unknown
@@ -252,6 +313,17 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 	// Ensure deterministic RequiresReplace by sorting and deduplicating
 	resp.RequiresReplace = NormaliseRequiresReplace(ctx, resp.RequiresReplace)
 
+	// Resources using a legacy type name can only be refreshed, imported, or
+	// destroyed.
+	if currentTypeName, ok := s.ResourceLegacyTypeName(ctx, req.TypeName); ok && !resp.PlannedState.Raw.IsNull() && !resp.PlannedState.Raw.Equal(req.PriorState.Raw) {
+		resp.Diagnostics.AddError(
+			"Resource Type Renamed",
+			fmt.Sprintf("The %q resource type has been renamed to %q. ", req.TypeName, currentTypeName)+
+				"Resources using the previous resource type name can only be refreshed, imported, or destroyed. "+
+				fmt.Sprintf("Update the configuration to use the %q resource type to create or update this resource.", currentTypeName),
+		)
+	}
+
 	// If this was a destroy resource plan, ensure the plan remained null.
 	if req.ProposedNewState.Raw.IsNull() && !resp.PlannedState.Raw.IsNull() {
 		resp.Diagnostics.AddError(
@@ -307,6 +379,65 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 			return val, nil
 		}
 
+		// Attributes with a default value are already set to the default
+		// value when null in the configuration.
+		switch a := attribute.(type) {
+		case fwschema.AttributeWithBoolDefaultValue:
+			if a.BoolDefaultValue() != nil {
+				logging.FrameworkTrace(ctx, "attribute has a default value, not marking unknown")
+
+				return val, nil
+			}
+		case fwschema.AttributeWithFloat64DefaultValue:
+			if a.Float64DefaultValue() != nil {
+				logging.FrameworkTrace(ctx, "attribute has a default value, not marking unknown")
+
+				return val, nil
+			}
+		case fwschema.AttributeWithInt64DefaultValue:
+			if a.Int64DefaultValue() != nil {
+				logging.FrameworkTrace(ctx, "attribute has a default value, not marking unknown")
+
+				return val, nil
+			}
+		case fwschema.AttributeWithListDefaultValue:
+			if a.ListDefaultValue() != nil {
+				logging.FrameworkTrace(ctx, "attribute has a default value, not marking unknown")
+
+				return val, nil
+			}
+		case fwschema.AttributeWithMapDefaultValue:
+			if a.MapDefaultValue() != nil {
+				logging.FrameworkTrace(ctx, "attribute has a default value, not marking unknown")
+
+				return val, nil
+			}
+		case fwschema.AttributeWithNumberDefaultValue:
+			if a.NumberDefaultValue() != nil {
+				logging.FrameworkTrace(ctx, "attribute has a default value, not marking unknown")
+
+				return val, nil
+			}
+		case fwschema.AttributeWithObjectDefaultValue:
+			if a.ObjectDefaultValue() != nil {
+				logging.FrameworkTrace(ctx, "attribute has a default value, not marking unknown")
+
+				return val, nil
+			}
+		case fwschema.AttributeWithSetDefaultValue:
+			if a.SetDefaultValue() != nil {
+				logging.FrameworkTrace(ctx, "attribute has a default value, not marking unknown")
+
+				return val, nil
+			}
+		case fwschema.AttributeWithStringDefaultValue:
+			if a.StringDefaultValue() != nil {
+				logging.FrameworkTrace(ctx, "attribute has a default value, not marking unknown")
+
+				return val, nil
+			}
+		}
+
 		logging.FrameworkDebug(ctx, "marking computed attribute that is null in the config as unknown")
 
 		return tftypes.NewValue(val.Type(), tftypes.UnknownValue), nil
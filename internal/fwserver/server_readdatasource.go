@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -32,6 +33,12 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		return
 	}
 
+	ctx, sensitiveValues := withSensitiveValues(ctx, req.Config)
+
+	defer func() {
+		resp.Diagnostics = redactSensitiveValues(resp.Diagnostics, sensitiveValues)
+	}()
+
 	if _, ok := req.DataSource.(datasource.DataSourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
@@ -77,4 +84,45 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 
 	resp.Diagnostics = readResp.Diagnostics
 	resp.State = &readResp.State
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Config == nil {
+		return
+	}
+
+	semanticEqualityReq := SchemaSemanticEqualityRequest{
+		PriorData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionConfiguration,
+			Schema:         req.Config.Schema,
+			TerraformValue: req.Config.Raw.Copy(),
+		},
+		ProposedNewData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionState,
+			Schema:         resp.State.Schema,
+			TerraformValue: resp.State.Raw.Copy(),
+		},
+	}
+	semanticEqualityResp := &SchemaSemanticEqualityResponse{
+		NewData: semanticEqualityReq.ProposedNewData,
+	}
+
+	SchemaSemanticEquality(ctx, semanticEqualityReq, semanticEqualityResp)
+
+	resp.Diagnostics.Append(semanticEqualityResp.Diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure new data is updated if semantic equality changed any values.
+	if semanticEqualityResp.NewData.TerraformValue.Equal(resp.State.Raw) {
+		return
+	}
+
+	logging.FrameworkDebug(ctx, "State updated due to semantic equality")
+
+	resp.State.Raw = semanticEqualityResp.NewData.TerraformValue
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -43,6 +45,22 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		return
 	}
 
+	ctx, sensitiveValues := withSensitiveValues(ctx, req.CurrentState)
+
+	defer func() {
+		resp.Diagnostics = redactSensitiveValues(resp.Diagnostics, sensitiveValues)
+	}()
+
+	defer func() {
+		resp.Diagnostics.Append(s.encryptPrivateState(ctx, resp.Private)...)
+	}()
+
+	resp.Diagnostics.Append(s.decryptPrivateState(ctx, req.Private)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if _, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -93,13 +111,45 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		resp.Private = req.Private
 	}
 
-	logging.FrameworkDebug(ctx, "Calling provider defined Resource Read")
-	req.Resource.Read(ctx, readReq, &readResp)
-	logging.FrameworkDebug(ctx, "Called provider defined Resource Read")
+	var readIncrementalPaths path.Paths
+	var readIncremental bool
+
+	resourceWithReadIncremental, ok := req.Resource.(resource.ResourceWithReadIncremental)
+
+	if ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithReadIncremental")
+
+		readIncrementalPaths, readIncremental = readIncrementalChangedPaths(ctx, req.Private, req.CurrentState.Raw, readIncrementalMaxAge(ctx, resourceWithReadIncremental))
+	}
+
+	if readIncremental {
+		readIncrementalReq := resource.ReadIncrementalRequest{
+			State:        readReq.State,
+			Paths:        readIncrementalPaths,
+			Private:      readReq.Private,
+			ProviderMeta: readReq.ProviderMeta,
+		}
+
+		logFields := map[string]interface{}{
+			logging.KeyAttributePaths: pathsStrings(readIncrementalPaths),
+		}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource ReadIncremental", logFields)
+		resourceWithReadIncremental.ReadIncremental(ctx, readIncrementalReq, &readResp)
+		logging.FrameworkDebug(ctx, "Called provider defined Resource ReadIncremental", logFields)
+	} else {
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource Read")
+		req.Resource.Read(ctx, readReq, &readResp)
+		logging.FrameworkDebug(ctx, "Called provider defined Resource Read")
+	}
 
 	resp.Diagnostics = readResp.Diagnostics
 	resp.NewState = &readResp.State
 
+	// The new state can contain sensitive values which are not in the
+	// current state.
+	ctx, sensitiveValues = addSensitiveValues(ctx, sensitiveValues, resp.NewState)
+
 	if readResp.Private != nil {
 		if resp.Private == nil {
 			resp.Private = &privatestate.Data{}
@@ -107,4 +157,97 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 
 		resp.Private.Provider = readResp.Private
 	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emptyObjectPolicyReq := SchemaEmptyObjectPolicyRequest{
+		PriorData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionState,
+			Schema:         req.CurrentState.Schema,
+			TerraformValue: req.CurrentState.Raw.Copy(),
+		},
+		ProposedNewData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionState,
+			Schema:         resp.NewState.Schema,
+			TerraformValue: resp.NewState.Raw.Copy(),
+		},
+	}
+	emptyObjectPolicyResp := &SchemaEmptyObjectPolicyResponse{
+		NewData: emptyObjectPolicyReq.ProposedNewData,
+	}
+
+	SchemaEmptyObjectPolicy(ctx, emptyObjectPolicyReq, emptyObjectPolicyResp)
+
+	resp.Diagnostics.Append(emptyObjectPolicyResp.Diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure new data is updated if empty object policies changed any values.
+	if !emptyObjectPolicyResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
+		logging.FrameworkDebug(ctx, "State updated due to empty object policy")
+
+		resp.NewState.Raw = emptyObjectPolicyResp.NewData.TerraformValue
+	}
+
+	newStateRaw, private, diags := eventualConsistencyRead(ctx, resp.NewState.Schema, req.CurrentState.Raw, resp.NewState.Raw, resp.Private)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.NewState.Raw = newStateRaw
+	resp.Private = private
+
+	semanticEqualityReq := SchemaSemanticEqualityRequest{
+		PriorData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionState,
+			Schema:         req.CurrentState.Schema,
+			TerraformValue: req.CurrentState.Raw.Copy(),
+		},
+		ProposedNewData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionState,
+			Schema:         resp.NewState.Schema,
+			TerraformValue: resp.NewState.Raw.Copy(),
+		},
+	}
+	semanticEqualityResp := &SchemaSemanticEqualityResponse{
+		NewData: semanticEqualityReq.ProposedNewData,
+	}
+
+	SchemaSemanticEquality(ctx, semanticEqualityReq, semanticEqualityResp)
+
+	resp.Diagnostics.Append(semanticEqualityResp.Diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure new data is updated if semantic equality changed any values.
+	if !semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
+		logging.FrameworkDebug(ctx, "State updated due to semantic equality")
+
+		resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	}
+
+	resp.Diagnostics.Append(canonicalizeState(ctx, req.Resource, nil, req.CurrentState, resp.NewState)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, ok := req.Resource.(resource.ResourceWithReadIncremental); !ok {
+		return
+	}
+
+	if resp.Private == nil {
+		resp.Private = privatestate.EmptyData(ctx)
+	}
+
+	resp.Diagnostics.Append(setReadFingerprints(resp.Private, resp.NewState.Raw, !readIncremental)...)
 }
@@ -3,16 +3,25 @@ package fwserver_test
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -65,6 +74,100 @@ func TestServerReadResource(t *testing.T) {
 		Schema: testSchema,
 	}
 
+	testSchemaSemanticEquality := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+				CustomType: testtypes.StringTypeWithSemanticEquals{
+					SemanticEquals: true,
+				},
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testCurrentStateSemanticEquality := &tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+			"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+		}),
+		Schema: testSchemaSemanticEquality,
+	}
+
+	testSchemaSensitive := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testCurrentStateSensitive := &tfsdk.State{
+		Raw:    testCurrentStateValue,
+		Schema: testSchemaSensitive,
+	}
+
+	testNewStateSensitive := &tfsdk.State{
+		Raw:    testNewStateValue,
+		Schema: testSchemaSensitive,
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested": tftypes.String,
+		},
+	}
+
+	testSchemaEmptyObjectPolicy := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_object": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_nested": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional:          true,
+				EmptyObjectPolicy: schema.EmptyObjectPolicyNull,
+			},
+		},
+	}
+
+	testCurrentStateEmptyObjectPolicy := &tfsdk.State{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_object": testNestedType,
+				},
+			},
+			map[string]tftypes.Value{
+				"test_object": tftypes.NewValue(testNestedType, nil),
+			},
+		),
+		Schema: testSchemaEmptyObjectPolicy,
+	}
+
+	testCurrentStateEmptyObjectPolicyEmpty := &tfsdk.State{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_object": testNestedType,
+				},
+			},
+			map[string]tftypes.Value{
+				"test_object": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"test_nested": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		),
+		Schema: testSchemaEmptyObjectPolicy,
+	}
+
 	testNewStateRemoved := &tfsdk.State{
 		Raw:    tftypes.NewValue(testType, nil),
 		Schema: testSchema,
@@ -99,6 +202,83 @@ func TestServerReadResource(t *testing.T) {
 		Provider: testEmptyProviderData,
 	}
 
+	testReadFingerprints := func(value tftypes.Value) map[string][]byte {
+		var attributes map[string]tftypes.Value
+
+		if err := value.As(&attributes); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		fingerprints := make(map[string]string, len(attributes))
+
+		for name, attribute := range attributes {
+			dynamicValue, err := tfprotov6.NewDynamicValue(attribute.Type(), attribute)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			sum := sha256.Sum256(dynamicValue.MsgPack)
+
+			fingerprints[name] = hex.EncodeToString(sum[:])
+		}
+
+		fingerprintsJSON, err := json.Marshal(fingerprints)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return map[string][]byte{
+			".read_fingerprints": fingerprintsJSON,
+		}
+	}
+
+	testStart := time.Now()
+
+	testReadFullRefresh := func(lastFullRefresh time.Time) []byte {
+		lastFullRefreshJSON, err := json.Marshal(lastFullRefresh.UTC())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return lastFullRefreshJSON
+	}
+
+	// testReadFullRefreshNow is a placeholder for the current time, which is
+	// recorded after full refreshes and verified separately.
+	testReadFullRefreshNow := []byte("now")
+
+	testReadIncrementalPrivate := func(value tftypes.Value, lastFullRefresh []byte) map[string][]byte {
+		result := testReadFingerprints(value)
+
+		result[".read_full_refresh"] = lastFullRefresh
+
+		return result
+	}
+
+	testPrivateStateCodec := &testprovider.PrivateStateCodec{
+		DecryptMethod: func(_ context.Context, _ string, value []byte) ([]byte, error) {
+			return []byte(strings.TrimPrefix(string(value), "test-encrypted:")), nil
+		},
+		EncryptMethod: func(_ context.Context, _ string, value []byte) ([]byte, error) {
+			return append([]byte("test-encrypted:"), value...), nil
+		},
+	}
+
+	testEncryptedProviderKeyValue, err := json.Marshal("tf-framework-encrypted:v1:" + base64.StdEncoding.EncodeToString([]byte(`test-encrypted:{"pKeyOne": {"k0": "zero", "k1": 1}}`)))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testPrivateProviderEncrypted := &privatestate.Data{
+		Provider: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+			"providerKeyOne": testEncryptedProviderKeyValue,
+		})),
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ReadResourceRequest
@@ -231,6 +411,236 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"readincremental-no-fingerprints": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.ResourceWithReadIncremental{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), types.StringValue("test-newstate-value"))...)
+						},
+					},
+					ReadIncrementalMethod: func(ctx context.Context, req resource.ReadIncrementalRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.AddError("unexpected ReadIncremental call", "")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewState,
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testNewStateValue, testReadFullRefreshNow),
+					Provider:  testEmptyProviderData,
+				},
+			},
+		},
+		"readincremental-no-full-refresh": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.ResourceWithReadIncremental{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), types.StringValue("test-newstate-value"))...)
+						},
+					},
+					ReadIncrementalMethod: func(ctx context.Context, req resource.ReadIncrementalRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.AddError("unexpected ReadIncremental call", "")
+					},
+				},
+				Private: &privatestate.Data{
+					Framework: testReadFingerprints(testCurrentStateValue),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewState,
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testNewStateValue, testReadFullRefreshNow),
+					Provider:  testEmptyProviderData,
+				},
+			},
+		},
+		"readincremental-max-age-exceeded": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.ResourceWithReadIncremental{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), types.StringValue("test-newstate-value"))...)
+						},
+					},
+					ReadIncrementalMethod: func(ctx context.Context, req resource.ReadIncrementalRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.AddError("unexpected ReadIncremental call", "")
+					},
+				},
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testCurrentStateValue, testReadFullRefresh(testStart.Add(-2*resource.DefaultReadIncrementalMaxAge))),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewState,
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testNewStateValue, testReadFullRefreshNow),
+					Provider:  testEmptyProviderData,
+				},
+			},
+		},
+		"readincremental-max-age-resource-exceeded": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.ResourceWithReadIncrementalMaxAge{
+					ResourceWithReadIncremental: &testprovider.ResourceWithReadIncremental{
+						Resource: &testprovider.Resource{
+							ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+								resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), types.StringValue("test-newstate-value"))...)
+							},
+						},
+						ReadIncrementalMethod: func(ctx context.Context, req resource.ReadIncrementalRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.AddError("unexpected ReadIncremental call", "")
+						},
+					},
+					ReadIncrementalMaxAgeMethod: func(_ context.Context) time.Duration {
+						return 30 * time.Minute
+					},
+				},
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testCurrentStateValue, testReadFullRefresh(testStart.Add(-time.Hour))),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewState,
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testNewStateValue, testReadFullRefreshNow),
+					Provider:  testEmptyProviderData,
+				},
+			},
+		},
+		"readincremental-max-age-resource-disabled": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.ResourceWithReadIncrementalMaxAge{
+					ResourceWithReadIncremental: &testprovider.ResourceWithReadIncremental{
+						Resource: &testprovider.Resource{
+							ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+								resp.Diagnostics.AddError("unexpected Read call", "")
+							},
+						},
+					},
+					ReadIncrementalMaxAgeMethod: func(_ context.Context) time.Duration {
+						return -1
+					},
+				},
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testCurrentStateValue, testReadFullRefresh(testStart.Add(-2*resource.DefaultReadIncrementalMaxAge))),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentState,
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testCurrentStateValue, testReadFullRefresh(testStart.Add(-2*resource.DefaultReadIncrementalMaxAge))),
+					Provider:  testEmptyProviderData,
+				},
+			},
+		},
+		"readincremental-paths": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.ResourceWithReadIncremental{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.AddError("unexpected Read call", "")
+						},
+					},
+					ReadIncrementalMethod: func(ctx context.Context, req resource.ReadIncrementalRequest, resp *resource.ReadResponse) {
+						expected := path.Paths{
+							path.Root("test_computed"),
+						}
+
+						if diff := cmp.Diff(req.Paths, expected); diff != "" {
+							resp.Diagnostics.AddError("unexpected req.Paths difference", diff)
+						}
+
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), types.StringValue("test-newstate-value"))...)
+					},
+				},
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testNewStateValue, testReadFullRefresh(testStart.Add(-time.Hour))),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewState,
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testNewStateValue, testReadFullRefresh(testStart.Add(-time.Hour))),
+					Provider:  testEmptyProviderData,
+				},
+			},
+		},
+		"readincremental-paths-empty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.ResourceWithReadIncremental{
+					Resource: &testprovider.Resource{},
+					ReadIncrementalMethod: func(ctx context.Context, req resource.ReadIncrementalRequest, resp *resource.ReadResponse) {
+						if diff := cmp.Diff(req.Paths, path.Paths{}); diff != "" {
+							resp.Diagnostics.AddError("unexpected req.Paths difference", diff)
+						}
+					},
+				},
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testCurrentStateValue, testReadFullRefresh(testStart.Add(-time.Hour))),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentState,
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testCurrentStateValue, testReadFullRefresh(testStart.Add(-time.Hour))),
+					Provider:  testEmptyProviderData,
+				},
+			},
+		},
+		"readincremental-removeresource": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.ResourceWithReadIncremental{
+					Resource: &testprovider.Resource{},
+					ReadIncrementalMethod: func(ctx context.Context, req resource.ReadIncrementalRequest, resp *resource.ReadResponse) {
+						resp.State.RemoveResource(ctx)
+					},
+				},
+				Private: &privatestate.Data{
+					Framework: testReadIncrementalPrivate(testNewStateValue, testReadFullRefresh(testStart.Add(-time.Hour))),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewStateRemoved,
+				Private: &privatestate.Data{
+					Framework: map[string][]byte{},
+					Provider:  testEmptyProviderData,
+				},
+			},
+		},
 		"resource-configure-data": {
 			server: &fwserver.Server{
 				Provider:              &testprovider.Provider{},
@@ -326,6 +736,195 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"response-state-sensitive-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentStateSensitive,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						var data struct {
+							TestComputed types.String `tfsdk:"test_computed"`
+							TestRequired types.String `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+						data.TestComputed = types.StringValue("test-newstate-value")
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+						resp.Diagnostics.AddWarning("warning summary", "warning detail: test-newstate-value")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.WithSensitiveValues(
+						[]string{"test-newstate-value"},
+						diag.NewWarningDiagnostic(
+							"warning summary",
+							"warning detail: test-newstate-value",
+						),
+					),
+				},
+				NewState: testNewStateSensitive,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"response-state-semantic-equality": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentStateSemanticEquality,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), types.StringValue("test-newstate-value"))...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentStateSemanticEquality,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"response-state-canonicalizers": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.ResourceWithStateCanonicalizers{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), types.StringValue(`{ "b": 2, "a": 1 }`))...)
+						},
+					},
+					StateCanonicalizersMethod: func(_ context.Context) []resource.StateCanonicalizer {
+						return []resource.StateCanonicalizer{
+							resource.JSONStringStateCanonicalizer(path.MatchRoot("test_computed")),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, `{"a":1,"b":2}`),
+						"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-canonicalizers-prior-state-equivalent": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, `{"b": 2, "a": 1}`),
+						"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Resource: &testprovider.ResourceWithStateCanonicalizers{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), types.StringValue(`{"a":1,"b":2}`))...)
+						},
+					},
+					StateCanonicalizersMethod: func(_ context.Context) []resource.StateCanonicalizer {
+						return []resource.StateCanonicalizer{
+							resource.JSONStringStateCanonicalizer(path.MatchRoot("test_computed")),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, `{"b": 2, "a": 1}`),
+						"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-canonicalizers-prior-state-changed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, `{"b": 2, "a": 1}`),
+						"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Resource: &testprovider.ResourceWithStateCanonicalizers{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), types.StringValue(`{ "a": 3 }`))...)
+						},
+					},
+					StateCanonicalizersMethod: func(_ context.Context) []resource.StateCanonicalizer {
+						return []resource.StateCanonicalizer{
+							resource.JSONStringStateCanonicalizer(path.MatchRoot("test_computed")),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, `{"a":3}`),
+						"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-empty-object-policy": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentStateEmptyObjectPolicy,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_object").AtName("test_nested"), types.StringNull())...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentStateEmptyObjectPolicy,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"response-state-empty-object-policy-prior-empty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentStateEmptyObjectPolicyEmpty,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_object").AtName("test_nested"), types.StringNull())...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentStateEmptyObjectPolicyEmpty,
+				Private:  testEmptyPrivate,
+			},
+		},
 		"response-state-removeresource": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -362,6 +961,91 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testPrivateProvider,
 			},
 		},
+		"response-private-codec": {
+			server: &fwserver.Server{
+				PrivateStateCodec: testPrivateStateCodec,
+				Provider:          &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						diags := resp.Private.SetKey(ctx, "providerKeyOne", []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`))
+
+						resp.Diagnostics.Append(diags...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentState,
+				Private:  testPrivateProviderEncrypted,
+			},
+		},
+		"request-private-codec": {
+			server: &fwserver.Server{
+				PrivateStateCodec: testPrivateStateCodec,
+				Provider:          &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						expected := `{"pKeyOne": {"k0": "zero", "k1": 1}}`
+
+						key, diags := req.Private.GetKey(ctx, "providerKeyOne")
+
+						resp.Diagnostics.Append(diags...)
+
+						if string(key) != expected {
+							resp.Diagnostics.AddError(
+								"Unexpected req.Private Value",
+								fmt.Sprintf("expected %q, got %q", expected, key),
+							)
+						}
+					},
+				},
+				Private: &privatestate.Data{
+					Provider: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+						"providerKeyOne": testEncryptedProviderKeyValue,
+					})),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentState,
+				Private:  testPrivateProviderEncrypted,
+			},
+		},
+		"request-private-codec-error": {
+			server: &fwserver.Server{
+				PrivateStateCodec: &testprovider.PrivateStateCodec{
+					DecryptMethod: func(_ context.Context, _ string, _ []byte) ([]byte, error) {
+						return nil, fmt.Errorf("test decrypt error")
+					},
+				},
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(_ context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.AddError("Unexpected Read Call", "Read should not be called when private state decryption fails.")
+					},
+				},
+				Private: &privatestate.Data{
+					Provider: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+						"providerKeyOne": testEncryptedProviderKeyValue,
+					})),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Error Decrypting Private State",
+						`An error was encountered when decrypting the private state value with key "providerKeyOne": test decrypt error`,
+					),
+				},
+			},
+		},
 		"response-private-updated": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -393,6 +1077,16 @@ func TestServerReadResource(t *testing.T) {
 			response := &fwserver.ReadResourceResponse{}
 			testCase.server.ReadResource(context.Background(), testCase.request, response)
 
+			if testCase.expectedResponse.Private != nil && bytes.Equal(testCase.expectedResponse.Private.Framework[".read_full_refresh"], testReadFullRefreshNow) && response.Private != nil {
+				var lastFullRefresh time.Time
+
+				if err := json.Unmarshal(response.Private.Framework[".read_full_refresh"], &lastFullRefresh); err != nil || lastFullRefresh.Before(testStart) {
+					t.Errorf("expected last full refresh time after %s, got: %s", testStart, response.Private.Framework[".read_full_refresh"])
+				}
+
+				response.Private.Framework[".read_full_refresh"] = testReadFullRefreshNow
+			}
+
 			if diff := cmp.Diff(response, testCase.expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
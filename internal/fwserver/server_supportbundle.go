@@ -0,0 +1,248 @@
+package fwserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// SupportBundleRequest is the information about a panicked RPC which is
+// written to a support bundle.
+type SupportBundleRequest struct {
+	// RPC is the name of the RPC, such as ApplyResourceChange.
+	RPC string
+
+	// TypeName is the resource or data source type name of the RPC, if any.
+	TypeName string
+
+	// Panic is the recovered panic value.
+	Panic any
+
+	// Stack is the goroutine stack trace of the panic.
+	Stack []byte
+
+	// Diagnostics are the diagnostics returned by the RPC before the panic.
+	Diagnostics []SupportBundleDiagnostic
+}
+
+// SupportBundleDiagnostic is a sanitized diagnostic in a support bundle. The
+// diagnostic detail is intentionally omitted, since it may contain values.
+type SupportBundleDiagnostic struct {
+	Attribute string `json:"attribute,omitempty"`
+	Severity  string `json:"severity"`
+	Summary   string `json:"summary"`
+}
+
+// supportBundle is the JSON encoded content of a support bundle.
+type supportBundle struct {
+	Created          time.Time                 `json:"created"`
+	Diagnostics      []SupportBundleDiagnostic `json:"diagnostics,omitempty"`
+	Panic            string                    `json:"panic,omitempty"`
+	ProviderTypeName string                    `json:"provider_type_name,omitempty"`
+	RPC              string                    `json:"rpc"`
+	Schema           []supportBundleAttribute  `json:"schema,omitempty"`
+	Stack            string                    `json:"stack,omitempty"`
+	TypeName         string                    `json:"type_name,omitempty"`
+}
+
+// supportBundleAttribute is an attribute or block in the schema snapshot of a
+// support bundle.
+type supportBundleAttribute struct {
+	Computed  bool   `json:"computed,omitempty"`
+	Optional  bool   `json:"optional,omitempty"`
+	Path      string `json:"path"`
+	Required  bool   `json:"required,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+	Type      string `json:"type"`
+}
+
+// supportBundleDataSourceRPCs are the RPCs which operate on data sources.
+var supportBundleDataSourceRPCs = map[string]bool{
+	"ReadDataSource":             true,
+	"ValidateDataResourceConfig": true,
+	"ValidateDataSourceConfig":   true,
+}
+
+// supportBundleProviderRPCs are the RPCs which operate on the provider
+// configuration.
+var supportBundleProviderRPCs = map[string]bool{
+	"ConfigureProvider":      true,
+	"PrepareProviderConfig":  true,
+	"ValidateProviderConfig": true,
+}
+
+// WriteSupportBundle writes a support bundle for an RPC which panicked to a
+// new file in SupportBundleDir, which must be set. The support bundle
+// contains the request information, a snapshot of the relevant schema, and
+// the panic stack trace. Configuration, plan, and state values are never
+// included. The returned diagnostics contain an error diagnostic for the
+// panic, which references the support bundle path, and the caller should
+// append them to the RPC response.
+func (s *Server) WriteSupportBundle(ctx context.Context, req SupportBundleRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bundle := supportBundle{
+		Created:          time.Now().UTC(),
+		Diagnostics:      req.Diagnostics,
+		Panic:            fmt.Sprint(req.Panic),
+		ProviderTypeName: s.providerTypeName,
+		RPC:              req.RPC,
+		Schema:           s.supportBundleSchema(req.RPC, req.TypeName),
+		Stack:            string(req.Stack),
+		TypeName:         req.TypeName,
+	}
+
+	bundlePath, err := writeSupportBundleFile(s.SupportBundleDir, bundle)
+
+	if err != nil {
+		logging.FrameworkError(ctx, "Unable to write support bundle", map[string]interface{}{logging.KeyError: err.Error()})
+
+		diags.Append(supportBundlePanicDiag(req.RPC, ""))
+		diags.AddWarning(
+			"Unable to Write Support Bundle",
+			"An unexpected error was encountered while writing a support bundle for troubleshooting the "+req.RPC+" request.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	logging.FrameworkDebug(ctx, "Wrote support bundle", map[string]interface{}{"path": bundlePath})
+
+	diags.Append(supportBundlePanicDiag(req.RPC, bundlePath))
+
+	return diags
+}
+
+// supportBundleSchema returns the schema snapshot for the RPC, if the schema
+// was already cached. Schemas are not fetched, since the provider defined
+// schema methods may be the cause of the failure.
+func (s *Server) supportBundleSchema(rpc string, typeName string) []supportBundleAttribute {
+	var schema fwschema.Schema
+
+	switch {
+	case supportBundleProviderRPCs[rpc]:
+		s.providerSchemaMutex.Lock()
+		schema = s.providerSchema
+		s.providerSchemaMutex.Unlock()
+	case typeName == "":
+		return nil
+	case supportBundleDataSourceRPCs[rpc]:
+		s.dataSourceSchemasMutex.Lock()
+		schema = s.dataSourceSchemas[typeName]
+		s.dataSourceSchemasMutex.Unlock()
+	default:
+		s.resourceSchemasMutex.Lock()
+		schema = s.resourceSchemas[typeName]
+		s.resourceSchemasMutex.Unlock()
+	}
+
+	if schema == nil {
+		return nil
+	}
+
+	var result []supportBundleAttribute
+
+	result = supportBundleAttributes(result, "", schema.GetAttributes(), schema.GetBlocks())
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result
+}
+
+// supportBundleAttributes appends the attributes and blocks, including any
+// nested attributes and blocks, to the schema snapshot.
+func supportBundleAttributes(result []supportBundleAttribute, parentPath string, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) []supportBundleAttribute {
+	for name, attribute := range attributes {
+		attributePath := supportBundlePath(parentPath, name)
+
+		result = append(result, supportBundleAttribute{
+			Computed:  attribute.IsComputed(),
+			Optional:  attribute.IsOptional(),
+			Path:      attributePath,
+			Required:  attribute.IsRequired(),
+			Sensitive: attribute.IsSensitive(),
+			Type:      fmt.Sprint(attribute.GetType()),
+		})
+
+		if nestedAttribute, ok := attribute.(fwschema.NestedAttribute); ok {
+			result = supportBundleAttributes(result, attributePath, nestedAttribute.GetNestedObject().GetAttributes(), nil)
+		}
+	}
+
+	for name, block := range blocks {
+		blockPath := supportBundlePath(parentPath, name)
+
+		result = append(result, supportBundleAttribute{
+			Path: blockPath,
+			Type: fmt.Sprint(block.Type()),
+		})
+
+		nestedObject := block.GetNestedObject()
+
+		result = supportBundleAttributes(result, blockPath, nestedObject.GetAttributes(), nestedObject.GetBlocks())
+	}
+
+	return result
+}
+
+// supportBundlePath returns the dot separated path of the name.
+func supportBundlePath(parentPath string, name string) string {
+	if parentPath == "" {
+		return name
+	}
+
+	return parentPath + "." + name
+}
+
+// writeSupportBundleFile writes the JSON encoded support bundle to a new
+// file in the directory, returning the file path.
+func writeSupportBundleFile(dir string, bundle supportBundle) (string, error) {
+	content, err := json.MarshalIndent(bundle, "", "  ")
+
+	if err != nil {
+		return "", err
+	}
+
+	// The temporary file is only readable and writable by the current user.
+	file, err := os.CreateTemp(dir, "terraform-provider-support-bundle-*.json")
+
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+
+		return "", err
+	}
+
+	if err := file.Close(); err != nil {
+		return "", err
+	}
+
+	return file.Name(), nil
+}
+
+// supportBundlePanicDiag returns an error diagnostic for a recovered panic,
+// which references the support bundle path if written.
+func supportBundlePanicDiag(rpc string, bundlePath string) diag.Diagnostic {
+	detail := "The provider unexpectedly panicked while handling the " + rpc + " request. " +
+		"This is always an issue in the provider and should be reported to the provider developers."
+
+	if bundlePath != "" {
+		detail += "\n\nA support bundle with the panic details was written to: " + bundlePath + "\n\n" +
+			"Review the file for any information which should not be shared, then include it when reporting the issue."
+	}
+
+	return diag.NewErrorDiagnostic("Provider Panic", detail)
+}
@@ -0,0 +1,100 @@
+package fwserver_test
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+)
+
+func TestServerWriteSupportBundle(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		supportBundleDir  func(t *testing.T) string
+		request           fwserver.SupportBundleRequest
+		expectedSummaries []string
+		expectedBundle    bool
+	}{
+		"panic": {
+			supportBundleDir: func(t *testing.T) string {
+				return t.TempDir()
+			},
+			request: fwserver.SupportBundleRequest{
+				RPC:   "ReadResource",
+				Panic: "test panic",
+			},
+			expectedSummaries: []string{"Provider Panic"},
+			expectedBundle:    true,
+		},
+		"panic-unwritable-dir": {
+			supportBundleDir: func(t *testing.T) string {
+				return filepath.Join(t.TempDir(), "missing")
+			},
+			request: fwserver.SupportBundleRequest{
+				RPC:   "ReadResource",
+				Panic: "test panic",
+			},
+			expectedSummaries: []string{"Provider Panic", "Unable to Write Support Bundle"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			supportBundleDir := testCase.supportBundleDir(t)
+
+			server := &fwserver.Server{
+				Provider:         &testprovider.Provider{},
+				SupportBundleDir: supportBundleDir,
+			}
+
+			got := server.WriteSupportBundle(context.Background(), testCase.request)
+
+			var gotSummaries []string
+
+			for _, d := range got {
+				gotSummaries = append(gotSummaries, d.Summary())
+			}
+
+			if diff := cmp.Diff(gotSummaries, testCase.expectedSummaries); diff != "" {
+				t.Errorf("unexpected summaries difference: %s", diff)
+			}
+
+			if got[0].Severity() != diag.SeverityError {
+				t.Errorf("expected panic error diagnostic, got: %s", got[0].Severity())
+			}
+
+			bundlePaths, err := filepath.Glob(filepath.Join(supportBundleDir, "*.json"))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.expectedBundle {
+				if len(bundlePaths) > 0 {
+					t.Fatalf("unexpected support bundles: %v", bundlePaths)
+				}
+
+				return
+			}
+
+			if len(bundlePaths) != 1 {
+				t.Fatalf("expected 1 support bundle, got: %v", bundlePaths)
+			}
+
+			if !strings.Contains(got[0].Detail(), bundlePaths[0]) {
+				t.Errorf("expected diagnostic detail to contain support bundle path %s, got: %s", bundlePaths[0], got[0].Detail())
+			}
+		})
+	}
+}
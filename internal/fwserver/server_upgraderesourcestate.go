@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -46,12 +47,24 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		return
 	}
 
+	var sensitiveValues []string
+
+	// The sensitive values are only known after the raw state is decoded
+	// with a schema, so they are added as each state becomes available.
+	defer func() {
+		_, sensitiveValues = addSensitiveValues(ctx, sensitiveValues, resp.UpgradedState)
+		resp.Diagnostics = redactSensitiveValues(resp.Diagnostics, sensitiveValues)
+	}()
+
+	undefinedAttributePolicy := s.undefinedAttributePolicy(ctx)
+
 	// Define options to be used when unmarshalling raw state.
-	// IgnoreUndefinedAttributes will silently skip over fields in the JSON
-	// that do not have a matching entry in the schema.
+	// IgnoreUndefinedAttributes will skip over fields in the JSON that do not
+	// have a matching entry in the schema, unless the provider defined policy
+	// is to return an error.
 	unmarshalOpts := tfprotov6.UnmarshalOpts{
 		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
-			IgnoreUndefinedAttributes: true,
+			IgnoreUndefinedAttributes: undefinedAttributePolicy != provider.UndefinedAttributePolicyError,
 		},
 	}
 
@@ -78,7 +91,9 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 		resourceSchemaType := req.ResourceSchema.Type().TerraformType(ctx)
 
-		rawStateValue, err := req.RawState.UnmarshalWithOpts(resourceSchemaType, unmarshalOpts)
+		rawState := rawStateWithAttributeAliases(ctx, req.RawState, resourceAttributeAliases(ctx, req.Resource), resourceSchemaType)
+
+		rawStateValue, err := unmarshalRawState(rawState, resourceSchemaType, unmarshalOpts)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -91,6 +106,8 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 			return
 		}
 
+		resp.Diagnostics.Append(rawStateUndefinedAttributesDiags(undefinedAttributePolicy, rawState, resourceSchemaType)...)
+
 		resp.UpgradedState = &tfsdk.State{
 			Schema: req.ResourceSchema,
 			Raw:    rawStateValue,
@@ -162,7 +179,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 		priorSchemaType := resourceStateUpgrader.PriorSchema.Type().TerraformType(ctx)
 
-		rawStateValue, err := req.RawState.UnmarshalWithOpts(priorSchemaType, unmarshalOpts)
+		rawStateValue, err := unmarshalRawState(req.RawState, priorSchemaType, unmarshalOpts)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -173,10 +190,14 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 			return
 		}
 
+		resp.Diagnostics.Append(rawStateUndefinedAttributesDiags(undefinedAttributePolicy, req.RawState, priorSchemaType)...)
+
 		upgradeResourceStateRequest.State = &tfsdk.State{
 			Raw:    rawStateValue,
 			Schema: *resourceStateUpgrader.PriorSchema,
 		}
+
+		ctx, sensitiveValues = addSensitiveValues(ctx, sensitiveValues, upgradeResourceStateRequest.State)
 	}
 
 	upgradeResourceStateResponse := resource.UpgradeStateResponse{
@@ -195,6 +216,12 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	resourceStateUpgrader.StateUpgrader(ctx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
 	logging.FrameworkDebug(ctx, "Called provider defined StateUpgrader")
 
+	s.sendEvent(ctx, provider.Event{
+		Type:              provider.EventTypeStateUpgrade,
+		Diagnostics:       upgradeResourceStateResponse.Diagnostics,
+		PriorStateVersion: req.Version,
+	})
+
 	resp.Diagnostics.Append(upgradeResourceStateResponse.Diagnostics...)
 
 	if resp.Diagnostics.HasError() {
@@ -215,11 +242,19 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 			return
 		}
 
-		resp.UpgradedState = &tfsdk.State{
+		upgradedState, diags := stateWithAttributeAliases(ctx, tfsdk.State{
 			Schema: req.ResourceSchema,
 			Raw:    upgradedStateValue,
+		}, resourceAttributeAliases(ctx, req.Resource))
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
 		}
 
+		resp.UpgradedState = &upgradedState
+
 		return
 	}
 
@@ -233,5 +268,13 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		return
 	}
 
-	resp.UpgradedState = &upgradeResourceStateResponse.State
+	upgradedState, diags := stateWithAttributeAliases(ctx, upgradeResourceStateResponse.State, resourceAttributeAliases(ctx, req.Resource))
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.UpgradedState = &upgradedState
 }
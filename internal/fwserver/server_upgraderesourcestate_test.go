@@ -14,9 +14,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func TestServerUpgradeResourceState(t *testing.T) {
@@ -514,6 +516,82 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"PriorSchema-and-State-flatmap": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: &tfprotov6.RawState{
+					Flatmap: map[string]string{
+						"id":                 "test-id-value",
+						"required_attribute": "true",
+					},
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUpgradeState{
+					Resource: &testprovider.Resource{},
+					UpgradeStateMethod: func(ctx context.Context) map[int64]resource.StateUpgrader {
+						return map[int64]resource.StateUpgrader{
+							0: {
+								PriorSchema: &schema.Schema{
+									Attributes: map[string]schema.Attribute{
+										"id": schema.StringAttribute{
+											Computed: true,
+										},
+										"optional_attribute": schema.BoolAttribute{
+											Optional: true,
+										},
+										"required_attribute": schema.BoolAttribute{
+											Required: true,
+										},
+									},
+								},
+								StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+									var priorStateData struct {
+										Id                string `tfsdk:"id"`
+										OptionalAttribute *bool  `tfsdk:"optional_attribute"`
+										RequiredAttribute bool   `tfsdk:"required_attribute"`
+									}
+
+									resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+
+									if resp.Diagnostics.HasError() {
+										return
+									}
+
+									upgradedStateData := struct {
+										Id                string  `tfsdk:"id"`
+										OptionalAttribute *string `tfsdk:"optional_attribute"`
+										RequiredAttribute string  `tfsdk:"required_attribute"`
+									}{
+										Id:                priorStateData.Id,
+										RequiredAttribute: fmt.Sprintf("%t", priorStateData.RequiredAttribute),
+									}
+
+									if priorStateData.OptionalAttribute != nil {
+										v := fmt.Sprintf("%t", *priorStateData.OptionalAttribute)
+										upgradedStateData.OptionalAttribute = &v
+									}
+
+									resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+								},
+							},
+						}
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
 		"PriorSchema-and-State-json-mismatch": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -646,13 +724,51 @@ func TestServerUpgradeResourceState(t *testing.T) {
 			request: &fwserver.UpgradeResourceStateRequest{
 				RawState: &tfprotov6.RawState{
 					Flatmap: map[string]string{
-						"flatmap": "is not supported",
+						"id":                 "test-id-value",
+						"required_attribute": "true",
 					},
 				},
 				ResourceSchema: testSchema,
 				Resource:       &testprovider.Resource{},
 				Version:        1, // Must match current tfsdk.Schema version to trigger framework implementation
 			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"Version-current-flatmap-invalid": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: &tfprotov6.RawState{
+					Flatmap: map[string]string{
+						"id":     "test-id-value",
+						"tags.#": "invalid",
+					},
+				},
+				ResourceSchema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"tags": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+					Version: 1,
+				},
+				Resource: &testprovider.Resource{},
+				Version:  1, // Must match current tfsdk.Schema version to trigger framework implementation
+			},
 			expectedResponse: &fwserver.UpgradeResourceStateResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewErrorDiagnostic(
@@ -661,7 +777,7 @@ func TestServerUpgradeResourceState(t *testing.T) {
 							"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
 							"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
 							"Otherwise, please report this to the provider developer:\n\n"+
-							"flatmap states cannot be unmarshaled, only states written by Terraform 0.12 and higher can be unmarshaled",
+							`invalid flatmap element count at "tags.#": strconv.Atoi: parsing "invalid": invalid syntax`,
 					),
 				},
 			},
@@ -705,6 +821,45 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"Version-current-json-attribute-aliases": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithUndefinedAttributePolicy{
+					Provider: &testprovider.Provider{},
+					UndefinedAttributePolicyMethod: func(_ context.Context) provider.UndefinedAttributePolicy {
+						return provider.UndefinedAttributePolicyWarn
+					},
+				},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"previous_attribute": "test-previous-value",
+					"required_attribute": "true",
+					"previous_required":  "false",
+				}),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithAttributeAliases{
+					Resource: &testprovider.Resource{},
+					AttributeAliasesMethod: func(_ context.Context) map[string]string {
+						return map[string]string{
+							"previous_attribute": "optional_attribute",
+							"previous_required":  "required_attribute",
+						}
+					},
+				},
+				Version: 1, // Must match current tfsdk.Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, "test-previous-value"),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
 		"Version-current-json-mismatch": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -730,6 +885,76 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"Version-current-json-mismatch-policy-warn": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithUndefinedAttributePolicy{
+					Provider: &testprovider.Provider{},
+					UndefinedAttributePolicyMethod: func(_ context.Context) provider.UndefinedAttributePolicy {
+						return provider.UndefinedAttributePolicyWarn
+					},
+				},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                    "test-id-value",
+					"required_attribute":    "true",
+					"nonexistent_attribute": "value",
+				}),
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+				Version:        1, // Must match current tfsdk.Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Undefined Resource State Attributes Removed",
+						"The saved resource state contained attributes which are not defined in the resource schema, "+
+							"such as after downgrading the provider. The attributes were removed from the resource state.\n\n"+
+							"Attributes: nonexistent_attribute",
+					),
+				},
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"Version-current-json-mismatch-policy-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithUndefinedAttributePolicy{
+					Provider: &testprovider.Provider{},
+					UndefinedAttributePolicyMethod: func(_ context.Context) provider.UndefinedAttributePolicy {
+						return provider.UndefinedAttributePolicyError
+					},
+				},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                    "test-id-value",
+					"required_attribute":    "true",
+					"nonexistent_attribute": "value",
+				}),
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+				Version:        1, // Must match current tfsdk.Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Read Previously Saved State for UpgradeResourceState",
+						"There was an error reading the saved resource state using the current resource schema.\n\n"+
+							"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
+							"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
+							"Otherwise, please report this to the provider developer:\n\n"+
+							`AttributeName("nonexistent_attribute"): unsupported attribute "nonexistent_attribute"`,
+					),
+				},
+			},
+		},
 		"Version-not-implemented": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -28,6 +28,16 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		return
 	}
 
+	defer func() {
+		s.eventValidationFailure(ctx, resp.Diagnostics)
+	}()
+
+	ctx, sensitiveValues := withSensitiveValues(ctx, req.Config)
+
+	defer func() {
+		resp.Diagnostics = redactSensitiveValues(resp.Diagnostics, sensitiveValues)
+	}()
+
 	if _, ok := req.DataSource.(datasource.DataSourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
@@ -28,6 +28,16 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 		return
 	}
 
+	defer func() {
+		s.eventValidationFailure(ctx, resp.Diagnostics)
+	}()
+
+	ctx, sensitiveValues := withSensitiveValues(ctx, req.Config)
+
+	defer func() {
+		resp.Diagnostics = redactSensitiveValues(resp.Diagnostics, sensitiveValues)
+	}()
+
 	vpcReq := provider.ValidateConfigRequest{
 		Config: *req.Config,
 	}
@@ -2,6 +2,7 @@ package fwserver
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -14,6 +15,7 @@ import (
 type ValidateResourceConfigRequest struct {
 	Config   *tfsdk.Config
 	Resource resource.Resource
+	TypeName string
 }
 
 // ValidateResourceConfigResponse is the framework server response for the
@@ -28,6 +30,16 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		return
 	}
 
+	defer func() {
+		s.eventValidationFailure(ctx, resp.Diagnostics)
+	}()
+
+	ctx, sensitiveValues := withSensitiveValues(ctx, req.Config)
+
+	defer func() {
+		resp.Diagnostics = redactSensitiveValues(resp.Diagnostics, sensitiveValues)
+	}()
+
 	if _, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -47,6 +59,25 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		}
 	}
 
+	if currentTypeName, ok := s.ResourceLegacyTypeName(ctx, req.TypeName); ok {
+		resp.Diagnostics.AddWarning(
+			"Resource Type Renamed",
+			fmt.Sprintf("The %q resource type has been renamed to %q. ", req.TypeName, currentTypeName)+
+				"Existing resources using the previous resource type name can still be refreshed, imported, and destroyed, "+
+				"however they cannot be created or updated. "+
+				fmt.Sprintf("Update the configuration to use the %q resource type.", currentTypeName),
+		)
+	}
+
+	attributeAliases := resourceAttributeAliases(ctx, req.Resource)
+
+	resp.Diagnostics.Append(configAttributeAliasesDiags(ctx, *req.Config, attributeAliases)...)
+
+	// Validate the configuration with previous attribute values available
+	// at the current attribute paths.
+	aliasedConfig := configWithAttributeAliases(ctx, *req.Config, attributeAliases)
+	req.Config = &aliasedConfig
+
 	vdscReq := resource.ValidateConfigRequest{
 		Config: *req.Config,
 	}
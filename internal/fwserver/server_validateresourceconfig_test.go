@@ -86,6 +86,48 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testSchemaAttributeAliases := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"previous": schema.StringAttribute{
+				Optional: true,
+			},
+			"test": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testAttributeAliasesConfig := func(previous, test interface{}) *tfsdk.Config {
+		return &tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"previous": tftypes.String,
+						"test":     tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"previous": tftypes.NewValue(tftypes.String, previous),
+					"test":     tftypes.NewValue(tftypes.String, test),
+				},
+			),
+			Schema: testSchemaAttributeAliases,
+		}
+	}
+
+	testAttributeAliasesResource := &testprovider.ResourceWithAttributeAliases{
+		Resource: &testprovider.Resource{
+			SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+				resp.Schema = testSchemaAttributeAliases
+			},
+		},
+		AttributeAliasesMethod: func(_ context.Context) map[string]string {
+			return map[string]string{
+				"previous": "test",
+			}
+		},
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateResourceConfigRequest
@@ -111,6 +153,96 @@ func TestServerValidateResourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
 		},
+		"request-config-legacy-type-name": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.ResourceWithLegacyTypeNames{
+									Resource: &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									},
+									LegacyTypeNamesMethod: func(_ context.Context) []string {
+										return []string{"test_legacy_resource"}
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchema
+					},
+				},
+				TypeName: "test_legacy_resource",
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Resource Type Renamed",
+						`The "test_legacy_resource" resource type has been renamed to "test_resource". `+
+							"Existing resources using the previous resource type name can still be refreshed, imported, and destroyed, "+
+							"however they cannot be created or updated. "+
+							`Update the configuration to use the "test_resource" resource type.`,
+					),
+				},
+			},
+		},
+		"request-config-ResourceWithAttributeAliases": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config:   testAttributeAliasesConfig(nil, "test-value"),
+				Resource: testAttributeAliasesResource,
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-ResourceWithAttributeAliases-previous": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config:   testAttributeAliasesConfig("test-value", nil),
+				Resource: testAttributeAliasesResource,
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("previous"),
+						"Attribute Renamed",
+						`The "previous" attribute has been renamed to "test". `+
+							`Update the configuration to use the "test" attribute, as the "previous" attribute may be removed in a future version.`,
+					),
+				},
+			},
+		},
+		"request-config-ResourceWithAttributeAliases-conflict": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config:   testAttributeAliasesConfig("test-value", "test-value"),
+				Resource: testAttributeAliasesResource,
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("previous"),
+						"Conflicting Renamed Attribute Configuration",
+						`The "previous" attribute has been renamed to "test" and both attributes are configured. `+
+							`Remove the "previous" attribute from the configuration.`,
+					),
+				},
+			},
+		},
 		"request-config-AttributeValidator": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -0,0 +1,146 @@
+package fwserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// undefinedAttributePolicy returns the provider defined
+// UndefinedAttributePolicy, if implemented, otherwise
+// provider.UndefinedAttributePolicyIgnore.
+func (s *Server) undefinedAttributePolicy(ctx context.Context) provider.UndefinedAttributePolicy {
+	providerWithUndefinedAttributePolicy, ok := s.Provider.(provider.ProviderWithUndefinedAttributePolicy)
+
+	if !ok {
+		return provider.UndefinedAttributePolicyIgnore
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithUndefinedAttributePolicy")
+
+	return providerWithUndefinedAttributePolicy.UndefinedAttributePolicy(ctx)
+}
+
+// rawStateUndefinedAttributesDiags returns a warning diagnostic if the policy
+// is provider.UndefinedAttributePolicyWarn and the JSON raw state contains
+// attributes which are not defined in the given type.
+func rawStateUndefinedAttributesDiags(policy provider.UndefinedAttributePolicy, rawState *tfprotov6.RawState, typ tftypes.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if policy != provider.UndefinedAttributePolicyWarn || rawState == nil || len(rawState.JSON) == 0 {
+		return diags
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(rawState.JSON))
+	decoder.UseNumber()
+
+	var value any
+
+	// Errors are already handled by unmarshalling the raw state.
+	if err := decoder.Decode(&value); err != nil {
+		return diags
+	}
+
+	attributes := undefinedAttributes(value, typ, "")
+
+	if len(attributes) == 0 {
+		return diags
+	}
+
+	sort.Strings(attributes)
+
+	diags.AddWarning(
+		"Undefined Resource State Attributes Removed",
+		"The saved resource state contained attributes which are not defined in the resource schema, "+
+			"such as after downgrading the provider. The attributes were removed from the resource state.\n\n"+
+			"Attributes: "+strings.Join(attributes, ", "),
+	)
+
+	return diags
+}
+
+// undefinedAttributes returns the paths of all object attributes within the
+// decoded JSON value which are not defined in the given type.
+func undefinedAttributes(value any, typ tftypes.Type, prefix string) []string {
+	var result []string
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		object, ok := value.(map[string]any)
+
+		if !ok {
+			return nil
+		}
+
+		for name, attributeValue := range object {
+			attributePath := name
+
+			if prefix != "" {
+				attributePath = prefix + "." + name
+			}
+
+			attributeType, ok := typ.AttributeTypes[name]
+
+			if !ok {
+				result = append(result, attributePath)
+
+				continue
+			}
+
+			result = append(result, undefinedAttributes(attributeValue, attributeType, attributePath)...)
+		}
+	case tftypes.List:
+		result = undefinedElementAttributes(value, func(int) tftypes.Type { return typ.ElementType }, prefix)
+	case tftypes.Set:
+		result = undefinedElementAttributes(value, func(int) tftypes.Type { return typ.ElementType }, prefix)
+	case tftypes.Tuple:
+		result = undefinedElementAttributes(value, func(index int) tftypes.Type {
+			if index >= len(typ.ElementTypes) {
+				return nil
+			}
+
+			return typ.ElementTypes[index]
+		}, prefix)
+	case tftypes.Map:
+		elements, ok := value.(map[string]any)
+
+		if !ok {
+			return nil
+		}
+
+		for key, elementValue := range elements {
+			result = append(result, undefinedAttributes(elementValue, typ.ElementType, fmt.Sprintf("%s[%q]", prefix, key))...)
+		}
+	}
+
+	return result
+}
+
+// undefinedElementAttributes returns the paths of all object attributes
+// within the decoded JSON array value which are not defined in the element
+// types.
+func undefinedElementAttributes(value any, elementType func(int) tftypes.Type, prefix string) []string {
+	elements, ok := value.([]any)
+
+	if !ok {
+		return nil
+	}
+
+	var result []string
+
+	for index, elementValue := range elements {
+		result = append(result, undefinedAttributes(elementValue, elementType(index), fmt.Sprintf("%s[%d]", prefix, index))...)
+	}
+
+	return result
+}
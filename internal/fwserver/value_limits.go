@@ -0,0 +1,337 @@
+package fwserver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ValueLimits are the decode-time limits of configuration, plan, and state
+// values received from Terraform. Values are checked before they are decoded,
+// so pathologically deep or large values return an error diagnostic instead
+// of exhausting the provider resources. The zero value disables all checks.
+type ValueLimits struct {
+	// MaxDepth is the maximum nesting depth of collection and object values.
+	// Zero or negative values disable the check.
+	MaxDepth int
+
+	// MaxElements is the maximum total number of collection elements and
+	// object attributes within a value. Zero or negative values disable the
+	// check.
+	MaxElements int
+}
+
+// maxDepth returns the effective maximum depth, or zero if disabled.
+func (l ValueLimits) maxDepth() int {
+	if l.MaxDepth < 0 {
+		return 0
+	}
+
+	return l.MaxDepth
+}
+
+// maxElements returns the effective maximum elements, or zero if disabled.
+func (l ValueLimits) maxElements() int {
+	if l.MaxElements < 0 {
+		return 0
+	}
+
+	return l.MaxElements
+}
+
+// Enabled returns true if any limit check is enabled.
+func (l ValueLimits) Enabled() bool {
+	return l.maxDepth() > 0 || l.maxElements() > 0
+}
+
+// CheckDynamicValue returns an error diagnostic if the MessagePack or JSON
+// encoded value, such as a protocol DynamicValue, exceeds the limits. The
+// description is a human readable name of the value, such as "prior state".
+//
+// Malformed data does not return diagnostics, so the error is instead
+// returned when the value is decoded.
+func (l ValueLimits) CheckDynamicValue(description string, msgpack []byte, jsonData []byte) diag.Diagnostics {
+	if !l.Enabled() {
+		return nil
+	}
+
+	var stats valueLimitsStats
+
+	switch {
+	case len(msgpack) > 0:
+		stats = l.msgpackStats(msgpack)
+	case len(jsonData) > 0:
+		stats = l.jsonStats(jsonData)
+	default:
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	if stats.depthExceeded {
+		diags.AddError(
+			"Value Nesting Depth Limit Exceeded",
+			fmt.Sprintf("The %s value exceeds the maximum nesting depth of %d collection and object values. ", description, l.maxDepth())+
+				"This is typically caused by malformed or unexpectedly deep data. "+
+				"If this value is expected, the provider developer can raise the limit.",
+		)
+	}
+
+	if stats.elementsExceeded {
+		diags.AddError(
+			"Value Element Limit Exceeded",
+			fmt.Sprintf("The %s value exceeds the maximum of %d collection elements and object attributes. ", description, l.maxElements())+
+				"This is typically caused by malformed or unexpectedly large data. "+
+				"If this value is expected, the provider developer can raise the limit.",
+		)
+	}
+
+	return diags
+}
+
+// valueLimitsStats is the result of scanning an encoded value.
+type valueLimitsStats struct {
+	depthExceeded    bool
+	elementsExceeded bool
+}
+
+// msgpackStats scans the MessagePack encoded value without recursion. The
+// scan stops at the first exceeded limit or malformed data.
+func (l ValueLimits) msgpackStats(b []byte) valueLimitsStats {
+	var stats valueLimitsStats
+
+	maxDepth := l.maxDepth()
+	maxElements := l.maxElements()
+
+	// remaining is the number of values left in each open array or map.
+	var remaining []uint64
+	var elements uint64
+	offset := 0
+
+	// read returns the next n bytes as an unsigned integer.
+	read := func(n int) (uint64, bool) {
+		if offset+n > len(b) {
+			return 0, false
+		}
+
+		var result uint64
+
+		switch n {
+		case 1:
+			result = uint64(b[offset])
+		case 2:
+			result = uint64(binary.BigEndian.Uint16(b[offset:]))
+		case 4:
+			result = uint64(binary.BigEndian.Uint32(b[offset:]))
+		}
+
+		offset += n
+
+		return result, true
+	}
+
+	for offset < len(b) {
+		c := b[offset]
+		offset++
+
+		var container bool
+		var count, items, skip uint64
+
+		ok := true
+
+		switch {
+		case c <= 0x7f || c >= 0xe0, c == 0xc0, c == 0xc2, c == 0xc3:
+			// fixint, nil, and bool values have no payload.
+		case c&0xf0 == 0x80:
+			container = true
+			count = uint64(c & 0x0f)
+			items = count * 2
+		case c&0xf0 == 0x90:
+			container = true
+			count = uint64(c & 0x0f)
+			items = count
+		case c&0xe0 == 0xa0:
+			skip = uint64(c & 0x1f)
+		case c == 0xc4 || c == 0xd9:
+			skip, ok = read(1)
+		case c == 0xc5 || c == 0xda:
+			skip, ok = read(2)
+		case c == 0xc6 || c == 0xdb:
+			skip, ok = read(4)
+		case c == 0xc7:
+			skip, ok = read(1)
+			skip++
+		case c == 0xc8:
+			skip, ok = read(2)
+			skip++
+		case c == 0xc9:
+			skip, ok = read(4)
+			skip++
+		case c == 0xcc || c == 0xd0:
+			skip = 1
+		case c == 0xcd || c == 0xd1:
+			skip = 2
+		case c == 0xca || c == 0xce || c == 0xd2:
+			skip = 4
+		case c == 0xcb || c == 0xcf || c == 0xd3:
+			skip = 8
+		case c >= 0xd4 && c <= 0xd8:
+			// fixext values have a type byte and 1, 2, 4, 8, or 16 bytes.
+			skip = 1 + (1 << (c - 0xd4))
+		case c == 0xdc:
+			container = true
+			count, ok = read(2)
+			items = count
+		case c == 0xdd:
+			container = true
+			count, ok = read(4)
+			items = count
+		case c == 0xde:
+			container = true
+			count, ok = read(2)
+			items = count * 2
+		case c == 0xdf:
+			container = true
+			count, ok = read(4)
+			items = count * 2
+		default:
+			ok = false
+		}
+
+		if !ok || skip > uint64(len(b)-offset) {
+			return stats
+		}
+
+		offset += int(skip)
+
+		if container {
+			if maxDepth > 0 && len(remaining)+1 > maxDepth {
+				stats.depthExceeded = true
+
+				return stats
+			}
+
+			elements += count
+
+			if maxElements > 0 && elements > uint64(maxElements) {
+				stats.elementsExceeded = true
+
+				return stats
+			}
+
+			if items > 0 {
+				remaining = append(remaining, items)
+
+				continue
+			}
+		}
+
+		// The value is complete, so close any arrays and maps which were
+		// waiting on it as their last remaining value.
+		for len(remaining) > 0 {
+			remaining[len(remaining)-1]--
+
+			if remaining[len(remaining)-1] > 0 {
+				break
+			}
+
+			remaining = remaining[:len(remaining)-1]
+		}
+
+		if len(remaining) == 0 {
+			return stats
+		}
+	}
+
+	return stats
+}
+
+// jsonStats scans the JSON encoded value without recursion. The scan stops at
+// the first exceeded limit or malformed data.
+func (l ValueLimits) jsonStats(b []byte) valueLimitsStats {
+	var stats valueLimitsStats
+
+	maxDepth := l.maxDepth()
+	maxElements := l.maxElements()
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	// objects tracks whether each open value is an object and, if so,
+	// whether the next token is an attribute name.
+	var objects []bool
+	var expectKey []bool
+	elements := 0
+
+	for {
+		token, err := decoder.Token()
+
+		if err != nil {
+			return stats
+		}
+
+		delim, isDelim := token.(json.Delim)
+
+		if isDelim && (delim == '}' || delim == ']') {
+			objects = objects[:len(objects)-1]
+			expectKey = expectKey[:len(expectKey)-1]
+
+			if len(objects) == 0 {
+				return stats
+			}
+
+			continue
+		}
+
+		if len(objects) > 0 {
+			parent := len(objects) - 1
+
+			if objects[parent] {
+				if expectKey[parent] {
+					// Attribute names count as elements and are always
+					// followed by the attribute value.
+					expectKey[parent] = false
+					elements++
+
+					if maxElements > 0 && elements > maxElements {
+						stats.elementsExceeded = true
+
+						return stats
+					}
+
+					continue
+				}
+
+				expectKey[parent] = true
+			} else {
+				elements++
+
+				if maxElements > 0 && elements > maxElements {
+					stats.elementsExceeded = true
+
+					return stats
+				}
+			}
+		}
+
+		if !isDelim {
+			if len(objects) == 0 {
+				return stats
+			}
+
+			continue
+		}
+
+		if maxDepth > 0 && len(objects)+1 > maxDepth {
+			stats.depthExceeded = true
+
+			return stats
+		}
+
+		objects = append(objects, delim == '{')
+		expectKey = append(expectKey, true)
+	}
+}
@@ -0,0 +1,157 @@
+package fwserver
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestValueLimitsCheckDynamicValue(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list": tftypes.List{ElementType: tftypes.String},
+			"test_map":  tftypes.Map{ElementType: tftypes.Number},
+		},
+	}
+
+	testValue, err := tfprotov6.NewDynamicValue(testType, tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "one"),
+			tftypes.NewValue(tftypes.String, "two"),
+		}),
+		"test_map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, map[string]tftypes.Value{
+			"key": tftypes.NewValue(tftypes.Number, 1.5),
+		}),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error creating DynamicValue: %s", err)
+	}
+
+	// 200 nested single element MessagePack arrays around a nil value.
+	testDeepMsgPack := append(bytes.Repeat([]byte{0x91}, 200), 0xc0)
+
+	testDeepJSON := []byte(strings.Repeat("[", 200) + strings.Repeat("]", 200))
+
+	testDepthDiags := func(depth string) diag.Diagnostics {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Value Nesting Depth Limit Exceeded",
+				"The prior state value exceeds the maximum nesting depth of "+depth+" collection and object values. "+
+					"This is typically caused by malformed or unexpectedly deep data. "+
+					"If this value is expected, the provider developer can raise the limit.",
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		limits   ValueLimits
+		msgpack  []byte
+		json     []byte
+		expected diag.Diagnostics
+	}{
+		"empty": {
+			limits: ValueLimits{},
+		},
+		"msgpack-within-limits": {
+			limits: ValueLimits{
+				MaxDepth:    2,
+				MaxElements: 5,
+			},
+			msgpack: testValue.MsgPack,
+		},
+		"msgpack-depth-zero": {
+			limits:  ValueLimits{},
+			msgpack: testDeepMsgPack,
+		},
+		"msgpack-depth-disabled": {
+			limits: ValueLimits{
+				MaxDepth: -1,
+			},
+			msgpack: testDeepMsgPack,
+		},
+		"msgpack-depth-exceeded": {
+			limits: ValueLimits{
+				MaxDepth: 1,
+			},
+			msgpack:  testValue.MsgPack,
+			expected: testDepthDiags("1"),
+		},
+		"msgpack-elements-exceeded": {
+			limits: ValueLimits{
+				MaxElements: 4,
+			},
+			msgpack: testValue.MsgPack,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Element Limit Exceeded",
+					"The prior state value exceeds the maximum of 4 collection elements and object attributes. "+
+						"This is typically caused by malformed or unexpectedly large data. "+
+						"If this value is expected, the provider developer can raise the limit.",
+				),
+			},
+		},
+		"msgpack-malformed": {
+			limits:  ValueLimits{},
+			msgpack: []byte{0xdc, 0xff},
+		},
+		"json-within-limits": {
+			limits: ValueLimits{
+				MaxDepth:    2,
+				MaxElements: 5,
+			},
+			json: []byte(`{"test_list":["one","two"],"test_map":{"key":1.5}}`),
+		},
+		"json-depth-zero": {
+			limits: ValueLimits{},
+			json:   testDeepJSON,
+		},
+		"json-depth-exceeded": {
+			limits: ValueLimits{
+				MaxDepth: 128,
+			},
+			json:     testDeepJSON,
+			expected: testDepthDiags("128"),
+		},
+		"json-elements-exceeded": {
+			limits: ValueLimits{
+				MaxElements: 4,
+			},
+			json: []byte(`{"test_list":["one","two"],"test_map":{"key":1.5}}`),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Element Limit Exceeded",
+					"The prior state value exceeds the maximum of 4 collection elements and object attributes. "+
+						"This is typically caused by malformed or unexpectedly large data. "+
+						"If this value is expected, the provider developer can raise the limit.",
+				),
+			},
+		},
+		"json-malformed": {
+			limits: ValueLimits{},
+			json:   []byte(`{"test_list":[`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.limits.CheckDynamicValue("prior state", testCase.msgpack, testCase.json)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -12,6 +12,10 @@ const (
 	// as parent.0.child in this project.
 	KeyAttributePath = "tf_attribute_path"
 
+	// Multiple attribute paths, in the same representation as
+	// KeyAttributePath.
+	KeyAttributePaths = "tf_attribute_paths"
+
 	// The type of data source being operated on, such as "archive_file"
 	KeyDataSourceType = "tf_data_source_type"
 
@@ -0,0 +1,21 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// MaskLogStrings returns a context which replaces the given strings in the
+// messages and field values of framework subsystem and root provider logs.
+func MaskLogStrings(ctx context.Context, strs ...string) context.Context {
+	if len(strs) == 0 {
+		return ctx
+	}
+
+	ctx = tfsdklog.SubsystemMaskLogStrings(ctx, SubsystemFramework, strs...)
+	ctx = tflog.MaskLogStrings(ctx, strs...)
+
+	return ctx
+}
@@ -0,0 +1,161 @@
+package privatestate
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// encryptedValuePrefix is the marker prefix of the JSON string which
+// replaces a provider private state value encrypted by a Codec. Values
+// without the marker are treated as unencrypted, so a Codec can be
+// introduced for resources which already have private state data.
+const encryptedValuePrefix = "tf-framework-encrypted:v1:"
+
+// Codec encrypts and decrypts provider private state values. The methods
+// match the resource.PrivateStateCodec interface.
+type Codec interface {
+	Encrypt(ctx context.Context, key string, value []byte) ([]byte, error)
+	Decrypt(ctx context.Context, key string, value []byte) ([]byte, error)
+}
+
+// Encrypt replaces each provider private state value with its encrypted
+// form, as returned by the codec, so it is protected when returned to
+// Terraform. Values which are already encrypted are skipped. Framework
+// private state data is never encrypted.
+func (d *Data) Encrypt(ctx context.Context, codec Codec) diag.Diagnostics {
+	if d == nil || codec == nil {
+		return nil
+	}
+
+	return d.Provider.Encrypt(ctx, codec)
+}
+
+// Decrypt replaces each encrypted provider private state value with its
+// decrypted form, as returned by the codec, so it is available to the
+// provider via GetKey. Values which are not encrypted are kept as is.
+func (d *Data) Decrypt(ctx context.Context, codec Codec) diag.Diagnostics {
+	if d == nil || codec == nil {
+		return nil
+	}
+
+	return d.Provider.Decrypt(ctx, codec)
+}
+
+// Encrypt replaces each value with its encrypted form, as returned by the
+// codec. Values which are already encrypted are skipped.
+func (d *ProviderData) Encrypt(ctx context.Context, codec Codec) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d == nil || codec == nil {
+		return diags
+	}
+
+	for key, value := range d.data {
+		if _, ok := encryptedValue(value); ok {
+			continue
+		}
+
+		encrypted, err := codec.Encrypt(ctx, key, value)
+
+		if err != nil {
+			logging.FrameworkError(ctx, "Error encrypting private state value", map[string]interface{}{"key": key, logging.KeyError: err.Error()})
+
+			diags.AddError(
+				"Error Encrypting Private State",
+				fmt.Sprintf("An error was encountered when encrypting the private state value with key %q: %s", key, err),
+			)
+
+			continue
+		}
+
+		encoded, err := json.Marshal(encryptedValuePrefix + base64.StdEncoding.EncodeToString(encrypted))
+
+		if err != nil {
+			diags.AddError(
+				"Error Encrypting Private State",
+				fmt.Sprintf("An error was encountered when encoding the encrypted private state value with key %q: %s.\n\n", key, err)+
+					"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer.",
+			)
+
+			continue
+		}
+
+		d.data[key] = encoded
+	}
+
+	return diags
+}
+
+// Decrypt replaces each encrypted value with its decrypted form, as returned
+// by the codec. Values which are not encrypted are kept as is.
+func (d *ProviderData) Decrypt(ctx context.Context, codec Codec) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d == nil || codec == nil {
+		return diags
+	}
+
+	for key, value := range d.data {
+		encrypted, ok := encryptedValue(value)
+
+		if !ok {
+			continue
+		}
+
+		decrypted, err := codec.Decrypt(ctx, key, encrypted)
+
+		if err != nil {
+			logging.FrameworkError(ctx, "Error decrypting private state value", map[string]interface{}{"key": key, logging.KeyError: err.Error()})
+
+			diags.AddError(
+				"Error Decrypting Private State",
+				fmt.Sprintf("An error was encountered when decrypting the private state value with key %q: %s", key, err),
+			)
+
+			continue
+		}
+
+		if !utf8.Valid(decrypted) || !json.Valid(decrypted) {
+			diags.AddError(
+				"Error Decrypting Private State",
+				fmt.Sprintf("The decrypted private state value with key %q is not valid UTF-8 JSON. ", key)+
+					"Verify the private state codec Decrypt method returns the value originally given to the Encrypt method.",
+			)
+
+			continue
+		}
+
+		d.data[key] = decrypted
+	}
+
+	return diags
+}
+
+// encryptedValue returns the encrypted bytes and true if the private state
+// value was encrypted by a Codec.
+func encryptedValue(value []byte) ([]byte, bool) {
+	var encoded string
+
+	if err := json.Unmarshal(value, &encoded); err != nil {
+		return nil, false
+	}
+
+	if !strings.HasPrefix(encoded, encryptedValuePrefix) {
+		return nil, false
+	}
+
+	encrypted, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(encoded, encryptedValuePrefix))
+
+	if err != nil {
+		return nil, false
+	}
+
+	return encrypted, true
+}
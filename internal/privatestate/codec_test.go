@@ -0,0 +1,193 @@
+package privatestate
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type testCodec struct {
+	err error
+}
+
+func (c testCodec) Decrypt(_ context.Context, _ string, value []byte) ([]byte, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return []byte(strings.TrimPrefix(string(value), "test-encrypted:")), nil
+}
+
+func (c testCodec) Encrypt(_ context.Context, _ string, value []byte) ([]byte, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return append([]byte("test-encrypted:"), value...), nil
+}
+
+func testEncryptedValue(value string) []byte {
+	return []byte(`"` + encryptedValuePrefix + base64.StdEncoding.EncodeToString([]byte("test-encrypted:"+value)) + `"`)
+}
+
+func TestProviderDataEncrypt(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData  *ProviderData
+		codec         Codec
+		expected      *ProviderData
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			codec: testCodec{},
+		},
+		"nil-codec": {
+			providerData: &ProviderData{
+				data: map[string][]byte{"key": []byte(`{"k": "v"}`)},
+			},
+			expected: &ProviderData{
+				data: map[string][]byte{"key": []byte(`{"k": "v"}`)},
+			},
+		},
+		"unencrypted": {
+			providerData: &ProviderData{
+				data: map[string][]byte{"key": []byte(`{"k": "v"}`)},
+			},
+			codec: testCodec{},
+			expected: &ProviderData{
+				data: map[string][]byte{"key": testEncryptedValue(`{"k": "v"}`)},
+			},
+		},
+		"encrypted": {
+			providerData: &ProviderData{
+				data: map[string][]byte{"key": testEncryptedValue(`{"k": "v"}`)},
+			},
+			codec: testCodec{},
+			expected: &ProviderData{
+				data: map[string][]byte{"key": testEncryptedValue(`{"k": "v"}`)},
+			},
+		},
+		"error": {
+			providerData: &ProviderData{
+				data: map[string][]byte{"key": []byte(`{"k": "v"}`)},
+			},
+			codec: testCodec{err: fmt.Errorf("test error")},
+			expected: &ProviderData{
+				data: map[string][]byte{"key": []byte(`{"k": "v"}`)},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Encrypting Private State",
+					`An error was encountered when encrypting the private state value with key "key": test error`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.providerData.Encrypt(context.Background(), testCase.codec)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.providerData, testCase.expected, cmp.AllowUnexported(ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderDataDecrypt(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData  *ProviderData
+		codec         Codec
+		expected      *ProviderData
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			codec: testCodec{},
+		},
+		"encrypted": {
+			providerData: &ProviderData{
+				data: map[string][]byte{"key": testEncryptedValue(`{"k": "v"}`)},
+			},
+			codec: testCodec{},
+			expected: &ProviderData{
+				data: map[string][]byte{"key": []byte(`{"k": "v"}`)},
+			},
+		},
+		"unencrypted": {
+			providerData: &ProviderData{
+				data: map[string][]byte{"key": []byte(`"test-value"`)},
+			},
+			codec: testCodec{},
+			expected: &ProviderData{
+				data: map[string][]byte{"key": []byte(`"test-value"`)},
+			},
+		},
+		"error": {
+			providerData: &ProviderData{
+				data: map[string][]byte{"key": testEncryptedValue(`{"k": "v"}`)},
+			},
+			codec: testCodec{err: fmt.Errorf("test error")},
+			expected: &ProviderData{
+				data: map[string][]byte{"key": testEncryptedValue(`{"k": "v"}`)},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Decrypting Private State",
+					`An error was encountered when decrypting the private state value with key "key": test error`,
+				),
+			},
+		},
+		"invalid-json": {
+			providerData: &ProviderData{
+				data: map[string][]byte{"key": testEncryptedValue(`not-json`)},
+			},
+			codec: testCodec{},
+			expected: &ProviderData{
+				data: map[string][]byte{"key": testEncryptedValue(`not-json`)},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Decrypting Private State",
+					`The decrypted private state value with key "key" is not valid UTF-8 JSON. `+
+						"Verify the private state codec Decrypt method returns the value originally given to the Encrypt method.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.providerData.Decrypt(context.Background(), testCase.codec)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.providerData, testCase.expected, cmp.AllowUnexported(ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
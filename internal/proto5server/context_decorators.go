@@ -0,0 +1,135 @@
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+var _ tfprotov5.ProviderServer = &contextDecoratorServer{}
+
+// NewContextDecoratorServer returns a tfprotov5.ProviderServer which wraps
+// the given server to apply the decorators to the context of every RPC
+// before it is handled by the given server.
+func NewContextDecoratorServer(server tfprotov5.ProviderServer, decorators fwserver.ContextDecorators) tfprotov5.ProviderServer {
+	return &contextDecoratorServer{
+		decorators: decorators,
+		server:     server,
+	}
+}
+
+// contextDecoratorServer implements the context decorator handling of
+// NewContextDecoratorServer.
+type contextDecoratorServer struct {
+	decorators fwserver.ContextDecorators
+	server     tfprotov5.ProviderServer
+}
+
+// GetProviderSchema satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{Name: "GetProviderSchema"})
+
+	return s.server.GetProviderSchema(ctx, req)
+}
+
+// PrepareProviderConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{Name: "PrepareProviderConfig"})
+
+	return s.server.PrepareProviderConfig(ctx, req)
+}
+
+// ConfigureProvider satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{Name: "ConfigureProvider"})
+
+	return s.server.ConfigureProvider(ctx, req)
+}
+
+// ValidateResourceTypeConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) ValidateResourceTypeConfig(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ValidateResourceTypeConfig",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ValidateResourceTypeConfig(ctx, req)
+}
+
+// UpgradeResourceState satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) UpgradeResourceState(ctx context.Context, req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "UpgradeResourceState",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.UpgradeResourceState(ctx, req)
+}
+
+// ReadResource satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ReadResource",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ReadResource(ctx, req)
+}
+
+// PlanResourceChange satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "PlanResourceChange",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.PlanResourceChange(ctx, req)
+}
+
+// ApplyResourceChange satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ApplyResourceChange",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ApplyResourceChange(ctx, req)
+}
+
+// ImportResourceState satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ImportResourceState",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ImportResourceState(ctx, req)
+}
+
+// ValidateDataSourceConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) ValidateDataSourceConfig(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ValidateDataSourceConfig",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ValidateDataSourceConfig(ctx, req)
+}
+
+// ReadDataSource satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ReadDataSource",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ReadDataSource(ctx, req)
+}
+
+// StopProvider satisfies the tfprotov5.ProviderServer interface.
+func (s *contextDecoratorServer) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{Name: "StopProvider"})
+
+	return s.server.StopProvider(ctx, req)
+}
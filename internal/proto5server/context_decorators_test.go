@@ -0,0 +1,93 @@
+package proto5server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+type testContextKey string
+
+func TestContextDecoratorServerReadResource(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testCurrentStateValue := testNewDynamicValue(t, testType, map[string]tftypes.Value{
+		"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+	})
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	var got []string
+
+	server := NewContextDecoratorServer(&Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = testSchema
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+								ReadMethod: func(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+									got, _ = ctx.Value(testContextKey("test")).([]string)
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}, fwserver.ContextDecorators{
+		func(ctx context.Context, info fwserver.RPCInfo) context.Context {
+			return context.WithValue(ctx, testContextKey("test"), []string{info.Name, info.TypeName})
+		},
+		func(ctx context.Context, _ fwserver.RPCInfo) context.Context {
+			existing, _ := ctx.Value(testContextKey("test")).([]string)
+
+			return context.WithValue(ctx, testContextKey("test"), append(existing, "second"))
+		},
+	})
+
+	resp, err := server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{
+		CurrentState: testCurrentStateValue,
+		TypeName:     "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+
+	expected := []string{"ReadResource", "test_resource", "second"}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,219 @@
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ tfprotov5.ProviderServer = &formatDiagnosticsServer{}
+
+// NewFormatDiagnosticsServer returns a tfprotov5.ProviderServer which wraps
+// the given server to format the response diagnostics of each RPC with the
+// framework server FormatDiagnostics method. It should wrap all other
+// servers, so diagnostics returned by other wrapping servers are also
+// formatted.
+func NewFormatDiagnosticsServer(server tfprotov5.ProviderServer, frameworkServer *fwserver.Server) tfprotov5.ProviderServer {
+	return &formatDiagnosticsServer{
+		frameworkServer: frameworkServer,
+		server:          server,
+	}
+}
+
+// formatDiagnosticsServer implements the diagnostic formatting of
+// NewFormatDiagnosticsServer.
+type formatDiagnosticsServer struct {
+	frameworkServer *fwserver.Server
+	server          tfprotov5.ProviderServer
+}
+
+// format updates the summary and detail of each diagnostic in place. The
+// severity and attribute path are preserved as-is.
+func (s *formatDiagnosticsServer) format(ctx context.Context, diagnostics []*tfprotov5.Diagnostic) {
+	if len(diagnostics) == 0 {
+		return
+	}
+
+	ctx = logging.InitContext(ctx)
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic == nil {
+			continue
+		}
+
+		formatted := s.frameworkServer.FormatDiagnostics(ctx, diag.Diagnostics{formatDiagnosticsDiagnostic(diagnostic)})
+
+		if len(formatted) == 0 {
+			continue
+		}
+
+		diagnostic.Summary = formatted[0].Summary()
+		diagnostic.Detail = formatted[0].Detail()
+	}
+}
+
+// formatDiagnosticsDiagnostic returns the framework diagnostic equivalent of
+// the protocol diagnostic. The attribute path is only included if every step
+// can be converted without the schema, which excludes set element values.
+func formatDiagnosticsDiagnostic(in *tfprotov5.Diagnostic) diag.Diagnostic {
+	var result diag.Diagnostic
+
+	switch in.Severity {
+	case tfprotov5.DiagnosticSeverityWarning:
+		result = diag.NewWarningDiagnostic(in.Summary, in.Detail)
+	default:
+		result = diag.NewErrorDiagnostic(in.Summary, in.Detail)
+	}
+
+	if in.Attribute == nil {
+		return result
+	}
+
+	var attributePath path.Path
+
+	for _, step := range in.Attribute.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			attributePath = attributePath.AtName(string(step))
+		case tftypes.ElementKeyInt:
+			attributePath = attributePath.AtListIndex(int(step))
+		case tftypes.ElementKeyString:
+			attributePath = attributePath.AtMapKey(string(step))
+		default:
+			return result
+		}
+	}
+
+	return diag.WithPath(attributePath, result)
+}
+
+// GetProviderSchema satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.server.GetProviderSchema(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// PrepareProviderConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	resp, err := s.server.PrepareProviderConfig(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ConfigureProvider satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	resp, err := s.server.ConfigureProvider(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// StopProvider satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	return s.server.StopProvider(ctx, req)
+}
+
+// ValidateResourceTypeConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) ValidateResourceTypeConfig(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	resp, err := s.server.ValidateResourceTypeConfig(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// UpgradeResourceState satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) UpgradeResourceState(ctx context.Context, req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	resp, err := s.server.UpgradeResourceState(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ReadResource satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	resp, err := s.server.ReadResource(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// PlanResourceChange satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.server.PlanResourceChange(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ApplyResourceChange satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	resp, err := s.server.ApplyResourceChange(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ImportResourceState satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	resp, err := s.server.ImportResourceState(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ValidateDataSourceConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) ValidateDataSourceConfig(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	resp, err := s.server.ValidateDataSourceConfig(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ReadDataSource satisfies the tfprotov5.ProviderServer interface.
+func (s *formatDiagnosticsServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	resp, err := s.server.ReadDataSource(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,142 @@
+package proto5server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFormatDiagnosticsServerReadResource(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testCurrentStateValue := testNewDynamicValue(t, testType, map[string]tftypes.Value{
+		"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+		}),
+	})
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_list": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		limits           fwserver.ValueLimits
+		expectedResponse *tfprotov5.ReadResourceResponse
+	}{
+		"resource-diagnostic": {
+			limits: fwserver.ValueLimits{},
+			expectedResponse: &tfprotov5.ReadResourceResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity:  tfprotov5.DiagnosticSeverityWarning,
+						Summary:   "formatted: warning summary",
+						Detail:    "warning detail [test_list[0]]",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test_list").WithElementKeyInt(0),
+					},
+				},
+				NewState: testCurrentStateValue,
+			},
+		},
+		"wrapping-server-diagnostic": {
+			limits: fwserver.ValueLimits{
+				MaxDepth: 1,
+			},
+			expectedResponse: &tfprotov5.ReadResourceResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "formatted: Value Nesting Depth Limit Exceeded",
+						Detail: "The current state value exceeds the maximum nesting depth of 1 collection and object values. " +
+							"This is typically caused by malformed or unexpectedly deep data. " +
+							"If this value is expected, the provider developer can raise the limit. []",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			frameworkServer := &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithFormatDiagnostic{
+						Provider: &testprovider.Provider{
+							ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+								return []func() resource.Resource{
+									func() resource.Resource {
+										return &testprovider.Resource{
+											SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+												resp.Schema = testSchema
+											},
+											MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+												resp.TypeName = "test_resource"
+											},
+											ReadMethod: func(_ context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+												resp.Diagnostics.AddAttributeWarning(path.Root("test_list").AtListIndex(0), "warning summary", "warning detail")
+											},
+										}
+									},
+								}
+							},
+						},
+						FormatDiagnosticMethod: func(_ context.Context, req provider.FormatDiagnosticRequest, resp *provider.FormatDiagnosticResponse) {
+							var diagPath path.Path
+
+							if diagWithPath, ok := req.Diagnostic.(diag.DiagnosticWithPath); ok {
+								diagPath = diagWithPath.Path()
+							}
+
+							resp.Summary = "formatted: " + resp.Summary
+							resp.Detail = resp.Detail + " [" + diagPath.String() + "]"
+						},
+					},
+				},
+			}
+
+			server := NewFormatDiagnosticsServer(
+				NewValueLimitsServer(frameworkServer, testCase.limits),
+				&frameworkServer.FrameworkServer,
+			)
+
+			got, err := server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{
+				CurrentState: testCurrentStateValue,
+				TypeName:     "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+var _ tfprotov5.ProviderServer = &rpcBudgetServer{}
+
+// NewRPCBudgetServer returns a tfprotov5.ProviderServer which wraps the given
+// server to measure the wall time and allocations of each RPC and append
+// warning diagnostics, via the framework RPCBudget type Finish method, for
+// any RPC which exceeds the budget.
+func NewRPCBudgetServer(server tfprotov5.ProviderServer, budget fwserver.RPCBudget) tfprotov5.ProviderServer {
+	return &rpcBudgetServer{
+		budget: budget,
+		server: server,
+	}
+}
+
+// rpcBudgetServer implements the RPC budget handling of NewRPCBudgetServer.
+type rpcBudgetServer struct {
+	budget fwserver.RPCBudget
+	server tfprotov5.ProviderServer
+}
+
+// finish returns the diagnostics of the RPC response after appending any
+// RPC budget diagnostics.
+func (s *rpcBudgetServer) finish(ctx context.Context, measurement fwserver.RPCBudgetMeasurement, diagnostics []*tfprotov5.Diagnostic) []*tfprotov5.Diagnostic {
+	ctx = logging.InitContext(ctx)
+
+	return append(diagnostics, toproto5.Diagnostics(ctx, s.budget.Finish(ctx, measurement))...)
+}
+
+// GetProviderSchema satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	measurement := s.budget.Start("GetProviderSchema", "")
+
+	resp, err := s.server.GetProviderSchema(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// PrepareProviderConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	measurement := s.budget.Start("PrepareProviderConfig", "")
+
+	resp, err := s.server.PrepareProviderConfig(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ConfigureProvider satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	measurement := s.budget.Start("ConfigureProvider", "")
+
+	resp, err := s.server.ConfigureProvider(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ValidateResourceTypeConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) ValidateResourceTypeConfig(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	measurement := s.budget.Start("ValidateResourceTypeConfig", req.TypeName)
+
+	resp, err := s.server.ValidateResourceTypeConfig(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// UpgradeResourceState satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) UpgradeResourceState(ctx context.Context, req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	measurement := s.budget.Start("UpgradeResourceState", req.TypeName)
+
+	resp, err := s.server.UpgradeResourceState(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ReadResource satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	measurement := s.budget.Start("ReadResource", req.TypeName)
+
+	resp, err := s.server.ReadResource(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// PlanResourceChange satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	measurement := s.budget.Start("PlanResourceChange", req.TypeName)
+
+	resp, err := s.server.PlanResourceChange(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ApplyResourceChange satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	measurement := s.budget.Start("ApplyResourceChange", req.TypeName)
+
+	resp, err := s.server.ApplyResourceChange(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ImportResourceState satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	measurement := s.budget.Start("ImportResourceState", req.TypeName)
+
+	resp, err := s.server.ImportResourceState(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ValidateDataSourceConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) ValidateDataSourceConfig(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	measurement := s.budget.Start("ValidateDataSourceConfig", req.TypeName)
+
+	resp, err := s.server.ValidateDataSourceConfig(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ReadDataSource satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	measurement := s.budget.Start("ReadDataSource", req.TypeName)
+
+	resp, err := s.server.ReadDataSource(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// StopProvider satisfies the tfprotov5.ProviderServer interface.
+func (s *rpcBudgetServer) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	return s.server.StopProvider(ctx, req)
+}
@@ -17,6 +17,12 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.A
 
 	fwResp := &fwserver.ApplyResourceChangeResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ApplyResourceChange", proto5Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
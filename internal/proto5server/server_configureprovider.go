@@ -17,6 +17,12 @@ func (s *Server) ConfigureProvider(ctx context.Context, proto5Req *tfprotov5.Con
 
 	fwResp := &provider.ConfigureResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ConfigureProvider", "")
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -18,6 +18,12 @@ func (s *Server) GetProviderSchema(ctx context.Context, proto5Req *tfprotov5.Get
 	fwReq := fromproto5.GetProviderSchemaRequest(ctx, proto5Req)
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "GetProviderSchema", "")
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
 
 	return toproto5.GetProviderSchemaResponse(ctx, fwResp), nil
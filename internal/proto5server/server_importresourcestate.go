@@ -17,6 +17,12 @@ func (s *Server) ImportResourceState(ctx context.Context, proto5Req *tfprotov5.I
 
 	fwResp := &fwserver.ImportResourceStateResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ImportResourceState", proto5Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -17,6 +17,12 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.Pl
 
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "PlanResourceChange", proto5Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
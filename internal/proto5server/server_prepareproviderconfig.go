@@ -17,6 +17,12 @@ func (s *Server) PrepareProviderConfig(ctx context.Context, proto5Req *tfprotov5
 
 	fwResp := &fwserver.ValidateProviderConfigResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "PrepareProviderConfig", "")
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
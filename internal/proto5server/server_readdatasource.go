@@ -17,6 +17,12 @@ func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDa
 
 	fwResp := &fwserver.ReadDataSourceResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ReadDataSource", proto5Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -18,6 +18,12 @@ func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadReso
 
 	fwResp := &fwserver.ReadResourceResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ReadResource", proto5Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -21,6 +21,12 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.
 		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
 	}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "UpgradeResourceState", proto5Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -17,6 +17,12 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, proto5Req *tfprot
 
 	fwResp := &fwserver.ValidateDataSourceConfigResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ValidateDataSourceConfig", proto5Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -17,6 +17,12 @@ func (s *Server) ValidateResourceTypeConfig(ctx context.Context, proto5Req *tfpr
 
 	fwResp := &fwserver.ValidateResourceConfigResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ValidateResourceTypeConfig", proto5Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -0,0 +1,217 @@
+package proto5server
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+var _ tfprotov5.ProviderServer = &supportBundleServer{}
+
+// NewSupportBundleServer returns a tfprotov5.ProviderServer which wraps the
+// given Server to recover panics and write a support bundle, via the
+// framework server WriteSupportBundle method, for any RPC which panics. The
+// framework server SupportBundleDir must be set.
+func NewSupportBundleServer(server *Server) tfprotov5.ProviderServer {
+	return &supportBundleServer{
+		server: server,
+	}
+}
+
+// supportBundleServer implements the support bundle handling of
+// NewSupportBundleServer.
+type supportBundleServer struct {
+	server *Server
+}
+
+// finish returns the diagnostics of the RPC response after appending the
+// support bundle diagnostics, if the RPC panicked. Error diagnostics are
+// expected provider behavior, such as API errors, so they do not write
+// support bundles.
+func (s *supportBundleServer) finish(ctx context.Context, rpc string, typeName string, panicValue any, diagnostics []*tfprotov5.Diagnostic) []*tfprotov5.Diagnostic {
+	if panicValue == nil {
+		return diagnostics
+	}
+
+	req := fwserver.SupportBundleRequest{
+		RPC:      rpc,
+		TypeName: typeName,
+		Panic:    panicValue,
+		Stack:    debug.Stack(),
+	}
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic == nil {
+			continue
+		}
+
+		bundleDiagnostic := fwserver.SupportBundleDiagnostic{
+			Severity: diagnostic.Severity.String(),
+			Summary:  diagnostic.Summary,
+		}
+
+		if diagnostic.Attribute != nil {
+			bundleDiagnostic.Attribute = diagnostic.Attribute.String()
+		}
+
+		req.Diagnostics = append(req.Diagnostics, bundleDiagnostic)
+	}
+
+	ctx = logging.InitContext(ctx)
+
+	logging.FrameworkError(ctx, "Recovered from provider panic", map[string]interface{}{"rpc": rpc})
+
+	return append(diagnostics, toproto5.Diagnostics(ctx, s.server.FrameworkServer.WriteSupportBundle(ctx, req))...)
+}
+
+// GetProviderSchema satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (resp *tfprotov5.GetProviderSchemaResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov5.GetProviderSchemaResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "GetProviderSchema", "", recover(), resp.Diagnostics)
+	}()
+
+	return s.server.GetProviderSchema(ctx, req)
+}
+
+// PrepareProviderConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (resp *tfprotov5.PrepareProviderConfigResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov5.PrepareProviderConfigResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "PrepareProviderConfig", "", recover(), resp.Diagnostics)
+	}()
+
+	return s.server.PrepareProviderConfig(ctx, req)
+}
+
+// ConfigureProvider satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (resp *tfprotov5.ConfigureProviderResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov5.ConfigureProviderResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ConfigureProvider", "", recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ConfigureProvider(ctx, req)
+}
+
+// ValidateResourceTypeConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) ValidateResourceTypeConfig(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (resp *tfprotov5.ValidateResourceTypeConfigResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov5.ValidateResourceTypeConfigResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ValidateResourceTypeConfig", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ValidateResourceTypeConfig(ctx, req)
+}
+
+// UpgradeResourceState satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) UpgradeResourceState(ctx context.Context, req *tfprotov5.UpgradeResourceStateRequest) (resp *tfprotov5.UpgradeResourceStateResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov5.UpgradeResourceStateResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "UpgradeResourceState", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.UpgradeResourceState(ctx, req)
+}
+
+// ReadResource satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (resp *tfprotov5.ReadResourceResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov5.ReadResourceResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ReadResource", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ReadResource(ctx, req)
+}
+
+// PlanResourceChange satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (resp *tfprotov5.PlanResourceChangeResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov5.PlanResourceChangeResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "PlanResourceChange", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.PlanResourceChange(ctx, req)
+}
+
+// ApplyResourceChange satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (resp *tfprotov5.ApplyResourceChangeResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov5.ApplyResourceChangeResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ApplyResourceChange", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ApplyResourceChange(ctx, req)
+}
+
+// ImportResourceState satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (resp *tfprotov5.ImportResourceStateResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov5.ImportResourceStateResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ImportResourceState", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ImportResourceState(ctx, req)
+}
+
+// ValidateDataSourceConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) ValidateDataSourceConfig(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (resp *tfprotov5.ValidateDataSourceConfigResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov5.ValidateDataSourceConfigResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ValidateDataSourceConfig", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ValidateDataSourceConfig(ctx, req)
+}
+
+// ReadDataSource satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (resp *tfprotov5.ReadDataSourceResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov5.ReadDataSourceResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ReadDataSource", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ReadDataSource(ctx, req)
+}
+
+// StopProvider satisfies the tfprotov5.ProviderServer interface.
+func (s *supportBundleServer) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	return s.server.StopProvider(ctx, req)
+}
@@ -0,0 +1,224 @@
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+var _ tfprotov5.ProviderServer = &valueLimitsServer{}
+
+// NewValueLimitsServer returns a tfprotov5.ProviderServer which wraps the given
+// server to check the configuration, plan, and state values of each RPC
+// against the framework ValueLimits before they are decoded. RPCs with values
+// which exceed the limits return error diagnostics without calling the
+// wrapped server.
+func NewValueLimitsServer(server tfprotov5.ProviderServer, limits fwserver.ValueLimits) tfprotov5.ProviderServer {
+	return &valueLimitsServer{
+		limits: limits,
+		server: server,
+	}
+}
+
+// valueLimitsServer implements the value limits handling of
+// NewValueLimitsServer.
+type valueLimitsServer struct {
+	limits fwserver.ValueLimits
+	server tfprotov5.ProviderServer
+}
+
+// valueLimitsValue is a request value and its description for diagnostics.
+type valueLimitsValue struct {
+	description string
+	value       *tfprotov5.DynamicValue
+}
+
+// check returns error diagnostics for any of the values which exceed the
+// limits.
+func (s *valueLimitsServer) check(ctx context.Context, values ...valueLimitsValue) []*tfprotov5.Diagnostic {
+	var diags diag.Diagnostics
+
+	for _, value := range values {
+		if value.value == nil {
+			continue
+		}
+
+		diags.Append(s.limits.CheckDynamicValue(value.description, value.value.MsgPack, value.value.JSON)...)
+	}
+
+	if len(diags) == 0 {
+		return nil
+	}
+
+	return toproto5.Diagnostics(logging.InitContext(ctx), diags)
+}
+
+// GetProviderSchema satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return s.server.GetProviderSchema(ctx, req)
+}
+
+// PrepareProviderConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "provider configuration", value: req.Config},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov5.PrepareProviderConfigResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.PrepareProviderConfig(ctx, req)
+}
+
+// ConfigureProvider satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "provider configuration", value: req.Config},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov5.ConfigureProviderResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ConfigureProvider(ctx, req)
+}
+
+// ValidateResourceTypeConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) ValidateResourceTypeConfig(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "resource configuration", value: req.Config},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov5.ValidateResourceTypeConfigResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ValidateResourceTypeConfig(ctx, req)
+}
+
+// UpgradeResourceState satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) UpgradeResourceState(ctx context.Context, req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	if req.RawState != nil {
+		diags := s.limits.CheckDynamicValue("raw state", nil, req.RawState.JSON)
+
+		if len(diags) > 0 {
+			return &tfprotov5.UpgradeResourceStateResponse{
+				Diagnostics: toproto5.Diagnostics(logging.InitContext(ctx), diags),
+			}, nil
+		}
+	}
+
+	return s.server.UpgradeResourceState(ctx, req)
+}
+
+// ReadResource satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "current state", value: req.CurrentState},
+		valueLimitsValue{description: "provider meta", value: req.ProviderMeta},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov5.ReadResourceResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ReadResource(ctx, req)
+}
+
+// PlanResourceChange satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "resource configuration", value: req.Config},
+		valueLimitsValue{description: "prior state", value: req.PriorState},
+		valueLimitsValue{description: "proposed new state", value: req.ProposedNewState},
+		valueLimitsValue{description: "provider meta", value: req.ProviderMeta},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov5.PlanResourceChangeResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.PlanResourceChange(ctx, req)
+}
+
+// ApplyResourceChange satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "resource configuration", value: req.Config},
+		valueLimitsValue{description: "prior state", value: req.PriorState},
+		valueLimitsValue{description: "planned state", value: req.PlannedState},
+		valueLimitsValue{description: "provider meta", value: req.ProviderMeta},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov5.ApplyResourceChangeResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ApplyResourceChange(ctx, req)
+}
+
+// ImportResourceState satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	return s.server.ImportResourceState(ctx, req)
+}
+
+// ValidateDataSourceConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) ValidateDataSourceConfig(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "data source configuration", value: req.Config},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov5.ValidateDataSourceConfigResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ValidateDataSourceConfig(ctx, req)
+}
+
+// ReadDataSource satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "data source configuration", value: req.Config},
+		valueLimitsValue{description: "provider meta", value: req.ProviderMeta},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov5.ReadDataSourceResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ReadDataSource(ctx, req)
+}
+
+// StopProvider satisfies the tfprotov5.ProviderServer interface.
+func (s *valueLimitsServer) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	return s.server.StopProvider(ctx, req)
+}
@@ -0,0 +1,135 @@
+package proto6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+var _ tfprotov6.ProviderServer = &contextDecoratorServer{}
+
+// NewContextDecoratorServer returns a tfprotov6.ProviderServer which wraps
+// the given server to apply the decorators to the context of every RPC
+// before it is handled by the given server.
+func NewContextDecoratorServer(server tfprotov6.ProviderServer, decorators fwserver.ContextDecorators) tfprotov6.ProviderServer {
+	return &contextDecoratorServer{
+		decorators: decorators,
+		server:     server,
+	}
+}
+
+// contextDecoratorServer implements the context decorator handling of
+// NewContextDecoratorServer.
+type contextDecoratorServer struct {
+	decorators fwserver.ContextDecorators
+	server     tfprotov6.ProviderServer
+}
+
+// GetProviderSchema satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{Name: "GetProviderSchema"})
+
+	return s.server.GetProviderSchema(ctx, req)
+}
+
+// ValidateProviderConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{Name: "ValidateProviderConfig"})
+
+	return s.server.ValidateProviderConfig(ctx, req)
+}
+
+// ConfigureProvider satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{Name: "ConfigureProvider"})
+
+	return s.server.ConfigureProvider(ctx, req)
+}
+
+// ValidateResourceConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ValidateResourceConfig",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ValidateResourceConfig(ctx, req)
+}
+
+// UpgradeResourceState satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "UpgradeResourceState",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.UpgradeResourceState(ctx, req)
+}
+
+// ReadResource satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ReadResource",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ReadResource(ctx, req)
+}
+
+// PlanResourceChange satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "PlanResourceChange",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.PlanResourceChange(ctx, req)
+}
+
+// ApplyResourceChange satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ApplyResourceChange",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ApplyResourceChange(ctx, req)
+}
+
+// ImportResourceState satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ImportResourceState",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ImportResourceState(ctx, req)
+}
+
+// ValidateDataResourceConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ValidateDataResourceConfig",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ValidateDataResourceConfig(ctx, req)
+}
+
+// ReadDataSource satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{
+		Name:     "ReadDataSource",
+		TypeName: req.TypeName,
+	})
+
+	return s.server.ReadDataSource(ctx, req)
+}
+
+// StopProvider satisfies the tfprotov6.ProviderServer interface.
+func (s *contextDecoratorServer) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	ctx = s.decorators.Decorate(ctx, fwserver.RPCInfo{Name: "StopProvider"})
+
+	return s.server.StopProvider(ctx, req)
+}
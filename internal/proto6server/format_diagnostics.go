@@ -0,0 +1,219 @@
+package proto6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ tfprotov6.ProviderServer = &formatDiagnosticsServer{}
+
+// NewFormatDiagnosticsServer returns a tfprotov6.ProviderServer which wraps
+// the given server to format the response diagnostics of each RPC with the
+// framework server FormatDiagnostics method. It should wrap all other
+// servers, so diagnostics returned by other wrapping servers are also
+// formatted.
+func NewFormatDiagnosticsServer(server tfprotov6.ProviderServer, frameworkServer *fwserver.Server) tfprotov6.ProviderServer {
+	return &formatDiagnosticsServer{
+		frameworkServer: frameworkServer,
+		server:          server,
+	}
+}
+
+// formatDiagnosticsServer implements the diagnostic formatting of
+// NewFormatDiagnosticsServer.
+type formatDiagnosticsServer struct {
+	frameworkServer *fwserver.Server
+	server          tfprotov6.ProviderServer
+}
+
+// format updates the summary and detail of each diagnostic in place. The
+// severity and attribute path are preserved as-is.
+func (s *formatDiagnosticsServer) format(ctx context.Context, diagnostics []*tfprotov6.Diagnostic) {
+	if len(diagnostics) == 0 {
+		return
+	}
+
+	ctx = logging.InitContext(ctx)
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic == nil {
+			continue
+		}
+
+		formatted := s.frameworkServer.FormatDiagnostics(ctx, diag.Diagnostics{formatDiagnosticsDiagnostic(diagnostic)})
+
+		if len(formatted) == 0 {
+			continue
+		}
+
+		diagnostic.Summary = formatted[0].Summary()
+		diagnostic.Detail = formatted[0].Detail()
+	}
+}
+
+// formatDiagnosticsDiagnostic returns the framework diagnostic equivalent of
+// the protocol diagnostic. The attribute path is only included if every step
+// can be converted without the schema, which excludes set element values.
+func formatDiagnosticsDiagnostic(in *tfprotov6.Diagnostic) diag.Diagnostic {
+	var result diag.Diagnostic
+
+	switch in.Severity {
+	case tfprotov6.DiagnosticSeverityWarning:
+		result = diag.NewWarningDiagnostic(in.Summary, in.Detail)
+	default:
+		result = diag.NewErrorDiagnostic(in.Summary, in.Detail)
+	}
+
+	if in.Attribute == nil {
+		return result
+	}
+
+	var attributePath path.Path
+
+	for _, step := range in.Attribute.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			attributePath = attributePath.AtName(string(step))
+		case tftypes.ElementKeyInt:
+			attributePath = attributePath.AtListIndex(int(step))
+		case tftypes.ElementKeyString:
+			attributePath = attributePath.AtMapKey(string(step))
+		default:
+			return result
+		}
+	}
+
+	return diag.WithPath(attributePath, result)
+}
+
+// GetProviderSchema satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	resp, err := s.server.GetProviderSchema(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ValidateProviderConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	resp, err := s.server.ValidateProviderConfig(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ConfigureProvider satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	resp, err := s.server.ConfigureProvider(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// StopProvider satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	return s.server.StopProvider(ctx, req)
+}
+
+// ValidateResourceConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	resp, err := s.server.ValidateResourceConfig(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// UpgradeResourceState satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
+	resp, err := s.server.UpgradeResourceState(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ReadResource satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	resp, err := s.server.ReadResource(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// PlanResourceChange satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.server.PlanResourceChange(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ApplyResourceChange satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	resp, err := s.server.ApplyResourceChange(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ImportResourceState satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	resp, err := s.server.ImportResourceState(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ValidateDataResourceConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	resp, err := s.server.ValidateDataResourceConfig(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ReadDataSource satisfies the tfprotov6.ProviderServer interface.
+func (s *formatDiagnosticsServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	resp, err := s.server.ReadDataSource(ctx, req)
+
+	if resp != nil {
+		s.format(ctx, resp.Diagnostics)
+	}
+
+	return resp, err
+}
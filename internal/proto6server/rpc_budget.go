@@ -0,0 +1,185 @@
+package proto6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+var _ tfprotov6.ProviderServer = &rpcBudgetServer{}
+
+// NewRPCBudgetServer returns a tfprotov6.ProviderServer which wraps the given
+// server to measure the wall time and allocations of each RPC and append
+// warning diagnostics, via the framework RPCBudget type Finish method, for
+// any RPC which exceeds the budget.
+func NewRPCBudgetServer(server tfprotov6.ProviderServer, budget fwserver.RPCBudget) tfprotov6.ProviderServer {
+	return &rpcBudgetServer{
+		budget: budget,
+		server: server,
+	}
+}
+
+// rpcBudgetServer implements the RPC budget handling of NewRPCBudgetServer.
+type rpcBudgetServer struct {
+	budget fwserver.RPCBudget
+	server tfprotov6.ProviderServer
+}
+
+// finish returns the diagnostics of the RPC response after appending any
+// RPC budget diagnostics.
+func (s *rpcBudgetServer) finish(ctx context.Context, measurement fwserver.RPCBudgetMeasurement, diagnostics []*tfprotov6.Diagnostic) []*tfprotov6.Diagnostic {
+	ctx = logging.InitContext(ctx)
+
+	return append(diagnostics, toproto6.Diagnostics(ctx, s.budget.Finish(ctx, measurement))...)
+}
+
+// GetProviderSchema satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	measurement := s.budget.Start("GetProviderSchema", "")
+
+	resp, err := s.server.GetProviderSchema(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ValidateProviderConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	measurement := s.budget.Start("ValidateProviderConfig", "")
+
+	resp, err := s.server.ValidateProviderConfig(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ConfigureProvider satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	measurement := s.budget.Start("ConfigureProvider", "")
+
+	resp, err := s.server.ConfigureProvider(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ValidateResourceConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	measurement := s.budget.Start("ValidateResourceConfig", req.TypeName)
+
+	resp, err := s.server.ValidateResourceConfig(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// UpgradeResourceState satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
+	measurement := s.budget.Start("UpgradeResourceState", req.TypeName)
+
+	resp, err := s.server.UpgradeResourceState(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ReadResource satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	measurement := s.budget.Start("ReadResource", req.TypeName)
+
+	resp, err := s.server.ReadResource(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// PlanResourceChange satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	measurement := s.budget.Start("PlanResourceChange", req.TypeName)
+
+	resp, err := s.server.PlanResourceChange(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ApplyResourceChange satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	measurement := s.budget.Start("ApplyResourceChange", req.TypeName)
+
+	resp, err := s.server.ApplyResourceChange(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ImportResourceState satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	measurement := s.budget.Start("ImportResourceState", req.TypeName)
+
+	resp, err := s.server.ImportResourceState(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ValidateDataResourceConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	measurement := s.budget.Start("ValidateDataResourceConfig", req.TypeName)
+
+	resp, err := s.server.ValidateDataResourceConfig(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ReadDataSource satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	measurement := s.budget.Start("ReadDataSource", req.TypeName)
+
+	resp, err := s.server.ReadDataSource(ctx, req)
+
+	if resp != nil {
+		resp.Diagnostics = s.finish(ctx, measurement, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// StopProvider satisfies the tfprotov6.ProviderServer interface.
+func (s *rpcBudgetServer) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	return s.server.StopProvider(ctx, req)
+}
@@ -0,0 +1,133 @@
+package proto6server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestRPCBudgetServerReadResource(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testCurrentStateValue := testNewDynamicValue(t, testType, map[string]tftypes.Value{
+		"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+	})
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		budget            fwserver.RPCBudget
+		readMethod        func(context.Context, resource.ReadRequest, *resource.ReadResponse)
+		expectedSummaries []string
+	}{
+		"within-budget": {
+			budget: fwserver.RPCBudget{
+				MaxDuration: time.Hour,
+			},
+			readMethod: func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {},
+		},
+		"exceeded-duration": {
+			budget: fwserver.RPCBudget{
+				MaxDuration: time.Millisecond,
+			},
+			readMethod: func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+				time.Sleep(10 * time.Millisecond)
+			},
+			expectedSummaries: []string{"RPC Duration Budget Exceeded"},
+		},
+		"exceeded-duration-error": {
+			budget: fwserver.RPCBudget{
+				MaxDuration: time.Millisecond,
+			},
+			readMethod: func(_ context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+				time.Sleep(10 * time.Millisecond)
+
+				resp.Diagnostics.AddError("test summary", "test detail")
+			},
+			expectedSummaries: []string{"test summary", "RPC Duration Budget Exceeded"},
+		},
+		"exceeded-allocated-bytes": {
+			budget: fwserver.RPCBudget{
+				MaxProcessAllocatedBytes: 1,
+			},
+			readMethod:        func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {},
+			expectedSummaries: []string{"RPC Allocation Budget Exceeded"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := NewRPCBudgetServer(&Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+										ReadMethod: testCase.readMethod,
+									}
+								},
+							}
+						},
+					},
+				},
+			}, testCase.budget)
+
+			got, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+				CurrentState: testCurrentStateValue,
+				TypeName:     "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotSummaries []string
+
+			for _, diagnostic := range got.Diagnostics {
+				gotSummaries = append(gotSummaries, diagnostic.Summary)
+			}
+
+			if strings.Join(gotSummaries, ",") != strings.Join(testCase.expectedSummaries, ",") {
+				t.Fatalf("expected diagnostic summaries %q, got: %q", testCase.expectedSummaries, gotSummaries)
+			}
+
+			for _, diagnostic := range got.Diagnostics {
+				if strings.HasPrefix(diagnostic.Summary, "RPC ") && !strings.Contains(diagnostic.Detail, "ReadResource test_resource") {
+					t.Errorf("expected diagnostic detail to contain RPC and type name, got: %s", diagnostic.Detail)
+				}
+			}
+		})
+	}
+}
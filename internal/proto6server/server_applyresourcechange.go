@@ -17,6 +17,12 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto6Req *tfprotov6.A
 
 	fwResp := &fwserver.ApplyResourceChangeResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ApplyResourceChange", proto6Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
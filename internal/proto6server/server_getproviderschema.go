@@ -18,6 +18,12 @@ func (s *Server) GetProviderSchema(ctx context.Context, proto6Req *tfprotov6.Get
 	fwReq := fromproto6.GetProviderSchemaRequest(ctx, proto6Req)
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "GetProviderSchema", "")
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
 
 	return toproto6.GetProviderSchemaResponse(ctx, fwResp), nil
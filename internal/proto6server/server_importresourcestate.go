@@ -17,6 +17,12 @@ func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.I
 
 	fwResp := &fwserver.ImportResourceStateResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ImportResourceState", proto6Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
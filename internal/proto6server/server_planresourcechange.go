@@ -17,6 +17,12 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.Pl
 
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "PlanResourceChange", proto6Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -17,6 +17,12 @@ func (s *Server) ReadDataSource(ctx context.Context, proto6Req *tfprotov6.ReadDa
 
 	fwResp := &fwserver.ReadDataSourceResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ReadDataSource", proto6Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -17,6 +17,12 @@ func (s *Server) ReadResource(ctx context.Context, proto6Req *tfprotov6.ReadReso
 
 	fwResp := &fwserver.ReadResourceResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ReadResource", proto6Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
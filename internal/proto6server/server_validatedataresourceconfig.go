@@ -17,6 +17,12 @@ func (s *Server) ValidateDataResourceConfig(ctx context.Context, proto6Req *tfpr
 
 	fwResp := &fwserver.ValidateDataSourceConfigResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ValidateDataResourceConfig", proto6Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
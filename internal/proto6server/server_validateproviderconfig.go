@@ -17,6 +17,12 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, proto6Req *tfprotov
 
 	fwResp := &fwserver.ValidateProviderConfigResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ValidateProviderConfig", "")
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -17,6 +17,12 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, proto6Req *tfprotov
 
 	fwResp := &fwserver.ValidateResourceConfigResponse{}
 
+	ctx = s.FrameworkServer.EventRPCStart(ctx, "ValidateResourceConfig", proto6Req.TypeName)
+
+	defer func() {
+		s.FrameworkServer.EventRPCFinish(ctx, fwResp.Diagnostics)
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -0,0 +1,217 @@
+package proto6server
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+var _ tfprotov6.ProviderServer = &supportBundleServer{}
+
+// NewSupportBundleServer returns a tfprotov6.ProviderServer which wraps the
+// given Server to recover panics and write a support bundle, via the
+// framework server WriteSupportBundle method, for any RPC which panics. The
+// framework server SupportBundleDir must be set.
+func NewSupportBundleServer(server *Server) tfprotov6.ProviderServer {
+	return &supportBundleServer{
+		server: server,
+	}
+}
+
+// supportBundleServer implements the support bundle handling of
+// NewSupportBundleServer.
+type supportBundleServer struct {
+	server *Server
+}
+
+// finish returns the diagnostics of the RPC response after appending the
+// support bundle diagnostics, if the RPC panicked. Error diagnostics are
+// expected provider behavior, such as API errors, so they do not write
+// support bundles.
+func (s *supportBundleServer) finish(ctx context.Context, rpc string, typeName string, panicValue any, diagnostics []*tfprotov6.Diagnostic) []*tfprotov6.Diagnostic {
+	if panicValue == nil {
+		return diagnostics
+	}
+
+	req := fwserver.SupportBundleRequest{
+		RPC:      rpc,
+		TypeName: typeName,
+		Panic:    panicValue,
+		Stack:    debug.Stack(),
+	}
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic == nil {
+			continue
+		}
+
+		bundleDiagnostic := fwserver.SupportBundleDiagnostic{
+			Severity: diagnostic.Severity.String(),
+			Summary:  diagnostic.Summary,
+		}
+
+		if diagnostic.Attribute != nil {
+			bundleDiagnostic.Attribute = diagnostic.Attribute.String()
+		}
+
+		req.Diagnostics = append(req.Diagnostics, bundleDiagnostic)
+	}
+
+	ctx = logging.InitContext(ctx)
+
+	logging.FrameworkError(ctx, "Recovered from provider panic", map[string]interface{}{"rpc": rpc})
+
+	return append(diagnostics, toproto6.Diagnostics(ctx, s.server.FrameworkServer.WriteSupportBundle(ctx, req))...)
+}
+
+// GetProviderSchema satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (resp *tfprotov6.GetProviderSchemaResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov6.GetProviderSchemaResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "GetProviderSchema", "", recover(), resp.Diagnostics)
+	}()
+
+	return s.server.GetProviderSchema(ctx, req)
+}
+
+// ValidateProviderConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (resp *tfprotov6.ValidateProviderConfigResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov6.ValidateProviderConfigResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ValidateProviderConfig", "", recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ValidateProviderConfig(ctx, req)
+}
+
+// ConfigureProvider satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (resp *tfprotov6.ConfigureProviderResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov6.ConfigureProviderResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ConfigureProvider", "", recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ConfigureProvider(ctx, req)
+}
+
+// ValidateResourceConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (resp *tfprotov6.ValidateResourceConfigResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov6.ValidateResourceConfigResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ValidateResourceConfig", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ValidateResourceConfig(ctx, req)
+}
+
+// UpgradeResourceState satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (resp *tfprotov6.UpgradeResourceStateResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov6.UpgradeResourceStateResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "UpgradeResourceState", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.UpgradeResourceState(ctx, req)
+}
+
+// ReadResource satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (resp *tfprotov6.ReadResourceResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov6.ReadResourceResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ReadResource", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ReadResource(ctx, req)
+}
+
+// PlanResourceChange satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (resp *tfprotov6.PlanResourceChangeResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov6.PlanResourceChangeResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "PlanResourceChange", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.PlanResourceChange(ctx, req)
+}
+
+// ApplyResourceChange satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (resp *tfprotov6.ApplyResourceChangeResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov6.ApplyResourceChangeResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ApplyResourceChange", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ApplyResourceChange(ctx, req)
+}
+
+// ImportResourceState satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (resp *tfprotov6.ImportResourceStateResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov6.ImportResourceStateResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ImportResourceState", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ImportResourceState(ctx, req)
+}
+
+// ValidateDataResourceConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (resp *tfprotov6.ValidateDataResourceConfigResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov6.ValidateDataResourceConfigResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ValidateDataResourceConfig", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ValidateDataResourceConfig(ctx, req)
+}
+
+// ReadDataSource satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (resp *tfprotov6.ReadDataSourceResponse, err error) {
+	defer func() {
+		if resp == nil {
+			resp = &tfprotov6.ReadDataSourceResponse{}
+		}
+
+		resp.Diagnostics = s.finish(ctx, "ReadDataSource", req.TypeName, recover(), resp.Diagnostics)
+	}()
+
+	return s.server.ReadDataSource(ctx, req)
+}
+
+// StopProvider satisfies the tfprotov6.ProviderServer interface.
+func (s *supportBundleServer) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	return s.server.StopProvider(ctx, req)
+}
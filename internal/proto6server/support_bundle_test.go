@@ -0,0 +1,177 @@
+package proto6server
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestSupportBundleServerReadResource(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testCurrentStateValue := testNewDynamicValue(t, testType, map[string]tftypes.Value{
+		"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+	})
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		readMethod          func(context.Context, resource.ReadRequest, *resource.ReadResponse)
+		expectedSummaries   []string
+		expectedBundle      bool
+		expectedBundlePanic string
+	}{
+		"success": {
+			readMethod: func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {},
+		},
+		"error": {
+			readMethod: func(_ context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.AddError("test summary", "test detail with secret value")
+			},
+			expectedSummaries: []string{"test summary"},
+		},
+		"panic": {
+			readMethod: func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+				panic("test panic")
+			},
+			expectedSummaries:   []string{"Provider Panic"},
+			expectedBundle:      true,
+			expectedBundlePanic: "test panic",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			supportBundleDir := t.TempDir()
+
+			server := NewSupportBundleServer(&Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+										ReadMethod: testCase.readMethod,
+									}
+								},
+							}
+						},
+					},
+					SupportBundleDir: supportBundleDir,
+				},
+			})
+
+			got, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+				CurrentState: testCurrentStateValue,
+				TypeName:     "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotSummaries []string
+
+			for _, diagnostic := range got.Diagnostics {
+				gotSummaries = append(gotSummaries, diagnostic.Summary)
+			}
+
+			if strings.Join(gotSummaries, ",") != strings.Join(testCase.expectedSummaries, ",") {
+				t.Fatalf("expected diagnostic summaries %q, got: %q", testCase.expectedSummaries, gotSummaries)
+			}
+
+			bundlePaths, err := filepath.Glob(filepath.Join(supportBundleDir, "*.json"))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.expectedBundle {
+				if len(bundlePaths) > 0 {
+					t.Fatalf("unexpected support bundles: %v", bundlePaths)
+				}
+
+				return
+			}
+
+			if len(bundlePaths) != 1 {
+				t.Fatalf("expected 1 support bundle, got: %v", bundlePaths)
+			}
+
+			if detail := got.Diagnostics[len(got.Diagnostics)-1].Detail; !strings.Contains(detail, bundlePaths[0]) {
+				t.Errorf("expected diagnostic detail to contain support bundle path %s, got: %s", bundlePaths[0], detail)
+			}
+
+			content, err := os.ReadFile(bundlePaths[0])
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if strings.Contains(string(content), "secret value") || strings.Contains(string(content), "test-currentstate-value") {
+				t.Errorf("unexpected values in support bundle: %s", content)
+			}
+
+			var bundle struct {
+				Panic    string `json:"panic"`
+				RPC      string `json:"rpc"`
+				Schema   []map[string]interface{}
+				Stack    string `json:"stack"`
+				TypeName string `json:"type_name"`
+			}
+
+			if err := json.Unmarshal(content, &bundle); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if bundle.RPC != "ReadResource" || bundle.TypeName != "test_resource" {
+				t.Errorf("unexpected support bundle request information: %s", content)
+			}
+
+			if len(bundle.Schema) != 1 || bundle.Schema[0]["path"] != "test_required" {
+				t.Errorf("unexpected support bundle schema: %s", content)
+			}
+
+			if bundle.Panic != testCase.expectedBundlePanic {
+				t.Errorf("expected support bundle panic %q, got: %q", testCase.expectedBundlePanic, bundle.Panic)
+			}
+
+			if testCase.expectedBundlePanic != "" && bundle.Stack == "" {
+				t.Errorf("expected support bundle stack trace")
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package proto6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+var _ tfprotov6.ProviderServer = &valueLimitsServer{}
+
+// NewValueLimitsServer returns a tfprotov6.ProviderServer which wraps the given
+// server to check the configuration, plan, and state values of each RPC
+// against the framework ValueLimits before they are decoded. RPCs with values
+// which exceed the limits return error diagnostics without calling the
+// wrapped server.
+func NewValueLimitsServer(server tfprotov6.ProviderServer, limits fwserver.ValueLimits) tfprotov6.ProviderServer {
+	return &valueLimitsServer{
+		limits: limits,
+		server: server,
+	}
+}
+
+// valueLimitsServer implements the value limits handling of
+// NewValueLimitsServer.
+type valueLimitsServer struct {
+	limits fwserver.ValueLimits
+	server tfprotov6.ProviderServer
+}
+
+// valueLimitsValue is a request value and its description for diagnostics.
+type valueLimitsValue struct {
+	description string
+	value       *tfprotov6.DynamicValue
+}
+
+// check returns error diagnostics for any of the values which exceed the
+// limits.
+func (s *valueLimitsServer) check(ctx context.Context, values ...valueLimitsValue) []*tfprotov6.Diagnostic {
+	var diags diag.Diagnostics
+
+	for _, value := range values {
+		if value.value == nil {
+			continue
+		}
+
+		diags.Append(s.limits.CheckDynamicValue(value.description, value.value.MsgPack, value.value.JSON)...)
+	}
+
+	if len(diags) == 0 {
+		return nil
+	}
+
+	return toproto6.Diagnostics(logging.InitContext(ctx), diags)
+}
+
+// GetProviderSchema satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	return s.server.GetProviderSchema(ctx, req)
+}
+
+// ValidateProviderConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "provider configuration", value: req.Config},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov6.ValidateProviderConfigResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ValidateProviderConfig(ctx, req)
+}
+
+// ConfigureProvider satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "provider configuration", value: req.Config},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov6.ConfigureProviderResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ConfigureProvider(ctx, req)
+}
+
+// ValidateResourceConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "resource configuration", value: req.Config},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov6.ValidateResourceConfigResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ValidateResourceConfig(ctx, req)
+}
+
+// UpgradeResourceState satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
+	if req.RawState != nil {
+		diags := s.limits.CheckDynamicValue("raw state", nil, req.RawState.JSON)
+
+		if len(diags) > 0 {
+			return &tfprotov6.UpgradeResourceStateResponse{
+				Diagnostics: toproto6.Diagnostics(logging.InitContext(ctx), diags),
+			}, nil
+		}
+	}
+
+	return s.server.UpgradeResourceState(ctx, req)
+}
+
+// ReadResource satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "current state", value: req.CurrentState},
+		valueLimitsValue{description: "provider meta", value: req.ProviderMeta},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov6.ReadResourceResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ReadResource(ctx, req)
+}
+
+// PlanResourceChange satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "resource configuration", value: req.Config},
+		valueLimitsValue{description: "prior state", value: req.PriorState},
+		valueLimitsValue{description: "proposed new state", value: req.ProposedNewState},
+		valueLimitsValue{description: "provider meta", value: req.ProviderMeta},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov6.PlanResourceChangeResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.PlanResourceChange(ctx, req)
+}
+
+// ApplyResourceChange satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "resource configuration", value: req.Config},
+		valueLimitsValue{description: "prior state", value: req.PriorState},
+		valueLimitsValue{description: "planned state", value: req.PlannedState},
+		valueLimitsValue{description: "provider meta", value: req.ProviderMeta},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov6.ApplyResourceChangeResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ApplyResourceChange(ctx, req)
+}
+
+// ImportResourceState satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	return s.server.ImportResourceState(ctx, req)
+}
+
+// ValidateDataResourceConfig satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "data source configuration", value: req.Config},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov6.ValidateDataResourceConfigResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ValidateDataResourceConfig(ctx, req)
+}
+
+// ReadDataSource satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	diagnostics := s.check(
+		ctx,
+		valueLimitsValue{description: "data source configuration", value: req.Config},
+		valueLimitsValue{description: "provider meta", value: req.ProviderMeta},
+	)
+
+	if len(diagnostics) > 0 {
+		return &tfprotov6.ReadDataSourceResponse{
+			Diagnostics: diagnostics,
+		}, nil
+	}
+
+	return s.server.ReadDataSource(ctx, req)
+}
+
+// StopProvider satisfies the tfprotov6.ProviderServer interface.
+func (s *valueLimitsServer) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	return s.server.StopProvider(ctx, req)
+}
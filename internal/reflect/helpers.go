@@ -56,7 +56,7 @@ func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[str
 			// skip unexported fields
 			continue
 		}
-		tag := field.Tag.Get(`tfsdk`)
+		tag, _ := StructFieldTag(field)
 		if tag == "-" {
 			// skip explicitly excluded fields
 			continue
@@ -76,6 +76,20 @@ func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[str
 	return tags, nil
 }
 
+// StructFieldTag returns the attribute name and any comma separated options,
+// such as "required", of the "tfsdk" struct tag of the field. The options are
+// only used for schema generation and are otherwise ignored.
+func StructFieldTag(field reflect.StructField) (string, []string) {
+	tag := field.Tag.Get(`tfsdk`)
+	name, options, found := strings.Cut(tag, ",")
+
+	if !found {
+		return name, nil
+	}
+
+	return name, strings.Split(options, ",")
+}
+
 // isValidFieldName returns true if `name` can be used as a field name in a
 // Terraform resource or data source.
 func isValidFieldName(name string) bool {
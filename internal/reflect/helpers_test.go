@@ -6,6 +6,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -115,6 +117,25 @@ func TestGetStructTags_success(t *testing.T) {
 	}
 }
 
+func TestGetStructTags_options(t *testing.T) {
+	t.Parallel()
+	type testStruct struct {
+		Field1 string `tfsdk:"field1,required,sensitive"`
+		Field2 string `tfsdk:"field2"`
+	}
+	res, err := getStructTags(context.Background(), reflect.ValueOf(testStruct{}), path.Empty())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+	expected := map[string]int{
+		"field1": 0,
+		"field2": 1,
+	}
+	if diff := cmp.Diff(res, expected); diff != "" {
+		t.Errorf("Unexpected result: %s", diff)
+	}
+}
+
 func TestGetStructTags_untagged(t *testing.T) {
 	t.Parallel()
 	type testStruct struct {
@@ -175,6 +196,51 @@ func TestGetStructTags_notAStruct(t *testing.T) {
 	}
 }
 
+func TestStructFieldTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		field           reflect.StructField
+		expectedName    string
+		expectedOptions []string
+	}{
+		"missing": {
+			field: reflect.StructField{},
+		},
+		"name": {
+			field: reflect.StructField{
+				Tag: `tfsdk:"test"`,
+			},
+			expectedName: "test",
+		},
+		"name-options": {
+			field: reflect.StructField{
+				Tag: `tfsdk:"test,optional,computed"`,
+			},
+			expectedName:    "test",
+			expectedOptions: []string{"optional", "computed"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotName, gotOptions := StructFieldTag(testCase.field)
+
+			if gotName != testCase.expectedName {
+				t.Errorf("expected name %q, got %q", testCase.expectedName, gotName)
+			}
+
+			if diff := cmp.Diff(gotOptions, testCase.expectedOptions); diff != "" {
+				t.Errorf("unexpected options difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestIsValidFieldName(t *testing.T) {
 	t.Parallel()
 	tests := map[string]bool{
@@ -292,6 +292,10 @@ func NewAttributeValue(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 	if err != nil {
 		return target, append(diags, valueFromTerraformErrorDiag(err, path))
 	}
+	// interface targets, such as attr.Value, accept any implementation
+	if target.Kind() == reflect.Interface && reflect.TypeOf(res).Implements(target.Type()) {
+		return reflect.ValueOf(res), diags
+	}
 	if reflect.TypeOf(res) != target.Type() {
 		diags.Append(diag.WithPath(path, DiagNewAttributeValueIntoWrongType{
 			ValType:    reflect.TypeOf(res),
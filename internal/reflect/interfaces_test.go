@@ -440,6 +440,11 @@ func TestNewAttributeValue(t *testing.T) {
 			target:   reflect.ValueOf(types.String{}),
 			expected: types.StringValue("hello"),
 		},
+		"generic-attr-value": {
+			val:      tftypes.NewValue(tftypes.String, "hello"),
+			target:   reflect.New(reflect.TypeOf((*attr.Value)(nil)).Elem()).Elem(),
+			expected: types.StringValue("hello"),
+		},
 	}
 
 	for name, tc := range testCases {
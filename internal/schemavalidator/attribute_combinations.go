@@ -0,0 +1,229 @@
+package schemavalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// AttributeCombinationRequest is the type independent request for the
+// attribute combination validators, such as ConflictsWith.
+type AttributeCombinationRequest struct {
+	// Config is the entire configuration being validated.
+	Config tfsdk.Config
+
+	// ConfigValue is the configuration value of the attribute being
+	// validated.
+	ConfigValue attr.Value
+
+	// Path is the path of the attribute being validated.
+	Path path.Path
+
+	// PathExpression is the path expression of the attribute being
+	// validated, which the Expressions are merged with.
+	PathExpression path.Expression
+
+	// Expressions are the path expressions of the other attributes, which
+	// can be relative to the attribute being validated or absolute.
+	Expressions path.Expressions
+}
+
+// AlsoRequires returns an error diagnostic for each attribute matching the
+// expressions which is null, when the attribute being validated is not null.
+// Unknown values are not considered null.
+func AlsoRequires(ctx context.Context, req AttributeCombinationRequest) diag.Diagnostics {
+	if req.ConfigValue.IsNull() {
+		return nil
+	}
+
+	matchedValues, diags := attributeCombinationValues(ctx, req)
+
+	for _, matchedValue := range matchedValues {
+		if !matchedValue.value.IsNull() {
+			continue
+		}
+
+		diags.Append(attributeCombinationDiagnostic(
+			req.Path,
+			fmt.Sprintf("Attribute %q must be specified when %q is specified", matchedValue.path, req.Path),
+		))
+	}
+
+	return diags
+}
+
+// AtLeastOneOf returns an error diagnostic if the attribute being validated
+// and all attributes matching the expressions are null. Unknown values are
+// not considered null.
+func AtLeastOneOf(ctx context.Context, req AttributeCombinationRequest) diag.Diagnostics {
+	if !req.ConfigValue.IsNull() {
+		return nil
+	}
+
+	matchedValues, diags := attributeCombinationValues(ctx, req)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, matchedValue := range matchedValues {
+		if !matchedValue.value.IsNull() {
+			return diags
+		}
+	}
+
+	diags.Append(attributeCombinationDiagnostic(
+		req.Path,
+		fmt.Sprintf("At least one attribute out of %s must be specified", attributeCombinationExpressions(req)),
+	))
+
+	return diags
+}
+
+// ConflictsWith returns an error diagnostic for each attribute matching the
+// expressions which is not null, when the attribute being validated is not
+// null. Unknown matched values are skipped, since they may become null.
+func ConflictsWith(ctx context.Context, req AttributeCombinationRequest) diag.Diagnostics {
+	if req.ConfigValue.IsNull() {
+		return nil
+	}
+
+	matchedValues, diags := attributeCombinationValues(ctx, req)
+
+	for _, matchedValue := range matchedValues {
+		if matchedValue.value.IsNull() || matchedValue.value.IsUnknown() {
+			continue
+		}
+
+		diags.Append(attributeCombinationDiagnostic(
+			req.Path,
+			fmt.Sprintf("Attribute %q cannot be specified when %q is specified", matchedValue.path, req.Path),
+		))
+	}
+
+	return diags
+}
+
+// ExactlyOneOf returns an error diagnostic unless exactly one of the
+// attribute being validated and the attributes matching the expressions is
+// not null. No diagnostics are returned if any of the values are unknown,
+// since it is not possible to determine which will be null.
+func ExactlyOneOf(ctx context.Context, req AttributeCombinationRequest) diag.Diagnostics {
+	matchedValues, diags := attributeCombinationValues(ctx, req)
+
+	if diags.HasError() || req.ConfigValue.IsUnknown() {
+		return diags
+	}
+
+	count := 0
+
+	if !req.ConfigValue.IsNull() {
+		count++
+	}
+
+	for _, matchedValue := range matchedValues {
+		if matchedValue.value.IsUnknown() {
+			return diags
+		}
+
+		if !matchedValue.value.IsNull() {
+			count++
+		}
+	}
+
+	switch count {
+	case 0:
+		diags.Append(attributeCombinationDiagnostic(
+			req.Path,
+			fmt.Sprintf("No attribute specified when one (and only one) of %s is required", attributeCombinationExpressions(req)),
+		))
+	case 1:
+	default:
+		diags.Append(attributeCombinationDiagnostic(
+			req.Path,
+			fmt.Sprintf("%d attributes specified when one (and only one) of %s is required", count, attributeCombinationExpressions(req)),
+		))
+	}
+
+	return diags
+}
+
+// attributeCombinationValue is a configuration value matching one of the
+// request expressions.
+type attributeCombinationValue struct {
+	path  path.Path
+	value attr.Value
+}
+
+// attributeCombinationValues returns the configuration values matching the
+// request expressions, excluding the attribute being validated.
+func attributeCombinationValues(ctx context.Context, req AttributeCombinationRequest) ([]attributeCombinationValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var values []attributeCombinationValue
+
+	for _, expression := range req.PathExpression.MergeExpressions(req.Expressions...) {
+		matchedPaths, matchedPathsDiags := req.Config.PathMatches(ctx, expression)
+
+		diags.Append(matchedPathsDiags...)
+
+		if matchedPathsDiags.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			if matchedPath.Equal(req.Path) {
+				continue
+			}
+
+			var matchedPathValue attr.Value
+
+			getAttributeDiags := req.Config.GetAttribute(ctx, matchedPath, &matchedPathValue)
+
+			diags.Append(getAttributeDiags...)
+
+			if getAttributeDiags.HasError() || matchedPathValue == nil {
+				continue
+			}
+
+			values = append(values, attributeCombinationValue{
+				path:  matchedPath,
+				value: matchedPathValue,
+			})
+		}
+	}
+
+	return values, diags
+}
+
+// attributeCombinationExpressions returns the string representation of the
+// attribute being validated and the request expressions, for diagnostics.
+func attributeCombinationExpressions(req AttributeCombinationRequest) string {
+	expressions := req.PathExpression.MergeExpressions(req.Expressions...)
+	expressionStrings := make([]string, 0, len(expressions)+1)
+	expressionStrings = append(expressionStrings, req.PathExpression.String())
+
+	for _, expression := range expressions {
+		if expression.Resolve().Equal(req.PathExpression.Resolve()) {
+			continue
+		}
+
+		expressionStrings = append(expressionStrings, expression.Resolve().String())
+	}
+
+	return "[" + strings.Join(expressionStrings, ",") + "]"
+}
+
+// attributeCombinationDiagnostic returns an error diagnostic for an invalid
+// combination of attributes.
+func attributeCombinationDiagnostic(p path.Path, detail string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Invalid Attribute Combination",
+		detail,
+	)
+}
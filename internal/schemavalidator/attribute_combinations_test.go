@@ -0,0 +1,171 @@
+package schemavalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAttributeCombinations(t *testing.T) {
+	t.Parallel()
+
+	testConfig := func(test any, other any) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"other": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+					"test": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"other": tftypes.String,
+						"test":  tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"other": tftypes.NewValue(tftypes.String, other),
+					"test":  tftypes.NewValue(tftypes.String, test),
+				},
+			),
+		}
+	}
+
+	testRequest := func(test any, other any, expression path.Expression) schemavalidator.AttributeCombinationRequest {
+		req := schemavalidator.AttributeCombinationRequest{
+			Config:         testConfig(test, other),
+			Expressions:    path.Expressions{expression},
+			Path:           path.Root("test"),
+			PathExpression: path.MatchRoot("test"),
+		}
+
+		switch test {
+		case nil:
+			req.ConfigValue = types.StringNull()
+		case tftypes.UnknownValue:
+			req.ConfigValue = types.StringUnknown()
+		default:
+			req.ConfigValue = types.StringValue(test.(string))
+		}
+
+		return req
+	}
+
+	testCases := map[string]struct {
+		validator func(context.Context, schemavalidator.AttributeCombinationRequest) diag.Diagnostics
+		request   schemavalidator.AttributeCombinationRequest
+		expected  diag.Diagnostics
+	}{
+		"alsorequires-relative": {
+			validator: schemavalidator.AlsoRequires,
+			request:   testRequest("value", nil, path.MatchRelative().AtParent().AtName("other")),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Combination",
+					`Attribute "other" must be specified when "test" is specified`,
+				),
+			},
+		},
+		"alsorequires-unknown": {
+			validator: schemavalidator.AlsoRequires,
+			request:   testRequest("value", tftypes.UnknownValue, path.MatchRoot("other")),
+			expected:  nil,
+		},
+		"atleastoneof-unknown": {
+			validator: schemavalidator.AtLeastOneOf,
+			request:   testRequest(nil, tftypes.UnknownValue, path.MatchRoot("other")),
+			expected:  nil,
+		},
+		"conflictswith-relative": {
+			validator: schemavalidator.ConflictsWith,
+			request:   testRequest("value", "value", path.MatchRelative().AtParent().AtName("other")),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Combination",
+					`Attribute "other" cannot be specified when "test" is specified`,
+				),
+			},
+		},
+		"conflictswith-self": {
+			validator: schemavalidator.ConflictsWith,
+			request:   testRequest("value", nil, path.MatchRoot("test")),
+			expected:  nil,
+		},
+		"conflictswith-unknown": {
+			validator: schemavalidator.ConflictsWith,
+			request:   testRequest("value", tftypes.UnknownValue, path.MatchRoot("other")),
+			expected:  nil,
+		},
+		"exactlyoneof-relative": {
+			validator: schemavalidator.ExactlyOneOf,
+			request:   testRequest("value", "value", path.MatchRelative().AtParent().AtName("other")),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Combination",
+					"2 attributes specified when one (and only one) of [test,other] is required",
+				),
+			},
+		},
+		"exactlyoneof-self": {
+			validator: schemavalidator.ExactlyOneOf,
+			request:   testRequest("value", nil, path.MatchRoot("test")),
+			expected:  nil,
+		},
+		"exactlyoneof-unknown": {
+			validator: schemavalidator.ExactlyOneOf,
+			request:   testRequest(tftypes.UnknownValue, "value", path.MatchRoot("other")),
+			expected:  nil,
+		},
+		"exactlyoneof-other-unknown": {
+			validator: schemavalidator.ExactlyOneOf,
+			request:   testRequest(nil, tftypes.UnknownValue, path.MatchRoot("other")),
+			expected:  nil,
+		},
+		"invalid-expression": {
+			validator: schemavalidator.ConflictsWith,
+			request:   testRequest("value", nil, path.MatchRoot("nonexistent")),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema Data",
+					"The Terraform Provider unexpectedly matched no paths with the given path expression and current schema data. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: nonexistent",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.validator(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
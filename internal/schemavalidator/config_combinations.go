@@ -0,0 +1,210 @@
+package schemavalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ConfigCombinationRequest is the request for the configuration-level
+// attribute combination validators, such as ConfigConflicting. Unlike
+// AttributeCombinationRequest, there is no attribute being validated, so the
+// expressions must be absolute from the root of the schema.
+type ConfigCombinationRequest struct {
+	// Config is the entire configuration being validated.
+	Config tfsdk.Config
+
+	// Expressions are the path expressions of the attributes.
+	Expressions path.Expressions
+}
+
+// ConfigAtLeastOneOf returns an error diagnostic if all attributes matching
+// the expressions are null. Unknown values are not considered null.
+func ConfigAtLeastOneOf(ctx context.Context, req ConfigCombinationRequest) diag.Diagnostics {
+	matchedValues, diags := configCombinationValues(ctx, req)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, matchedValue := range matchedValues {
+		if !matchedValue.value.IsNull() {
+			return diags
+		}
+	}
+
+	diags.AddError(
+		"Missing Attribute Configuration",
+		fmt.Sprintf("At least one attribute out of %s must be specified", ConfigCombinationExpressions(req.Expressions)),
+	)
+
+	return diags
+}
+
+// ConfigConflicting returns an error diagnostic if more than one attribute
+// matching the expressions is not null. Unknown values are skipped, since
+// they may become null.
+func ConfigConflicting(ctx context.Context, req ConfigCombinationRequest) diag.Diagnostics {
+	matchedValues, diags := configCombinationValues(ctx, req)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	var configuredPaths path.Paths
+
+	for _, matchedValue := range matchedValues {
+		if matchedValue.value.IsNull() || matchedValue.value.IsUnknown() {
+			continue
+		}
+
+		configuredPaths.Append(matchedValue.path)
+	}
+
+	if len(configuredPaths) > 1 {
+		diags.Append(attributeCombinationDiagnostic(
+			configuredPaths[0],
+			fmt.Sprintf("These attributes cannot be configured together: %s", configuredPaths),
+		))
+	}
+
+	return diags
+}
+
+// ConfigExactlyOneOf returns an error diagnostic unless exactly one of the
+// attributes matching the expressions is not null. No diagnostics are
+// returned if any of the values are unknown, since it is not possible to
+// determine which will be null.
+func ConfigExactlyOneOf(ctx context.Context, req ConfigCombinationRequest) diag.Diagnostics {
+	matchedValues, diags := configCombinationValues(ctx, req)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	var configuredPaths path.Paths
+
+	for _, matchedValue := range matchedValues {
+		if matchedValue.value.IsUnknown() {
+			return diags
+		}
+
+		if !matchedValue.value.IsNull() {
+			configuredPaths.Append(matchedValue.path)
+		}
+	}
+
+	switch len(configuredPaths) {
+	case 0:
+		diags.AddError(
+			"Missing Attribute Configuration",
+			fmt.Sprintf("No attribute specified when one (and only one) of %s is required", ConfigCombinationExpressions(req.Expressions)),
+		)
+	case 1:
+	default:
+		diags.Append(attributeCombinationDiagnostic(
+			configuredPaths[0],
+			fmt.Sprintf("%d attributes specified when one (and only one) of %s is required", len(configuredPaths), ConfigCombinationExpressions(req.Expressions)),
+		))
+	}
+
+	return diags
+}
+
+// ConfigRequiredTogether returns an error diagnostic if some, but not all,
+// attributes matching the expressions are null. No diagnostics are returned
+// if any of the values are unknown, since it is not possible to determine
+// which will be null.
+func ConfigRequiredTogether(ctx context.Context, req ConfigCombinationRequest) diag.Diagnostics {
+	matchedValues, diags := configCombinationValues(ctx, req)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	var configuredPaths, nullPaths path.Paths
+
+	for _, matchedValue := range matchedValues {
+		if matchedValue.value.IsUnknown() {
+			return diags
+		}
+
+		if matchedValue.value.IsNull() {
+			nullPaths.Append(matchedValue.path)
+
+			continue
+		}
+
+		configuredPaths.Append(matchedValue.path)
+	}
+
+	if len(configuredPaths) > 0 && len(nullPaths) > 0 {
+		diags.Append(attributeCombinationDiagnostic(
+			configuredPaths[0],
+			fmt.Sprintf("These attributes must be configured together: %s", ConfigCombinationExpressions(req.Expressions)),
+		))
+	}
+
+	return diags
+}
+
+// ConfigCombinationExpressions returns the string representation of the
+// expressions, for descriptions and diagnostics.
+func ConfigCombinationExpressions(expressions path.Expressions) string {
+	expressionStrings := make([]string, 0, len(expressions))
+
+	for _, expression := range expressions {
+		expressionStrings = append(expressionStrings, expression.Resolve().String())
+	}
+
+	return "[" + strings.Join(expressionStrings, ",") + "]"
+}
+
+// configCombinationValues returns the configuration values matching the
+// request expressions. Paths matched by multiple expressions are returned
+// once.
+func configCombinationValues(ctx context.Context, req ConfigCombinationRequest) ([]attributeCombinationValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var matchedPaths path.Paths
+	var values []attributeCombinationValue
+
+	for _, expression := range req.Expressions {
+		expressionMatchedPaths, expressionMatchedPathsDiags := req.Config.PathMatches(ctx, expression)
+
+		diags.Append(expressionMatchedPathsDiags...)
+
+		if expressionMatchedPathsDiags.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range expressionMatchedPaths {
+			if matchedPaths.Contains(matchedPath) {
+				continue
+			}
+
+			matchedPaths.Append(matchedPath)
+
+			var matchedPathValue attr.Value
+
+			getAttributeDiags := req.Config.GetAttribute(ctx, matchedPath, &matchedPathValue)
+
+			diags.Append(getAttributeDiags...)
+
+			if getAttributeDiags.HasError() || matchedPathValue == nil {
+				continue
+			}
+
+			values = append(values, attributeCombinationValue{
+				path:  matchedPath,
+				value: matchedPathValue,
+			})
+		}
+	}
+
+	return values, diags
+}
@@ -0,0 +1,51 @@
+package schemavalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ConfigValueEquals returns true if any configuration value matching the
+// given expression is equal to the given value. The expression is merged
+// with the path expression of the attribute being validated, so it can be
+// relative or absolute.
+//
+// Unknown configuration values never match, since it is not possible to
+// determine their eventual value during validation.
+func ConfigValueEquals(ctx context.Context, config tfsdk.Config, attributePathExpression path.Expression, expression path.Expression, value attr.Value) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	matchedPaths, matchedPathsDiags := config.PathMatches(ctx, attributePathExpression.Merge(expression))
+
+	diags.Append(matchedPathsDiags...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, matchedPath := range matchedPaths {
+		var matchedPathValue attr.Value
+
+		getAttributeDiags := config.GetAttribute(ctx, matchedPath, &matchedPathValue)
+
+		diags.Append(getAttributeDiags...)
+
+		if getAttributeDiags.HasError() {
+			continue
+		}
+
+		if matchedPathValue == nil || matchedPathValue.IsUnknown() {
+			continue
+		}
+
+		if matchedPathValue.Equal(value) {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
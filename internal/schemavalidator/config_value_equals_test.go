@@ -0,0 +1,124 @@
+package schemavalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConfigValueEquals(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"condition": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"test": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testConfig := func(condition tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"condition": tftypes.String,
+						"test":      tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"condition": condition,
+					"test":      tftypes.NewValue(tftypes.String, "test-value"),
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		config        tfsdk.Config
+		expression    path.Expression
+		value         attr.Value
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"relative-equal": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, "expected")),
+			expression: path.MatchRelative().AtParent().AtName("condition"),
+			value:      types.StringValue("expected"),
+			expected:   true,
+		},
+		"root-equal": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, "expected")),
+			expression: path.MatchRoot("condition"),
+			value:      types.StringValue("expected"),
+			expected:   true,
+		},
+		"not-equal": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, "other")),
+			expression: path.MatchRoot("condition"),
+			value:      types.StringValue("expected"),
+			expected:   false,
+		},
+		"null-equal": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, nil)),
+			expression: path.MatchRoot("condition"),
+			value:      types.StringNull(),
+			expected:   true,
+		},
+		"unknown": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			expression: path.MatchRoot("condition"),
+			value:      types.StringUnknown(),
+			expected:   false,
+		},
+		"invalid-expression": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, "expected")),
+			expression: path.MatchRoot("not_present"),
+			value:      types.StringValue("expected"),
+			expected:   false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema Data",
+					"The Terraform Provider unexpectedly matched no paths with the given path expression and current schema data. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: not_present",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := schemavalidator.ConfigValueEquals(context.Background(), testCase.config, path.MatchRoot("test"), testCase.expression, testCase.value)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
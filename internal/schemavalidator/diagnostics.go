@@ -0,0 +1,36 @@
+package schemavalidator
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// InvalidValueDiagnostic returns an error diagnostic for a configuration
+// value which does not satisfy the validator description, such as "string
+// length must be at most 10".
+func InvalidValueDiagnostic(p path.Path, summary string, description string, value string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		summary,
+		"Attribute "+p.String()+" "+description+", got: "+value,
+	)
+}
+
+// InvalidElementTypeDiagnostic returns an error diagnostic for a collection
+// element validator which does not match the collection element type, such
+// as a String element validator on a list of Int64 elements.
+func InvalidElementTypeDiagnostic(p path.Path, expectedType string, element attr.Value) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Invalid Validator for Element Type",
+		"While performing schema-based validation, an unexpected error occurred. "+
+			"The attribute declares a "+expectedType+" values validator, however its values do not implement the "+expectedType+" value type. "+
+			"Use the appropriate values validator that matches the element type. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			"Path: "+p.String()+"\n"+
+			"Element Type: "+fmt.Sprintf("%T", element),
+	)
+}
@@ -0,0 +1,4 @@
+// Package schemavalidator contains type independent logic shared by the
+// framework schema validator implementations, such as the type-specific
+// schema/stringvalidator package.
+package schemavalidator
@@ -0,0 +1,43 @@
+package schemavalidator
+
+import (
+	"math/big"
+)
+
+// multipleOfTolerance is the largest difference between a quotient and the
+// nearest integer which is still considered a multiple. This accounts for
+// decimal configuration values, such as 0.3 and 0.1, which do not have an
+// exact binary floating point representation.
+var multipleOfTolerance = big.NewFloat(1e-9)
+
+// IsMultipleOf returns true if the value is a multiple of the given multiple,
+// within a small tolerance for floating point representation. Only zero is a
+// multiple of zero and infinite values are never multiples.
+func IsMultipleOf(value *big.Float, multiple *big.Float) bool {
+	if value.IsInf() || multiple.IsInf() {
+		return false
+	}
+
+	if multiple.Sign() == 0 {
+		return value.Sign() == 0
+	}
+
+	quotient := new(big.Float).Quo(value, multiple)
+
+	if quotient.IsInt() {
+		return true
+	}
+
+	truncated, _ := quotient.Int(nil)
+
+	fraction := new(big.Float).Sub(quotient, new(big.Float).SetInt(truncated))
+	fraction.Abs(fraction)
+
+	// Use the distance to the nearest integer, which may be the next integer
+	// away from zero.
+	if fraction.Cmp(big.NewFloat(0.5)) > 0 {
+		fraction.Sub(big.NewFloat(1), fraction)
+	}
+
+	return fraction.Cmp(multipleOfTolerance) <= 0
+}
@@ -0,0 +1,74 @@
+package schemavalidator_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+)
+
+func TestIsMultipleOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    *big.Float
+		multiple *big.Float
+		expected bool
+	}{
+		"integer-multiple": {
+			value:    big.NewFloat(15),
+			multiple: big.NewFloat(5),
+			expected: true,
+		},
+		"integer-not-multiple": {
+			value:    big.NewFloat(16),
+			multiple: big.NewFloat(5),
+			expected: false,
+		},
+		"negative-multiple": {
+			value:    big.NewFloat(-15),
+			multiple: big.NewFloat(5),
+			expected: true,
+		},
+		"decimal-multiple": {
+			value:    big.NewFloat(0.3),
+			multiple: big.NewFloat(0.1),
+			expected: true,
+		},
+		"decimal-not-multiple": {
+			value:    big.NewFloat(0.35),
+			multiple: big.NewFloat(0.1),
+			expected: false,
+		},
+		"zero-multiple-zero": {
+			value:    big.NewFloat(0),
+			multiple: big.NewFloat(0),
+			expected: true,
+		},
+		"nonzero-multiple-zero": {
+			value:    big.NewFloat(1),
+			multiple: big.NewFloat(0),
+			expected: false,
+		},
+		"infinite-value": {
+			value:    big.NewFloat(math.Inf(1)),
+			multiple: big.NewFloat(1),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemavalidator.IsMultipleOf(testCase.value, testCase.multiple)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
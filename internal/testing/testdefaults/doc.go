@@ -0,0 +1,2 @@
+// Package testdefaults contains declarative resource/schema/defaults implementations for unit testing.
+package testdefaults
@@ -0,0 +1,44 @@
+package testdefaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+var _ defaults.Object = Object{}
+
+// Declarative defaults.Object for unit testing.
+type Object struct {
+	// defaults.Object interface methods
+	DescriptionMethod         func(context.Context) string
+	MarkdownDescriptionMethod func(context.Context) string
+	DefaultObjectMethod       func(context.Context, defaults.ObjectRequest, *defaults.ObjectResponse)
+}
+
+// Description satisfies the defaults.Object interface.
+func (v Object) Description(ctx context.Context) string {
+	if v.DescriptionMethod == nil {
+		return ""
+	}
+
+	return v.DescriptionMethod(ctx)
+}
+
+// MarkdownDescription satisfies the defaults.Object interface.
+func (v Object) MarkdownDescription(ctx context.Context) string {
+	if v.MarkdownDescriptionMethod == nil {
+		return ""
+	}
+
+	return v.MarkdownDescriptionMethod(ctx)
+}
+
+// DefaultObject satisfies the defaults.Object interface.
+func (v Object) DefaultObject(ctx context.Context, req defaults.ObjectRequest, resp *defaults.ObjectResponse) {
+	if v.DefaultObjectMethod == nil {
+		return
+	}
+
+	v.DefaultObjectMethod(ctx, req, resp)
+}
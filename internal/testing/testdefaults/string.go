@@ -0,0 +1,44 @@
+package testdefaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+var _ defaults.String = String{}
+
+// Declarative defaults.String for unit testing.
+type String struct {
+	// defaults.String interface methods
+	DescriptionMethod         func(context.Context) string
+	MarkdownDescriptionMethod func(context.Context) string
+	DefaultStringMethod       func(context.Context, defaults.StringRequest, *defaults.StringResponse)
+}
+
+// Description satisfies the defaults.String interface.
+func (v String) Description(ctx context.Context) string {
+	if v.DescriptionMethod == nil {
+		return ""
+	}
+
+	return v.DescriptionMethod(ctx)
+}
+
+// MarkdownDescription satisfies the defaults.String interface.
+func (v String) MarkdownDescription(ctx context.Context) string {
+	if v.MarkdownDescriptionMethod == nil {
+		return ""
+	}
+
+	return v.MarkdownDescriptionMethod(ctx)
+}
+
+// DefaultString satisfies the defaults.String interface.
+func (v String) DefaultString(ctx context.Context, req defaults.StringRequest, resp *defaults.StringResponse) {
+	if v.DefaultStringMethod == nil {
+		return
+	}
+
+	v.DefaultStringMethod(ctx, req, resp)
+}
@@ -0,0 +1,34 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.PrivateStateCodec = &PrivateStateCodec{}
+
+// Declarative resource.PrivateStateCodec for unit testing.
+type PrivateStateCodec struct {
+	// PrivateStateCodec interface methods
+	DecryptMethod func(context.Context, string, []byte) ([]byte, error)
+	EncryptMethod func(context.Context, string, []byte) ([]byte, error)
+}
+
+// Decrypt satisfies the resource.PrivateStateCodec interface.
+func (c *PrivateStateCodec) Decrypt(ctx context.Context, key string, value []byte) ([]byte, error) {
+	if c.DecryptMethod == nil {
+		return value, nil
+	}
+
+	return c.DecryptMethod(ctx, key, value)
+}
+
+// Encrypt satisfies the resource.PrivateStateCodec interface.
+func (c *PrivateStateCodec) Encrypt(ctx context.Context, key string, value []byte) ([]byte, error) {
+	if c.EncryptMethod == nil {
+		return value, nil
+	}
+
+	return c.EncryptMethod(ctx, key, value)
+}
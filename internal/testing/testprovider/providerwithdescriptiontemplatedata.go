@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithDescriptionTemplateData{}
+var _ provider.ProviderWithDescriptionTemplateData = &ProviderWithDescriptionTemplateData{}
+
+// Declarative provider.ProviderWithDescriptionTemplateData for unit testing.
+type ProviderWithDescriptionTemplateData struct {
+	*Provider
+
+	// ProviderWithDescriptionTemplateData interface methods
+	DescriptionTemplateDataMethod func(context.Context, provider.DescriptionTemplateDataRequest, *provider.DescriptionTemplateDataResponse)
+}
+
+// DescriptionTemplateData satisfies the provider.ProviderWithDescriptionTemplateData interface.
+func (p *ProviderWithDescriptionTemplateData) DescriptionTemplateData(ctx context.Context, req provider.DescriptionTemplateDataRequest, resp *provider.DescriptionTemplateDataResponse) {
+	if p.DescriptionTemplateDataMethod == nil {
+		return
+	}
+
+	p.DescriptionTemplateDataMethod(ctx, req, resp)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithEventSubscribers{}
+var _ provider.ProviderWithEventSubscribers = &ProviderWithEventSubscribers{}
+
+// Declarative provider.ProviderWithEventSubscribers for unit testing.
+type ProviderWithEventSubscribers struct {
+	*Provider
+
+	// ProviderWithEventSubscribers interface methods
+	EventSubscribersMethod func(context.Context) []provider.EventSubscriber
+}
+
+// EventSubscribers satisfies the provider.ProviderWithEventSubscribers interface.
+func (p *ProviderWithEventSubscribers) EventSubscribers(ctx context.Context) []provider.EventSubscriber {
+	if p.EventSubscribersMethod == nil {
+		return nil
+	}
+
+	return p.EventSubscribersMethod(ctx)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithFormatDiagnostic{}
+var _ provider.ProviderWithFormatDiagnostic = &ProviderWithFormatDiagnostic{}
+
+// Declarative provider.ProviderWithFormatDiagnostic for unit testing.
+type ProviderWithFormatDiagnostic struct {
+	*Provider
+
+	// ProviderWithFormatDiagnostic interface methods
+	FormatDiagnosticMethod func(context.Context, provider.FormatDiagnosticRequest, *provider.FormatDiagnosticResponse)
+}
+
+// FormatDiagnostic satisfies the provider.ProviderWithFormatDiagnostic interface.
+func (p *ProviderWithFormatDiagnostic) FormatDiagnostic(ctx context.Context, req provider.FormatDiagnosticRequest, resp *provider.FormatDiagnosticResponse) {
+	if p.FormatDiagnosticMethod == nil {
+		return
+	}
+
+	p.FormatDiagnosticMethod(ctx, req, resp)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithNullComputedAttributeCheck{}
+var _ provider.ProviderWithNullComputedAttributeCheck = &ProviderWithNullComputedAttributeCheck{}
+
+// Declarative provider.ProviderWithNullComputedAttributeCheck for unit testing.
+type ProviderWithNullComputedAttributeCheck struct {
+	*Provider
+
+	// ProviderWithNullComputedAttributeCheck interface methods
+	NullComputedAttributeCheckMethod func(context.Context) bool
+}
+
+// NullComputedAttributeCheck satisfies the provider.ProviderWithNullComputedAttributeCheck interface.
+func (p *ProviderWithNullComputedAttributeCheck) NullComputedAttributeCheck(ctx context.Context) bool {
+	if p.NullComputedAttributeCheckMethod == nil {
+		return false
+	}
+
+	return p.NullComputedAttributeCheckMethod(ctx)
+}
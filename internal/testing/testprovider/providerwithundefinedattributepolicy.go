@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithUndefinedAttributePolicy{}
+var _ provider.ProviderWithUndefinedAttributePolicy = &ProviderWithUndefinedAttributePolicy{}
+
+// Declarative provider.ProviderWithUndefinedAttributePolicy for unit testing.
+type ProviderWithUndefinedAttributePolicy struct {
+	*Provider
+
+	// ProviderWithUndefinedAttributePolicy interface methods
+	UndefinedAttributePolicyMethod func(context.Context) provider.UndefinedAttributePolicy
+}
+
+// UndefinedAttributePolicy satisfies the provider.ProviderWithUndefinedAttributePolicy interface.
+func (p *ProviderWithUndefinedAttributePolicy) UndefinedAttributePolicy(ctx context.Context) provider.UndefinedAttributePolicy {
+	if p.UndefinedAttributePolicyMethod == nil {
+		return provider.UndefinedAttributePolicyIgnore
+	}
+
+	return p.UndefinedAttributePolicyMethod(ctx)
+}
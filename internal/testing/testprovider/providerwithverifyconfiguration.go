@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithVerifyConfiguration{}
+var _ provider.ProviderWithVerifyConfiguration = &ProviderWithVerifyConfiguration{}
+
+// Declarative provider.ProviderWithVerifyConfiguration for unit testing.
+type ProviderWithVerifyConfiguration struct {
+	*Provider
+
+	// ProviderWithVerifyConfiguration interface methods
+	VerifyConfigurationMethod func(context.Context, provider.VerifyConfigurationRequest, *provider.VerifyConfigurationResponse)
+}
+
+// VerifyConfiguration satisfies the provider.ProviderWithVerifyConfiguration interface.
+func (p *ProviderWithVerifyConfiguration) VerifyConfiguration(ctx context.Context, req provider.VerifyConfigurationRequest, resp *provider.VerifyConfigurationResponse) {
+	if p.VerifyConfigurationMethod == nil {
+		return
+	}
+
+	p.VerifyConfigurationMethod(ctx, req, resp)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithAfterApply{}
+var _ resource.ResourceWithAfterApply = &ResourceWithAfterApply{}
+
+// Declarative resource.ResourceWithAfterApply for unit testing.
+type ResourceWithAfterApply struct {
+	*Resource
+
+	// ResourceWithAfterApply interface methods
+	AfterApplyMethod func(context.Context, resource.AfterApplyRequest, *resource.AfterApplyResponse)
+}
+
+// AfterApply satisfies the resource.ResourceWithAfterApply interface.
+func (r *ResourceWithAfterApply) AfterApply(ctx context.Context, req resource.AfterApplyRequest, resp *resource.AfterApplyResponse) {
+	if r.AfterApplyMethod == nil {
+		return
+	}
+
+	r.AfterApplyMethod(ctx, req, resp)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithApplyDependsOn{}
+var _ resource.ResourceWithApplyDependsOn = &ResourceWithApplyDependsOn{}
+
+// Declarative resource.ResourceWithApplyDependsOn for unit testing.
+type ResourceWithApplyDependsOn struct {
+	*Resource
+
+	// ResourceWithApplyDependsOn interface methods
+	ApplyDependsOnMethod func(context.Context) []string
+}
+
+// ApplyDependsOn satisfies the resource.ResourceWithApplyDependsOn interface.
+func (p *ResourceWithApplyDependsOn) ApplyDependsOn(ctx context.Context) []string {
+	if p.ApplyDependsOnMethod == nil {
+		return nil
+	}
+
+	return p.ApplyDependsOnMethod(ctx)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithAttributeAliases{}
+var _ resource.ResourceWithAttributeAliases = &ResourceWithAttributeAliases{}
+
+// Declarative resource.ResourceWithAttributeAliases for unit testing.
+type ResourceWithAttributeAliases struct {
+	*Resource
+
+	// ResourceWithAttributeAliases interface methods
+	AttributeAliasesMethod func(context.Context) map[string]string
+}
+
+// AttributeAliases satisfies the resource.ResourceWithAttributeAliases interface.
+func (p *ResourceWithAttributeAliases) AttributeAliases(ctx context.Context) map[string]string {
+	if p.AttributeAliasesMethod == nil {
+		return nil
+	}
+
+	return p.AttributeAliasesMethod(ctx)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithLegacyTypeNames{}
+var _ resource.ResourceWithLegacyTypeNames = &ResourceWithLegacyTypeNames{}
+
+// Declarative resource.ResourceWithLegacyTypeNames for unit testing.
+type ResourceWithLegacyTypeNames struct {
+	*Resource
+
+	// ResourceWithLegacyTypeNames interface methods
+	LegacyTypeNamesMethod func(context.Context) []string
+}
+
+// LegacyTypeNames satisfies the resource.ResourceWithLegacyTypeNames interface.
+func (p *ResourceWithLegacyTypeNames) LegacyTypeNames(ctx context.Context) []string {
+	if p.LegacyTypeNamesMethod == nil {
+		return nil
+	}
+
+	return p.LegacyTypeNamesMethod(ctx)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithReadIncremental{}
+var _ resource.ResourceWithReadIncremental = &ResourceWithReadIncremental{}
+
+// Declarative resource.ResourceWithReadIncremental for unit testing.
+type ResourceWithReadIncremental struct {
+	*Resource
+
+	// ResourceWithReadIncremental interface methods
+	ReadIncrementalMethod func(context.Context, resource.ReadIncrementalRequest, *resource.ReadResponse)
+}
+
+// ReadIncremental satisfies the resource.ResourceWithReadIncremental interface.
+func (r *ResourceWithReadIncremental) ReadIncremental(ctx context.Context, req resource.ReadIncrementalRequest, resp *resource.ReadResponse) {
+	if r.ReadIncrementalMethod == nil {
+		return
+	}
+
+	r.ReadIncrementalMethod(ctx, req, resp)
+}
@@ -0,0 +1,28 @@
+package testprovider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithReadIncrementalMaxAge{}
+var _ resource.ResourceWithReadIncrementalMaxAge = &ResourceWithReadIncrementalMaxAge{}
+
+// Declarative resource.ResourceWithReadIncrementalMaxAge for unit testing.
+type ResourceWithReadIncrementalMaxAge struct {
+	*ResourceWithReadIncremental
+
+	// ResourceWithReadIncrementalMaxAge interface methods
+	ReadIncrementalMaxAgeMethod func(context.Context) time.Duration
+}
+
+// ReadIncrementalMaxAge satisfies the resource.ResourceWithReadIncrementalMaxAge interface.
+func (r *ResourceWithReadIncrementalMaxAge) ReadIncrementalMaxAge(ctx context.Context) time.Duration {
+	if r.ReadIncrementalMaxAgeMethod == nil {
+		return 0
+	}
+
+	return r.ReadIncrementalMaxAgeMethod(ctx)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithSchemaHistory{}
+var _ resource.ResourceWithSchemaHistory = &ResourceWithSchemaHistory{}
+
+// Declarative resource.ResourceWithSchemaHistory for unit testing.
+type ResourceWithSchemaHistory struct {
+	*Resource
+
+	// ResourceWithSchemaHistory interface methods
+	SchemaHistoryMethod func(context.Context) []resource.SchemaChange
+}
+
+// SchemaHistory satisfies the resource.ResourceWithSchemaHistory interface.
+func (p *ResourceWithSchemaHistory) SchemaHistory(ctx context.Context) []resource.SchemaChange {
+	if p.SchemaHistoryMethod == nil {
+		return nil
+	}
+
+	return p.SchemaHistoryMethod(ctx)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithStateCanonicalizers{}
+var _ resource.ResourceWithStateCanonicalizers = &ResourceWithStateCanonicalizers{}
+
+// Declarative resource.ResourceWithStateCanonicalizers for unit testing.
+type ResourceWithStateCanonicalizers struct {
+	*Resource
+
+	// ResourceWithStateCanonicalizers interface methods
+	StateCanonicalizersMethod func(context.Context) []resource.StateCanonicalizer
+}
+
+// StateCanonicalizers satisfies the resource.ResourceWithStateCanonicalizers interface.
+func (p *ResourceWithStateCanonicalizers) StateCanonicalizers(ctx context.Context) []resource.StateCanonicalizer {
+	if p.StateCanonicalizersMethod == nil {
+		return nil
+	}
+
+	return p.StateCanonicalizersMethod(ctx)
+}
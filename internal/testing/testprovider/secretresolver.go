@@ -0,0 +1,24 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.SecretResolver = &SecretResolver{}
+
+// Declarative provider.SecretResolver for unit testing.
+type SecretResolver struct {
+	// SecretResolver interface methods
+	ResolveSecretMethod func(context.Context, provider.ResolveSecretRequest, *provider.ResolveSecretResponse)
+}
+
+// ResolveSecret satisfies the provider.SecretResolver interface.
+func (r *SecretResolver) ResolveSecret(ctx context.Context, req provider.ResolveSecretRequest, resp *provider.ResolveSecretResponse) {
+	if r.ResolveSecretMethod == nil {
+		return
+	}
+
+	r.ResolveSecretMethod(ctx, req, resp)
+}
@@ -25,6 +25,10 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 
 	protov5.Provider, err = Schema(ctx, fw.Provider)
 
+	if err == nil {
+		err = SchemaDescriptionTemplate(protov5.Provider, fw.DescriptionTemplate)
+	}
+
 	if err != nil {
 		protov5.Diagnostics = append(protov5.Diagnostics, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
@@ -37,6 +41,10 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 
 	protov5.ProviderMeta, err = Schema(ctx, fw.ProviderMeta)
 
+	if err == nil {
+		err = SchemaDescriptionTemplate(protov5.ProviderMeta, fw.DescriptionTemplate)
+	}
+
 	if err != nil {
 		protov5.Diagnostics = append(protov5.Diagnostics, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
@@ -50,6 +58,10 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 	for dataSourceType, dataSourceSchema := range fw.DataSourceSchemas {
 		protov5.DataSourceSchemas[dataSourceType], err = Schema(ctx, dataSourceSchema)
 
+		if err == nil {
+			err = SchemaDescriptionTemplate(protov5.DataSourceSchemas[dataSourceType], fw.DescriptionTemplate)
+		}
+
 		if err != nil {
 			protov5.Diagnostics = append(protov5.Diagnostics, &tfprotov5.Diagnostic{
 				Severity: tfprotov5.DiagnosticSeverityError,
@@ -64,6 +76,10 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 	for resourceType, resourceSchema := range fw.ResourceSchemas {
 		protov5.ResourceSchemas[resourceType], err = Schema(ctx, resourceSchema)
 
+		if err == nil {
+			err = SchemaDescriptionTemplate(protov5.ResourceSchemas[resourceType], fw.DescriptionTemplate)
+		}
+
 		if err != nil {
 			protov5.Diagnostics = append(protov5.Diagnostics, &tfprotov5.Diagnostic{
 				Severity: tfprotov5.DiagnosticSeverityError,
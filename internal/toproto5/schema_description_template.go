@@ -0,0 +1,90 @@
+package toproto5
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// SchemaDescriptionTemplate resolves template placeholders in all
+// descriptions of the *tfprotov5.Schema, including nested blocks, using the
+// given *fwserver.DescriptionTemplate.
+func SchemaDescriptionTemplate(s *tfprotov5.Schema, t *fwserver.DescriptionTemplate) error {
+	if s == nil || t == nil {
+		return nil
+	}
+
+	return schemaBlockDescriptionTemplate(s.Block, t, "")
+}
+
+// schemaBlockDescriptionTemplate resolves template placeholders in the
+// descriptions of the block and everything nested underneath it. The name
+// is the dot separated location of the block, or empty for the schema.
+func schemaBlockDescriptionTemplate(b *tfprotov5.SchemaBlock, t *fwserver.DescriptionTemplate, name string) error {
+	if b == nil {
+		return nil
+	}
+
+	description, err := t.Execute(b.Description)
+
+	if err != nil {
+		if name == "" {
+			return fmt.Errorf("schema description: %w", err)
+		}
+
+		return fmt.Errorf("block %q description: %w", name, err)
+	}
+
+	b.Description = description
+
+	for _, attribute := range b.Attributes {
+		err := schemaAttributeDescriptionTemplate(attribute, t, schemaDescriptionTemplateName(name, attribute.Name))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, block := range b.BlockTypes {
+		if block == nil {
+			continue
+		}
+
+		err := schemaBlockDescriptionTemplate(block.Block, t, schemaDescriptionTemplateName(name, block.TypeName))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// schemaAttributeDescriptionTemplate resolves template placeholders in the
+// description of the attribute.
+func schemaAttributeDescriptionTemplate(a *tfprotov5.SchemaAttribute, t *fwserver.DescriptionTemplate, name string) error {
+	if a == nil {
+		return nil
+	}
+
+	description, err := t.Execute(a.Description)
+
+	if err != nil {
+		return fmt.Errorf("attribute %q description: %w", name, err)
+	}
+
+	a.Description = description
+
+	return nil
+}
+
+// schemaDescriptionTemplateName returns the dot separated location of a
+// nested attribute or block for error messages.
+func schemaDescriptionTemplateName(parent string, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + "." + name
+}
@@ -0,0 +1,130 @@
+package toproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaDescriptionTemplate(t *testing.T) {
+	t.Parallel()
+
+	server := &fwserver.Server{
+		Provider: &testprovider.ProviderWithDescriptionTemplateData{
+			Provider: &testprovider.Provider{
+				MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+					resp.TypeName = "examplecloud"
+				},
+			},
+		},
+	}
+	serverResp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(context.Background(), &fwserver.GetProviderSchemaRequest{}, serverResp)
+
+	testSchema := func(attributeDescription string) *tfprotov5.Schema {
+		return &tfprotov5.Schema{
+			Block: &tfprotov5.SchemaBlock{
+				Attributes: []*tfprotov5.SchemaAttribute{
+					{
+						Name:        "attribute",
+						Description: attributeDescription,
+						Type:        tftypes.String,
+					},
+				},
+				BlockTypes: []*tfprotov5.SchemaNestedBlock{
+					{
+						TypeName: "block",
+						Block: &tfprotov5.SchemaBlock{
+							Description: "{{ .ProviderTypeName }} block",
+						},
+						Nesting: tfprotov5.SchemaNestedBlockNestingModeList,
+					},
+				},
+				Description: "{{ .ProviderTypeName }} schema",
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		schema      *tfprotov5.Schema
+		template    *fwserver.DescriptionTemplate
+		expected    *tfprotov5.Schema
+		expectedErr string
+	}{
+		"nil-schema": {
+			template: serverResp.DescriptionTemplate,
+		},
+		"nil-template": {
+			schema:   testSchema("{{ .ProviderTypeName }} attribute"),
+			expected: testSchema("{{ .ProviderTypeName }} attribute"),
+		},
+		"template": {
+			schema:   testSchema("{{ .ProviderTypeName }} attribute"),
+			template: serverResp.DescriptionTemplate,
+			expected: &tfprotov5.Schema{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:        "attribute",
+							Description: "examplecloud attribute",
+							Type:        tftypes.String,
+						},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							TypeName: "block",
+							Block: &tfprotov5.SchemaBlock{
+								Description: "examplecloud block",
+							},
+							Nesting: tfprotov5.SchemaNestedBlockNestingModeList,
+						},
+					},
+					Description: "examplecloud schema",
+				},
+			},
+		},
+		"error": {
+			schema:      testSchema("{{ .Missing }} attribute"),
+			template:    serverResp.DescriptionTemplate,
+			expectedErr: `attribute "attribute" description: template: description:1:3: executing "description" at <.Missing>: map has no entry for key "Missing"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := toproto5.SchemaDescriptionTemplate(testCase.schema, testCase.template)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(testCase.schema, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
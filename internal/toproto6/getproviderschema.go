@@ -25,6 +25,10 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 
 	protov6.Provider, err = Schema(ctx, fw.Provider)
 
+	if err == nil {
+		err = SchemaDescriptionTemplate(protov6.Provider, fw.DescriptionTemplate)
+	}
+
 	if err != nil {
 		protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
 			Severity: tfprotov6.DiagnosticSeverityError,
@@ -37,6 +41,10 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 
 	protov6.ProviderMeta, err = Schema(ctx, fw.ProviderMeta)
 
+	if err == nil {
+		err = SchemaDescriptionTemplate(protov6.ProviderMeta, fw.DescriptionTemplate)
+	}
+
 	if err != nil {
 		protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
 			Severity: tfprotov6.DiagnosticSeverityError,
@@ -50,6 +58,10 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 	for dataSourceType, dataSourceSchema := range fw.DataSourceSchemas {
 		protov6.DataSourceSchemas[dataSourceType], err = Schema(ctx, dataSourceSchema)
 
+		if err == nil {
+			err = SchemaDescriptionTemplate(protov6.DataSourceSchemas[dataSourceType], fw.DescriptionTemplate)
+		}
+
 		if err != nil {
 			protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
 				Severity: tfprotov6.DiagnosticSeverityError,
@@ -64,6 +76,10 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 	for resourceType, resourceSchema := range fw.ResourceSchemas {
 		protov6.ResourceSchemas[resourceType], err = Schema(ctx, resourceSchema)
 
+		if err == nil {
+			err = SchemaDescriptionTemplate(protov6.ResourceSchemas[resourceType], fw.DescriptionTemplate)
+		}
+
 		if err != nil {
 			protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
 				Severity: tfprotov6.DiagnosticSeverityError,
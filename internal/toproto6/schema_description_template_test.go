@@ -0,0 +1,158 @@
+package toproto6_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaDescriptionTemplate(t *testing.T) {
+	t.Parallel()
+
+	server := &fwserver.Server{
+		Provider: &testprovider.ProviderWithDescriptionTemplateData{
+			Provider: &testprovider.Provider{
+				MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+					resp.TypeName = "examplecloud"
+				},
+			},
+		},
+	}
+	serverResp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(context.Background(), &fwserver.GetProviderSchemaRequest{}, serverResp)
+
+	testSchema := func(attributeDescription string) *tfprotov6.Schema {
+		return &tfprotov6.Schema{
+			Block: &tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:        "attribute",
+						Description: attributeDescription,
+						Type:        tftypes.String,
+					},
+					{
+						Name:        "nested_attribute",
+						Description: "{{ .ProviderTypeName }} nested attribute",
+						NestedType: &tfprotov6.SchemaObject{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:        "sub_attribute",
+									Description: "{{ .ProviderTypeName }} sub attribute",
+									Type:        tftypes.String,
+								},
+							},
+							Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+						},
+					},
+				},
+				BlockTypes: []*tfprotov6.SchemaNestedBlock{
+					{
+						TypeName: "block",
+						Block: &tfprotov6.SchemaBlock{
+							Description: "{{ .ProviderTypeName }} block",
+						},
+						Nesting: tfprotov6.SchemaNestedBlockNestingModeList,
+					},
+				},
+				Description: "{{ .ProviderTypeName }} schema",
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		schema      *tfprotov6.Schema
+		template    *fwserver.DescriptionTemplate
+		expected    *tfprotov6.Schema
+		expectedErr string
+	}{
+		"nil-schema": {
+			template: serverResp.DescriptionTemplate,
+		},
+		"nil-template": {
+			schema:   testSchema("{{ .ProviderTypeName }} attribute"),
+			expected: testSchema("{{ .ProviderTypeName }} attribute"),
+		},
+		"template": {
+			schema:   testSchema("{{ .ProviderTypeName }} attribute"),
+			template: serverResp.DescriptionTemplate,
+			expected: &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:        "attribute",
+							Description: "examplecloud attribute",
+							Type:        tftypes.String,
+						},
+						{
+							Name:        "nested_attribute",
+							Description: "examplecloud nested attribute",
+							NestedType: &tfprotov6.SchemaObject{
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:        "sub_attribute",
+										Description: "examplecloud sub attribute",
+										Type:        tftypes.String,
+									},
+								},
+								Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+							},
+						},
+					},
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						{
+							TypeName: "block",
+							Block: &tfprotov6.SchemaBlock{
+								Description: "examplecloud block",
+							},
+							Nesting: tfprotov6.SchemaNestedBlockNestingModeList,
+						},
+					},
+					Description: "examplecloud schema",
+				},
+			},
+		},
+		"error": {
+			schema:      testSchema("{{ .Missing }} attribute"),
+			template:    serverResp.DescriptionTemplate,
+			expectedErr: `attribute "attribute" description: template: description:1:3: executing "description" at <.Missing>: map has no entry for key "Missing"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := toproto6.SchemaDescriptionTemplate(testCase.schema, testCase.template)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(testCase.schema, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package validatordiag
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ErrorsToWarnings returns the given diagnostics with any error severity
+// diagnostics converted into warning severity diagnostics. The summary,
+// detail, and path information of each diagnostic are preserved.
+func ErrorsToWarnings(diags diag.Diagnostics) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	for _, d := range diags {
+		if d == nil {
+			continue
+		}
+
+		if d.Severity() != diag.SeverityError {
+			result.Append(d)
+
+			continue
+		}
+
+		if dWithPath, ok := d.(diag.DiagnosticWithPath); ok {
+			result.AddAttributeWarning(dWithPath.Path(), d.Summary(), d.Detail())
+
+			continue
+		}
+
+		result.AddWarning(d.Summary(), d.Detail())
+	}
+
+	return result
+}
@@ -0,0 +1,73 @@
+package validatordiag_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestErrorsToWarnings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		expected diag.Diagnostics
+	}{
+		"nil": {
+			diags:    nil,
+			expected: nil,
+		},
+		"error": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+			},
+		},
+		"error-with-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "test summary", "test detail"),
+			},
+		},
+		"warning": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "test summary", "test detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "test summary", "test detail"),
+			},
+		},
+		"error-and-warning-deduplicated": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+				diag.NewErrorDiagnostic("other summary", "other detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+				diag.NewWarningDiagnostic("other summary", "other detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatordiag.ErrorsToWarnings(testCase.diags)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+// Package validatordiag contains diagnostic helpers for the framework
+// schema validator implementations.
+package validatordiag
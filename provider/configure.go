@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -42,4 +44,17 @@ type ConfigureResponse struct {
 	// to [resource.ConfigureRequest.ProviderData] for each Resource type
 	// that implements the Configure method.
 	ResourceData any
+
+	// SkipVerifyConfiguration prevents the framework from calling the
+	// ProviderWithVerifyConfiguration interface VerifyConfiguration method,
+	// if implemented. Providers can set this from a configuration attribute
+	// to allow practitioners to opt out of verification, such as when the
+	// API is not reachable while planning.
+	SkipVerifyConfiguration bool
+
+	// VerifyConfigurationTimeout is the duration after which the
+	// ProviderWithVerifyConfiguration interface VerifyConfiguration method
+	// context is cancelled. If zero, DefaultVerifyConfigurationTimeout is
+	// used.
+	VerifyConfigurationTimeout time.Duration
 }
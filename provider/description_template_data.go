@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DescriptionTemplateDataRequest represents a request for the Provider to
+// return the data available to schema description templates. An instance of
+// this request struct is supplied as an argument to the
+// ProviderWithDescriptionTemplateData interface DescriptionTemplateData
+// method.
+type DescriptionTemplateDataRequest struct{}
+
+// DescriptionTemplateDataResponse represents a response to a
+// DescriptionTemplateDataRequest. An instance of this response struct is
+// supplied as an argument to the ProviderWithDescriptionTemplateData
+// interface DescriptionTemplateData method.
+type DescriptionTemplateDataResponse struct {
+	// Data is the provider-defined data available to schema description
+	// templates, such as a documentation base URL under a "DocsBaseURL" key,
+	// which is referenced in descriptions as {{ .DocsBaseURL }}.
+	//
+	// The "ProviderTypeName" key is automatically set to the provider type
+	// name from the Metadata method, unless it is already present.
+	Data map[string]any
+
+	// Diagnostics report errors or warnings related to the template data.
+	// An empty slice indicates success, with no warnings or errors
+	// generated.
+	Diagnostics diag.Diagnostics
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// EventType is the kind of framework lifecycle event.
+type EventType string
+
+const (
+	// EventTypeRPCStart is sent when the framework begins handling an RPC
+	// from Terraform.
+	EventTypeRPCStart EventType = "rpc_start"
+
+	// EventTypeRPCFinish is sent when the framework finishes handling an RPC
+	// from Terraform, immediately before the response is returned. The
+	// Event Diagnostics and Duration fields are populated.
+	EventTypeRPCFinish EventType = "rpc_finish"
+
+	// EventTypeValidationFailure is sent when provider, resource, or data
+	// source configuration validation returned error diagnostics. The Event
+	// Diagnostics field is populated.
+	EventTypeValidationFailure EventType = "validation_failure"
+
+	// EventTypeStateUpgrade is sent after a provider-defined resource
+	// StateUpgrader was executed. The Event Diagnostics and PriorStateVersion
+	// fields are populated.
+	EventTypeStateUpgrade EventType = "state_upgrade"
+)
+
+// Event is a framework lifecycle event, which is sent to the functions
+// returned by the ProviderWithEventSubscribers interface EventSubscribers
+// method.
+type Event struct {
+	// Type is the kind of event.
+	Type EventType
+
+	// RPC is the name of the RPC being handled, such as ReadResource.
+	RPC string
+
+	// TypeName is the data source or resource type name of the RPC, such as
+	// examplecloud_thing. This is empty for provider level RPCs.
+	TypeName string
+
+	// Diagnostics are the diagnostics associated with the event, if any.
+	Diagnostics diag.Diagnostics
+
+	// Duration is the time spent handling the RPC, for EventTypeRPCFinish
+	// events.
+	Duration time.Duration
+
+	// PriorStateVersion is the resource state version which was upgraded,
+	// for EventTypeStateUpgrade events.
+	PriorStateVersion int64
+}
+
+// EventSubscriber is a function which receives framework lifecycle events.
+// Events are sent synchronously while the framework is handling RPCs, so
+// subscribers should return quickly. Terraform may send RPCs concurrently, so
+// subscribers must be safe for concurrent use.
+type EventSubscriber func(context.Context, Event)
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// FormatDiagnosticRequest represents a request for the Provider to format a
+// diagnostic before it is returned to Terraform. An instance of this request
+// struct is supplied as an argument to the ProviderWithFormatDiagnostic
+// interface FormatDiagnostic method.
+type FormatDiagnosticRequest struct {
+	// Diagnostic is the outgoing diagnostic, as generated by the framework or
+	// the provider.
+	Diagnostic diag.Diagnostic
+}
+
+// FormatDiagnosticResponse represents a response to a
+// FormatDiagnosticRequest. An instance of this response struct is supplied as
+// an argument to the ProviderWithFormatDiagnostic interface FormatDiagnostic
+// method.
+//
+// The Summary and Detail fields are pre-populated from the request
+// Diagnostic. The severity, attribute path, and code of the diagnostic are
+// always preserved. For diagnostics created with diag.WithCode, the Detail
+// field excludes the code line, which is appended again after formatting.
+type FormatDiagnosticResponse struct {
+	// Summary is the short description of the diagnostic that will be
+	// returned to Terraform.
+	Summary string
+
+	// Detail is the long description of the diagnostic that will be
+	// returned to Terraform.
+	Detail string
+}
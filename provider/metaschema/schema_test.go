@@ -354,6 +354,53 @@ func TestSchemaAttributeAtTerraformPath(t *testing.T) {
 	}
 }
 
+func TestSchemaAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   metaschema.Schema
+		expected path.Expressions
+	}{
+		"no-attributes": {
+			schema:   metaschema.Schema{},
+			expected: nil,
+		},
+		"attributes": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"nested": metaschema.ListNestedAttribute{
+						NestedObject: metaschema.NestedAttributeObject{
+							Attributes: map[string]metaschema.Attribute{
+								"testattr": metaschema.StringAttribute{},
+							},
+						},
+					},
+					"testattr": metaschema.StringAttribute{},
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("nested"),
+				path.MatchRoot("nested").AtAnyListIndex().AtName("testattr"),
+				path.MatchRoot("testattr"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.AttributePaths()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSchemaGetAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -821,7 +868,9 @@ func TestSchemaValidate(t *testing.T) {
 		"attribute-using-invalid-field-name": {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
-					"^": metaschema.StringAttribute{},
+					"^": metaschema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -836,8 +885,11 @@ func TestSchemaValidate(t *testing.T) {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
 					"single_nested_attribute": metaschema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]metaschema.Attribute{
-							"^": metaschema.BoolAttribute{},
+							"^": metaschema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -854,8 +906,11 @@ func TestSchemaValidate(t *testing.T) {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
 					"$": metaschema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]metaschema.Attribute{
-							"^": metaschema.BoolAttribute{},
+							"^": metaschema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -877,9 +932,12 @@ func TestSchemaValidate(t *testing.T) {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
 					"list_nested_attribute": metaschema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: metaschema.NestedAttributeObject{
 							Attributes: map[string]metaschema.Attribute{
-								"^": metaschema.Int64Attribute{},
+								"^": metaschema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -897,9 +955,12 @@ func TestSchemaValidate(t *testing.T) {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
 					"$": metaschema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: metaschema.NestedAttributeObject{
 							Attributes: map[string]metaschema.Attribute{
-								"^": metaschema.Int64Attribute{},
+								"^": metaschema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -14,6 +14,12 @@ import (
 //   - Validation: Schema-based or entire configuration
 //     via ProviderWithConfigValidators or ProviderWithValidateConfig.
 //   - Meta Schema: ProviderWithMetaSchema
+//   - Diagnostic Formatting: ProviderWithFormatDiagnostic
+//   - Description Templating: ProviderWithDescriptionTemplateData
+//   - Lifecycle Events: ProviderWithEventSubscribers
+//   - Undefined State Attributes: ProviderWithUndefinedAttributePolicy
+//   - Configuration Verification: ProviderWithVerifyConfiguration
+//   - Null Computed Attribute Check: ProviderWithNullComputedAttributeCheck
 type Provider interface {
 	// Metadata should return the metadata for the provider, such as
 	// a type name and version data.
@@ -65,6 +71,52 @@ type ProviderWithConfigValidators interface {
 	ConfigValidators(context.Context) []ConfigValidator
 }
 
+// ProviderWithDescriptionTemplateData is an interface type that extends
+// Provider to enable text/template placeholders, such as
+// {{ .ProviderTypeName }}, in the descriptions and markdown descriptions of
+// all provider, resource, and data source schemas. This keeps common
+// description content, such as documentation URLs, consistent across many
+// schemas.
+//
+// Descriptions are resolved once when the framework returns the schemas to
+// Terraform. Referencing a missing data key or an invalid template returns
+// an error diagnostic. Descriptions are not templated unless the provider
+// implements this interface.
+type ProviderWithDescriptionTemplateData interface {
+	Provider
+
+	// DescriptionTemplateData should return the data available to
+	// schema description templates.
+	DescriptionTemplateData(context.Context, DescriptionTemplateDataRequest, *DescriptionTemplateDataResponse)
+}
+
+// ProviderWithEventSubscribers is an interface type that extends Provider to
+// include subscribers for framework lifecycle events, such as the start and
+// finish of each RPC. This is intended for feeding provider-defined telemetry
+// systems without wrapping every provider, resource, and data source method.
+type ProviderWithEventSubscribers interface {
+	Provider
+
+	// EventSubscribers should return the functions which receive every
+	// framework lifecycle event. It is called once per provider server.
+	EventSubscribers(context.Context) []EventSubscriber
+}
+
+// ProviderWithFormatDiagnostic is an interface type that extends Provider to
+// include transformation of all outgoing diagnostics, such as appending
+// support URLs or error codes, or translating messages.
+//
+// The formatting is applied centrally by the framework to every diagnostic
+// immediately before the response is returned to Terraform, including
+// diagnostics generated by the framework itself.
+type ProviderWithFormatDiagnostic interface {
+	Provider
+
+	// FormatDiagnostic should update the response Summary and Detail, if
+	// necessary, for the given request Diagnostic.
+	FormatDiagnostic(context.Context, FormatDiagnosticRequest, *FormatDiagnosticResponse)
+}
+
 // ProviderWithMetaSchema is a provider with a provider meta schema, which
 // is configured by practitioners via the provider_meta configuration block
 // and the configuration data is included with certain data source and resource
@@ -86,6 +138,51 @@ type ProviderWithMetaSchema interface {
 	MetaSchema(context.Context, MetaSchemaRequest, *MetaSchemaResponse)
 }
 
+// ProviderWithNullComputedAttributeCheck is an interface type that extends
+// Provider to check resource computed attributes after apply. When enabled,
+// the framework returns a warning diagnostic for each computed attribute
+// which is null after a resource is created or updated, which is typically
+// caused by a missing value from the remote system response. Attributes
+// which may legitimately be null can set the AllowNullAfterApply field.
+type ProviderWithNullComputedAttributeCheck interface {
+	Provider
+
+	// NullComputedAttributeCheck should return true to enable the check.
+	NullComputedAttributeCheck(context.Context) bool
+}
+
+// ProviderWithUndefinedAttributePolicy is an interface type that extends
+// Provider to control the handling of prior resource state attributes which
+// are not defined in the resource schema. This can improve resilience during
+// rollouts where different provider versions manage the same resources.
+type ProviderWithUndefinedAttributePolicy interface {
+	Provider
+
+	// UndefinedAttributePolicy should return the policy for prior resource
+	// state attributes which are not defined in the resource schema.
+	UndefinedAttributePolicy(context.Context) UndefinedAttributePolicy
+}
+
+// ProviderWithVerifyConfiguration is an interface type that extends Provider
+// to include verification of the provider configuration, such as calling an
+// API endpoint to check connectivity and authentication, immediately after
+// the Configure method returns without errors. This surfaces
+// misconfiguration during provider configuration rather than during the
+// first data source or resource operation.
+//
+// The VerifyConfiguration method context is cancelled after the
+// ConfigureResponse type VerifyConfigurationTimeout field duration, or
+// DefaultVerifyConfigurationTimeout if not set. Verification is skipped if
+// the Configure method sets the ConfigureResponse type
+// SkipVerifyConfiguration field, such as from a provider-defined
+// configuration attribute.
+type ProviderWithVerifyConfiguration interface {
+	Provider
+
+	// VerifyConfiguration performs the verification.
+	VerifyConfiguration(context.Context, VerifyConfigurationRequest, *VerifyConfigurationResponse)
+}
+
 // ProviderWithValidateConfig is an interface type that extends Provider to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
@@ -0,0 +1,4 @@
+// Package providertest contains helpers for unit testing provider
+// implementations without Terraform, such as smoke testing that the provider,
+// resource, and data source schemas are registered and usable.
+package providertest
@@ -0,0 +1,160 @@
+package providertest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SmokeTest runs a minimal in-process check of the provider, as Terraform
+// would before any configuration is applied, and returns any diagnostics.
+// This catches common registration and schema bugs, such as duplicate or
+// invalid type names and invalid schema definitions, without writing tests
+// for each resource and data source. The following are run in order:
+//
+//   - The GetProviderSchema RPC, which calls the Metadata and Schema methods
+//     of the provider and of every resource and data source.
+//   - The ValidateProviderConfig RPC, with a configuration where every
+//     top level attribute and block is null, except required attributes,
+//     which are unknown as if referencing values not known until apply.
+//   - Decoding a null object value and an object value where every top level
+//     attribute and block is null, using each resource and data source
+//     schema type, to verify any custom types can handle null values.
+//
+// Provider validation logic which requires known configuration values may
+// return diagnostics for this configuration.
+func SmokeTest(ctx context.Context, p provider.Provider) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	server := &fwserver.Server{
+		Provider: p,
+	}
+
+	schemaResp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(ctx, &fwserver.GetProviderSchemaRequest{}, schemaResp)
+
+	diags.Append(schemaResp.Diagnostics...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	providerConfig, err := nullAttributesValue(ctx, schemaResp.Provider, true)
+
+	if err != nil {
+		diags.AddError(
+			"Provider Schema Decode Error",
+			"The provider schema could not decode a configuration with all null values. "+
+				"This is always an error in the provider.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	validateReq := &fwserver.ValidateProviderConfigRequest{
+		Config: &tfsdk.Config{
+			Raw:    providerConfig,
+			Schema: schemaResp.Provider,
+		},
+	}
+	validateResp := &fwserver.ValidateProviderConfigResponse{}
+
+	server.ValidateProviderConfig(ctx, validateReq, validateResp)
+
+	diags.Append(validateResp.Diagnostics...)
+
+	for _, typeName := range sortedTypeNames(schemaResp.ResourceSchemas) {
+		diags.Append(decodeNullValues(ctx, "Resource", typeName, schemaResp.ResourceSchemas[typeName])...)
+	}
+
+	for _, typeName := range sortedTypeNames(schemaResp.DataSourceSchemas) {
+		diags.Append(decodeNullValues(ctx, "Data Source", typeName, schemaResp.DataSourceSchemas[typeName])...)
+	}
+
+	return diags
+}
+
+// decodeNullValues returns an error diagnostic for each null value the schema
+// type cannot decode. The kind is used in diagnostics, such as "Resource".
+func decodeNullValues(ctx context.Context, kind string, typeName string, s fwschema.Schema) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schemaType := s.Type()
+
+	if _, err := schemaType.ValueFromTerraform(ctx, tftypes.NewValue(schemaType.TerraformType(ctx), nil)); err != nil {
+		diags.AddError(
+			kind+" Schema Decode Error",
+			"The "+typeName+" schema could not decode a null value. "+
+				"This is always an error in the provider.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	value, err := nullAttributesValue(ctx, s, false)
+
+	if err == nil {
+		_, err = schemaType.ValueFromTerraform(ctx, value)
+	}
+
+	if err != nil {
+		diags.AddError(
+			kind+" Schema Decode Error",
+			"The "+typeName+" schema could not decode a value with all null attributes. "+
+				"This is always an error in the provider.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// nullAttributesValue returns an object value of the schema type where every
+// top level attribute and block is null. If unknownRequired is true, required
+// attributes are instead unknown, so configuration validation does not raise
+// missing required attribute errors.
+func nullAttributesValue(ctx context.Context, s fwschema.Schema, unknownRequired bool) (tftypes.Value, error) {
+	objectType, ok := s.Type().TerraformType(ctx).(tftypes.Object)
+
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("expected schema type tftypes.Object, got: %T", s.Type().TerraformType(ctx))
+	}
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+
+		if attribute, ok := s.GetAttributes()[name]; ok && unknownRequired && attribute.IsRequired() {
+			attributes[name] = tftypes.NewValue(attributeType, tftypes.UnknownValue)
+		}
+	}
+
+	if err := tftypes.ValidateValue(objectType, attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return tftypes.NewValue(objectType, attributes), nil
+}
+
+// sortedTypeNames returns the type names of the schemas in sorted order, so
+// diagnostics are consistent across runs.
+func sortedTypeNames(schemas map[string]fwschema.Schema) []string {
+	typeNames := make([]string, 0, len(schemas))
+
+	for typeName := range schemas {
+		typeNames = append(typeNames, typeName)
+	}
+
+	sort.Strings(typeNames)
+
+	return typeNames
+}
@@ -0,0 +1,198 @@
+package providertest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/providertest"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// nullErrorStringType is a custom string type which incorrectly returns an
+// error for null values.
+type nullErrorStringType struct {
+	basetypes.StringType
+}
+
+func (t nullErrorStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.IsNull() {
+		return nil, errors.New("intentional null value error")
+	}
+
+	return t.StringType.ValueFromTerraform(ctx, in)
+}
+
+func TestSmokeTest(t *testing.T) {
+	t.Parallel()
+
+	testProviderSchemaMethod := func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+		resp.Schema = providerschema.Schema{
+			Attributes: map[string]providerschema.Attribute{
+				"endpoint": providerschema.StringAttribute{
+					Required: true,
+				},
+			},
+		}
+	}
+
+	testResource := func(typeName string, attributes map[string]resourceschema.Attribute) func() resource.Resource {
+		return func() resource.Resource {
+			return &testprovider.Resource{
+				MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+					resp.TypeName = req.ProviderTypeName + "_" + typeName
+				},
+				SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+					resp.Schema = resourceschema.Schema{
+						Attributes: attributes,
+					}
+				},
+			}
+		}
+	}
+
+	testDataSource := func(typeName string) func() datasource.DataSource {
+		return func() datasource.DataSource {
+			return &testprovider.DataSource{
+				MetadataMethod: func(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+					resp.TypeName = req.ProviderTypeName + "_" + typeName
+				},
+				SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+					resp.Schema = datasourceschema.Schema{
+						Attributes: map[string]datasourceschema.Attribute{
+							"id": datasourceschema.StringAttribute{
+								Computed: true,
+							},
+						},
+						Blocks: map[string]datasourceschema.Block{
+							"filter": datasourceschema.ListNestedBlock{
+								NestedObject: datasourceschema.NestedBlockObject{
+									Attributes: map[string]datasourceschema.Attribute{
+										"name": datasourceschema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					}
+				},
+			}
+		}
+	}
+
+	testValidResource := testResource("thing", map[string]resourceschema.Attribute{
+		"id": resourceschema.StringAttribute{
+			Computed: true,
+		},
+		"tags": resourceschema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+	})
+
+	testCases := map[string]struct {
+		provider      provider.Provider
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			provider: &testprovider.Provider{
+				MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+					resp.TypeName = "examplecloud"
+				},
+				SchemaMethod: testProviderSchemaMethod,
+				DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+					return []func() datasource.DataSource{
+						testDataSource("thing"),
+					}
+				},
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						testValidResource,
+					}
+				},
+			},
+		},
+		"provider-schema-error": {
+			provider: &testprovider.Provider{
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+					resp.Diagnostics.AddError("Test Error", "Test schema error.")
+				},
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						testValidResource,
+					}
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Test Error", "Test schema error."),
+			},
+		},
+		"provider-validate-config-error": {
+			provider: &testprovider.ProviderWithValidateConfig{
+				Provider: &testprovider.Provider{
+					SchemaMethod: testProviderSchemaMethod,
+				},
+				ValidateConfigMethod: func(_ context.Context, _ provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+					resp.Diagnostics.AddError("Test Error", "Test validation error.")
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Test Error", "Test validation error."),
+			},
+		},
+		"resource-decode-error": {
+			provider: &testprovider.Provider{
+				MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+					resp.TypeName = "examplecloud"
+				},
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						testValidResource,
+						testResource("invalid", map[string]resourceschema.Attribute{
+							"invalid": resourceschema.StringAttribute{
+								CustomType: nullErrorStringType{},
+								Optional:   true,
+							},
+						}),
+					}
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Schema Decode Error",
+					"The examplecloud_invalid schema could not decode a value with all null attributes. "+
+						"This is always an error in the provider.\n\n"+
+						"Error: intentional null value error",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := providertest.SmokeTest(context.Background(), testCase.provider)
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SecretResolver resolves provider configuration string attribute values from
+// an external secret source, such as a secret manager, before the provider
+// Configure method is called. Register a SecretResolver with the
+// providerserver.ServeOpts type SecretResolver field.
+//
+// The resolver is called for every known, non-null string attribute value in
+// the provider configuration, including nested attributes. Resolved values
+// only replace the ConfigureRequest Config data, so they are never saved
+// into resource state, and the framework does not log configuration values.
+type SecretResolver interface {
+	// ResolveSecret should set the response Value field if the request
+	// Reference is a reference to a secret, such as a value with a
+	// provider-defined prefix. Leaving the Value field null keeps the
+	// configured value.
+	ResolveSecret(context.Context, ResolveSecretRequest, *ResolveSecretResponse)
+}
+
+// ResolveSecretRequest is the request for the SecretResolver interface
+// ResolveSecret method.
+type ResolveSecretRequest struct {
+	// Path is the path of the provider configuration attribute.
+	Path path.Path
+
+	// Reference is the configured value of the attribute.
+	Reference string
+}
+
+// ResolveSecretResponse is the response for the SecretResolver interface
+// ResolveSecret method.
+type ResolveSecretResponse struct {
+	// Value is the resolved secret value, which replaces the configured value
+	// in the ConfigureRequest Config. If null or unknown, the configured
+	// value is kept.
+	Value types.String
+
+	// Diagnostics report errors or warnings related to resolving the secret.
+	// Error diagnostics prevent the provider Configure method from being
+	// called.
+	Diagnostics diag.Diagnostics
+}
@@ -0,0 +1,26 @@
+package provider
+
+// UndefinedAttributePolicy controls the framework handling of prior resource
+// state data which contains attributes that are not defined in the resource
+// schema, such as after downgrading the provider to a version where the
+// attributes were not yet added.
+//
+// Only prior resource state data sent by Terraform during the
+// UpgradeResourceState RPC is affected. Terraform always encodes all other
+// configuration, plan, and state data with the current schema.
+type UndefinedAttributePolicy uint8
+
+const (
+	// UndefinedAttributePolicyIgnore silently removes undefined attributes
+	// from the prior resource state. This is the default policy.
+	UndefinedAttributePolicyIgnore UndefinedAttributePolicy = 0
+
+	// UndefinedAttributePolicyWarn removes undefined attributes from the
+	// prior resource state and returns a warning diagnostic, which includes
+	// the removed attribute paths.
+	UndefinedAttributePolicyWarn UndefinedAttributePolicy = 1
+
+	// UndefinedAttributePolicyError returns an error diagnostic if the prior
+	// resource state contains undefined attributes.
+	UndefinedAttributePolicyError UndefinedAttributePolicy = 2
+)
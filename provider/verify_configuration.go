@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// DefaultVerifyConfigurationTimeout is the duration after which the
+// ProviderWithVerifyConfiguration interface VerifyConfiguration method
+// context is cancelled, if the ConfigureResponse type
+// VerifyConfigurationTimeout field is not set.
+const DefaultVerifyConfigurationTimeout = 30 * time.Second
+
+// VerifyConfigurationRequest represents a request to verify the provider
+// configuration, such as API connectivity and authentication, after the
+// provider Configure method returned without errors. An instance of this
+// request struct is supplied as an argument to the provider's
+// VerifyConfiguration function.
+type VerifyConfigurationRequest struct {
+	// Config is the configuration the user supplied for the provider, which
+	// is the same as the ConfigureRequest Config.
+	Config tfsdk.Config
+
+	// DataSourceData is the ConfigureResponse DataSourceData, such as an
+	// API client, set by the provider Configure method.
+	DataSourceData any
+
+	// ResourceData is the ConfigureResponse ResourceData, such as an API
+	// client, set by the provider Configure method.
+	ResourceData any
+}
+
+// VerifyConfigurationResponse represents a response to a
+// VerifyConfigurationRequest. An instance of this response struct is
+// supplied as an argument to the provider's VerifyConfiguration function, in
+// which the provider should set values on the VerifyConfigurationResponse as
+// appropriate.
+type VerifyConfigurationResponse struct {
+	// Diagnostics report errors or warnings related to verifying the
+	// provider configuration. Attribute diagnostics, such as those created
+	// by the Diagnostics type AddAttributeError method, should be used to
+	// name the misconfigured provider configuration attributes. An empty
+	// slice indicates success, with no warnings or errors generated.
+	Diagnostics diag.Diagnostics
+}
@@ -0,0 +1,55 @@
+package providerserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+)
+
+// RPCInfo describes an incoming RPC for ContextDecorator functions.
+type RPCInfo struct {
+	// Name is the protocol RPC name, such as ReadResource or
+	// ApplyResourceChange.
+	Name string
+
+	// TypeName is the resource or data source type name of the RPC, such as
+	// examplecloud_thing. It is empty for provider-level RPCs, such as
+	// GetProviderSchema and ConfigureProvider.
+	TypeName string
+}
+
+// ContextDecorator returns a context derived from the context of an incoming
+// RPC, such as a context with a logger field, tracing span, tenant
+// identifier, or feature flag value. The returned context is passed to the
+// provider, resource, and data source methods handling the RPC.
+//
+// Decorators are called concurrently when Terraform sends concurrent RPCs.
+// Returning nil leaves the context unchanged.
+type ContextDecorator func(context.Context, RPCInfo) context.Context
+
+// fwserverContextDecorators returns the framework server equivalent of the
+// decorators.
+func fwserverContextDecorators(decorators []ContextDecorator) fwserver.ContextDecorators {
+	if len(decorators) == 0 {
+		return nil
+	}
+
+	result := make(fwserver.ContextDecorators, 0, len(decorators))
+
+	for _, decorator := range decorators {
+		if decorator == nil {
+			continue
+		}
+
+		decorator := decorator
+
+		result = append(result, func(ctx context.Context, info fwserver.RPCInfo) context.Context {
+			return decorator(ctx, RPCInfo{
+				Name:     info.Name,
+				TypeName: info.TypeName,
+			})
+		})
+	}
+
+	return result
+}
@@ -18,13 +18,19 @@ import (
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server.Serve()
 // function and various terraform-plugin-mux functions.
+//
+// The servers do not have any of the ServeOpts functionality, such as
+// ContextDecorators or PrivateStateCodec. Use NewProtocol5WithServeOpts to
+// configure it.
 func NewProtocol5(p provider.Provider) func() tfprotov5.ProviderServer {
 	return func() tfprotov5.ProviderServer {
-		return &proto5server.Server{
+		server := &proto5server.Server{
 			FrameworkServer: fwserver.Server{
 				Provider: p,
 			},
 		}
+
+		return withFormatDiagnosticsProtocol5(server, server)
 	}
 }
 
@@ -32,14 +38,20 @@ func NewProtocol5(p provider.Provider) func() tfprotov5.ProviderServer {
 // implementation based on the given Provider and suitable for usage with
 // github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource.TestCase.ProtoV5ProviderFactories.
 //
+// The servers do not have any of the ServeOpts functionality, such as
+// ContextDecorators or PrivateStateCodec. Use
+// NewProtocol5WithServeOptsAndError to configure it.
+//
 // The error return is not currently used, but it may be in the future.
 func NewProtocol5WithError(p provider.Provider) func() (tfprotov5.ProviderServer, error) {
 	return func() (tfprotov5.ProviderServer, error) {
-		return &proto5server.Server{
+		server := &proto5server.Server{
 			FrameworkServer: fwserver.Server{
 				Provider: p,
 			},
-		}, nil
+		}
+
+		return withFormatDiagnosticsProtocol5(server, server), nil
 	}
 }
 
@@ -47,13 +59,19 @@ func NewProtocol5WithError(p provider.Provider) func() (tfprotov5.ProviderServer
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server.Serve()
 // function and various terraform-plugin-mux functions.
+//
+// The servers do not have any of the ServeOpts functionality, such as
+// ContextDecorators or PrivateStateCodec. Use NewProtocol6WithServeOpts to
+// configure it.
 func NewProtocol6(p provider.Provider) func() tfprotov6.ProviderServer {
 	return func() tfprotov6.ProviderServer {
-		return &proto6server.Server{
+		server := &proto6server.Server{
 			FrameworkServer: fwserver.Server{
 				Provider: p,
 			},
 		}
+
+		return withFormatDiagnosticsProtocol6(server, server)
 	}
 }
 
@@ -61,14 +79,64 @@ func NewProtocol6(p provider.Provider) func() tfprotov6.ProviderServer {
 // implementation based on the given Provider and suitable for usage with
 // github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource.TestCase.ProtoV6ProviderFactories.
 //
+// The servers do not have any of the ServeOpts functionality, such as
+// ContextDecorators or PrivateStateCodec. Use
+// NewProtocol6WithServeOptsAndError to configure it.
+//
 // The error return is not currently used, but it may be in the future.
 func NewProtocol6WithError(p provider.Provider) func() (tfprotov6.ProviderServer, error) {
 	return func() (tfprotov6.ProviderServer, error) {
-		return &proto6server.Server{
+		server := &proto6server.Server{
 			FrameworkServer: fwserver.Server{
 				Provider: p,
 			},
-		}, nil
+		}
+
+		return withFormatDiagnosticsProtocol6(server, server), nil
+	}
+}
+
+// NewProtocol5WithServeOpts is equivalent to NewProtocol5, except the returned
+// servers are configured by the given ServeOpts the same as servers started
+// by Serve, such as applying ContextDecorators and PrivateStateCodec. The
+// Address and ProtocolVersion fields are ignored and the Debug field only
+// determines whether RPCBudget is enabled.
+func NewProtocol5WithServeOpts(p provider.Provider, opts ServeOpts) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return newProtocol5ProviderServer(p, opts)
+	}
+}
+
+// NewProtocol5WithServeOptsAndError is equivalent to NewProtocol5WithError,
+// except the returned servers are configured by the given ServeOpts. Refer to
+// NewProtocol5WithServeOpts for details.
+//
+// The error return is not currently used, but it may be in the future.
+func NewProtocol5WithServeOptsAndError(p provider.Provider, opts ServeOpts) func() (tfprotov5.ProviderServer, error) {
+	return func() (tfprotov5.ProviderServer, error) {
+		return newProtocol5ProviderServer(p, opts), nil
+	}
+}
+
+// NewProtocol6WithServeOpts is equivalent to NewProtocol6, except the returned
+// servers are configured by the given ServeOpts the same as servers started
+// by Serve, such as applying ContextDecorators and PrivateStateCodec. The
+// Address and ProtocolVersion fields are ignored and the Debug field only
+// determines whether RPCBudget is enabled.
+func NewProtocol6WithServeOpts(p provider.Provider, opts ServeOpts) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		return newProtocol6ProviderServer(p, opts)
+	}
+}
+
+// NewProtocol6WithServeOptsAndError is equivalent to NewProtocol6WithError,
+// except the returned servers are configured by the given ServeOpts. Refer to
+// NewProtocol6WithServeOpts for details.
+//
+// The error return is not currently used, but it may be in the future.
+func NewProtocol6WithServeOptsAndError(p provider.Provider, opts ServeOpts) func() (tfprotov6.ProviderServer, error) {
+	return func() (tfprotov6.ProviderServer, error) {
+		return newProtocol6ProviderServer(p, opts), nil
 	}
 }
 
@@ -91,13 +159,7 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 		return tf5server.Serve(
 			opts.Address,
 			func() tfprotov5.ProviderServer {
-				provider := providerFunc()
-
-				return &proto5server.Server{
-					FrameworkServer: fwserver.Server{
-						Provider: provider,
-					},
-				}
+				return newProtocol5ProviderServer(providerFunc(), opts)
 			},
 			tf5serverOpts...,
 		)
@@ -111,15 +173,107 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 		return tf6server.Serve(
 			opts.Address,
 			func() tfprotov6.ProviderServer {
-				provider := providerFunc()
-
-				return &proto6server.Server{
-					FrameworkServer: fwserver.Server{
-						Provider: provider,
-					},
-				}
+				return newProtocol6ProviderServer(providerFunc(), opts)
 			},
 			tf6serverOpts...,
 		)
 	}
 }
+
+// newProtocol5ProviderServer returns a protocol version 5 ProviderServer for
+// the provider, wrapped as configured by the ServeOpts.
+func newProtocol5ProviderServer(p provider.Provider, opts ServeOpts) tfprotov5.ProviderServer {
+	contextDecorators := fwserverContextDecorators(opts.ContextDecorators)
+	rpcBudget := opts.RPCBudget.fwserverRPCBudget()
+	valueLimits := opts.ValueLimits.fwserverValueLimits()
+
+	server := &proto5server.Server{
+		FrameworkServer: fwserver.Server{
+			Provider:          p,
+			PrivateStateCodec: opts.PrivateStateCodec,
+			SecretResolver:    opts.SecretResolver,
+			SupportBundleDir:  opts.SupportBundleDir,
+		},
+	}
+
+	var providerServer tfprotov5.ProviderServer = server
+
+	if opts.SupportBundleDir != "" {
+		providerServer = proto5server.NewSupportBundleServer(server)
+	}
+
+	if valueLimits.Enabled() {
+		providerServer = proto5server.NewValueLimitsServer(providerServer, valueLimits)
+	}
+
+	if opts.Debug && rpcBudget.Enabled() {
+		providerServer = proto5server.NewRPCBudgetServer(providerServer, rpcBudget)
+	}
+
+	if len(contextDecorators) > 0 {
+		providerServer = proto5server.NewContextDecoratorServer(providerServer, contextDecorators)
+	}
+
+	return withFormatDiagnosticsProtocol5(providerServer, server)
+}
+
+// newProtocol6ProviderServer returns a protocol version 6 ProviderServer for
+// the provider, wrapped as configured by the ServeOpts.
+func newProtocol6ProviderServer(p provider.Provider, opts ServeOpts) tfprotov6.ProviderServer {
+	contextDecorators := fwserverContextDecorators(opts.ContextDecorators)
+	rpcBudget := opts.RPCBudget.fwserverRPCBudget()
+	valueLimits := opts.ValueLimits.fwserverValueLimits()
+
+	server := &proto6server.Server{
+		FrameworkServer: fwserver.Server{
+			Provider:          p,
+			PrivateStateCodec: opts.PrivateStateCodec,
+			SecretResolver:    opts.SecretResolver,
+			SupportBundleDir:  opts.SupportBundleDir,
+		},
+	}
+
+	var providerServer tfprotov6.ProviderServer = server
+
+	if opts.SupportBundleDir != "" {
+		providerServer = proto6server.NewSupportBundleServer(server)
+	}
+
+	if valueLimits.Enabled() {
+		providerServer = proto6server.NewValueLimitsServer(providerServer, valueLimits)
+	}
+
+	if opts.Debug && rpcBudget.Enabled() {
+		providerServer = proto6server.NewRPCBudgetServer(providerServer, rpcBudget)
+	}
+
+	if len(contextDecorators) > 0 {
+		providerServer = proto6server.NewContextDecoratorServer(providerServer, contextDecorators)
+	}
+
+	return withFormatDiagnosticsProtocol6(providerServer, server)
+}
+
+// withFormatDiagnosticsProtocol5 returns the protocol version 5
+// ProviderServer wrapped to format all outgoing diagnostics, if the provider
+// implements provider.ProviderWithFormatDiagnostic. It must wrap all other
+// servers, so their diagnostics are also formatted.
+func withFormatDiagnosticsProtocol5(providerServer tfprotov5.ProviderServer, server *proto5server.Server) tfprotov5.ProviderServer {
+	if _, ok := server.FrameworkServer.Provider.(provider.ProviderWithFormatDiagnostic); !ok {
+		return providerServer
+	}
+
+	return proto5server.NewFormatDiagnosticsServer(providerServer, &server.FrameworkServer)
+}
+
+// withFormatDiagnosticsProtocol6 returns the protocol version 6
+// ProviderServer wrapped to format all outgoing diagnostics, if the provider
+// implements provider.ProviderWithFormatDiagnostic. It must wrap all other
+// servers, so their diagnostics are also formatted.
+func withFormatDiagnosticsProtocol6(providerServer tfprotov6.ProviderServer, server *proto6server.Server) tfprotov6.ProviderServer {
+	if _, ok := server.FrameworkServer.Provider.(provider.ProviderWithFormatDiagnostic); !ok {
+		return providerServer
+	}
+
+	return proto6server.NewFormatDiagnosticsServer(providerServer, &server.FrameworkServer)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -70,3 +71,93 @@ func TestNewProtocol6WithError(t *testing.T) {
 		t.Fatalf("unexpected error calling ProviderServer: %s", err)
 	}
 }
+
+func TestNewProtocol5WithServeOpts(t *testing.T) {
+	provider := &testprovider.Provider{}
+
+	var got []RPCInfo
+
+	opts := ServeOpts{
+		ContextDecorators: []ContextDecorator{
+			func(ctx context.Context, info RPCInfo) context.Context {
+				got = append(got, info)
+
+				return ctx
+			},
+		},
+	}
+
+	providerServer := NewProtocol5WithServeOpts(provider, opts)()
+
+	_, err := providerServer.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	if diff := cmp.Diff(got, []RPCInfo{{Name: "GetProviderSchema"}}); diff != "" {
+		t.Errorf("unexpected decorator calls difference: %s", diff)
+	}
+}
+
+func TestNewProtocol5WithServeOptsAndError(t *testing.T) {
+	provider := &testprovider.Provider{}
+
+	providerServer, err := NewProtocol5WithServeOptsAndError(provider, ServeOpts{})()
+
+	if err != nil {
+		t.Fatalf("unexpected error creating ProviderServer: %s", err)
+	}
+
+	// Simple verification
+	_, err = providerServer.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+}
+
+func TestNewProtocol6WithServeOpts(t *testing.T) {
+	provider := &testprovider.Provider{}
+
+	var got []RPCInfo
+
+	opts := ServeOpts{
+		ContextDecorators: []ContextDecorator{
+			func(ctx context.Context, info RPCInfo) context.Context {
+				got = append(got, info)
+
+				return ctx
+			},
+		},
+	}
+
+	providerServer := NewProtocol6WithServeOpts(provider, opts)()
+
+	_, err := providerServer.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	if diff := cmp.Diff(got, []RPCInfo{{Name: "GetProviderSchema"}}); diff != "" {
+		t.Errorf("unexpected decorator calls difference: %s", diff)
+	}
+}
+
+func TestNewProtocol6WithServeOptsAndError(t *testing.T) {
+	provider := &testprovider.Provider{}
+
+	providerServer, err := NewProtocol6WithServeOptsAndError(provider, ServeOpts{})()
+
+	if err != nil {
+		t.Fatalf("unexpected error creating ProviderServer: %s", err)
+	}
+
+	// Simple verification
+	_, err = providerServer.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+}
@@ -0,0 +1,35 @@
+package providerserver
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+)
+
+// RPCBudget is the wall time and allocation budget of each RPC while the
+// provider is running in debug mode. When an RPC exceeds the budget, a
+// warning diagnostic is returned to Terraform and a framework warning log
+// entry is written. Zero values disable the associated check.
+//
+// This is intended to surface performance regressions, such as repeated API
+// calls or conversions of large collections, during provider development.
+type RPCBudget struct {
+	// MaxProcessAllocatedBytes is the maximum number of heap bytes allocated
+	// by the whole provider process while handling an RPC. This is only a
+	// rough, debug-only signal rather than a per-RPC measurement: RPCs which
+	// Terraform sends concurrently and any background goroutines are
+	// included, and each measurement reads the Go runtime memory statistics,
+	// which briefly stops the world. Do not rely on it outside of debug mode.
+	MaxProcessAllocatedBytes uint64
+
+	// MaxDuration is the maximum wall time of handling an RPC.
+	MaxDuration time.Duration
+}
+
+// fwserverRPCBudget returns the framework server equivalent of the budget.
+func (b RPCBudget) fwserverRPCBudget() fwserver.RPCBudget {
+	return fwserver.RPCBudget{
+		MaxProcessAllocatedBytes: b.MaxProcessAllocatedBytes,
+		MaxDuration:              b.MaxDuration,
+	}
+}
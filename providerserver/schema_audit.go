@@ -0,0 +1,297 @@
+package providerserver
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// SchemaAuditCheck is the name of a check performed by the AuditSchemas
+// function.
+type SchemaAuditCheck string
+
+const (
+	// SchemaAuditCheckEmptyDescription reports a resource or data source
+	// schema, attribute, or block without a Description or
+	// MarkdownDescription.
+	SchemaAuditCheckEmptyDescription SchemaAuditCheck = "empty_description"
+
+	// SchemaAuditCheckSensitiveWithoutWarning reports a sensitive attribute
+	// with a description that does not mention the value is sensitive.
+	SchemaAuditCheckSensitiveWithoutWarning SchemaAuditCheck = "sensitive_without_warning"
+
+	// SchemaAuditCheckTrailingPeriod reports a description which ends with a
+	// period when most descriptions of the provider do not, or the
+	// opposite. When descriptions are evenly split, descriptions without a
+	// trailing period are reported.
+	SchemaAuditCheckTrailingPeriod SchemaAuditCheck = "trailing_period"
+)
+
+// SchemaAuditFinding is a single finding of the AuditSchemas function.
+type SchemaAuditFinding struct {
+	// Check is the check which reported the finding.
+	Check SchemaAuditCheck
+
+	// Message is a human readable explanation of the finding.
+	Message string
+
+	// Path is the attribute or block within the schema, where list, map, and
+	// set nesting is represented by the path.Expression type AtAnyListIndex,
+	// AtAnyMapKey, and AtAnySetValue methods respectively. It is empty if
+	// the finding is for the schema itself.
+	Path path.Expression
+
+	// SchemaType is the kind of schema: "provider", "provider_meta",
+	// "resource", or "data_source".
+	SchemaType string
+
+	// TypeName is the resource or data source type name. It is empty for
+	// provider and provider_meta schemas.
+	TypeName string
+}
+
+// String returns a human readable representation of the finding, suitable
+// for test failure output.
+func (f SchemaAuditFinding) String() string {
+	var location strings.Builder
+
+	location.WriteString(f.SchemaType)
+
+	if f.TypeName != "" {
+		location.WriteString(" " + f.TypeName)
+	}
+
+	if pathString := f.Path.String(); pathString != "" {
+		location.WriteString(" " + pathString)
+	}
+
+	return location.String() + ": " + f.Message + " (" + string(f.Check) + ")"
+}
+
+// AuditSchemas calls all provider defined Metadata and Schema methods of the
+// given provider and returns findings for schema documentation quality
+// issues, such as empty descriptions, inconsistent trailing periods in
+// descriptions, or sensitive attributes which do not mention it in their
+// description. Unlike ValidateImplementation, findings are not problems
+// with the provider implementation, so providers can decide which checks to
+// enforce in their own unit tests.
+//
+// Findings are sorted by schema type, type name, path, and check. Error
+// diagnostics are returned for invalid provider definitions, in which case
+// no findings are returned.
+func AuditSchemas(ctx context.Context, p provider.Provider) ([]SchemaAuditFinding, diag.Diagnostics) {
+	server := &fwserver.Server{
+		Provider: p,
+	}
+
+	req := &fwserver.GetProviderSchemaRequest{}
+	resp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	audit := &schemaAudit{}
+
+	audit.schema("provider", "", resp.Provider)
+	audit.schema("provider_meta", "", resp.ProviderMeta)
+
+	for typeName, resourceSchema := range resp.ResourceSchemas {
+		// Legacy type names share the schema of the current type name.
+		if _, ok := server.ResourceLegacyTypeName(ctx, typeName); ok {
+			continue
+		}
+
+		audit.schema("resource", typeName, resourceSchema)
+	}
+
+	for typeName, dataSourceSchema := range resp.DataSourceSchemas {
+		audit.schema("data_source", typeName, dataSourceSchema)
+	}
+
+	return audit.result(), resp.Diagnostics
+}
+
+// schemaAuditSchemaTypes is the sort order of SchemaAuditFinding SchemaType.
+var schemaAuditSchemaTypes = map[string]int{
+	"provider":      0,
+	"provider_meta": 1,
+	"resource":      2,
+	"data_source":   3,
+}
+
+// schemaAudit collects findings while walking schemas.
+type schemaAudit struct {
+	// descriptions are the non-empty descriptions of all schemas, attributes,
+	// and blocks for the trailing period check, which requires all
+	// descriptions of the provider.
+	descriptions []schemaAuditDescription
+
+	findings []SchemaAuditFinding
+}
+
+// schemaAuditDescription is a non-empty description and its location.
+type schemaAuditDescription struct {
+	description string
+	location    SchemaAuditFinding
+}
+
+// schema audits the schema and all of its attributes and blocks. Provider
+// and provider_meta schemas without attributes or blocks are skipped.
+func (a *schemaAudit) schema(schemaType string, typeName string, s fwschema.Schema) {
+	if s == nil {
+		return
+	}
+
+	if typeName == "" && len(s.GetAttributes()) == 0 && len(s.GetBlocks()) == 0 {
+		return
+	}
+
+	location := SchemaAuditFinding{
+		SchemaType: schemaType,
+		TypeName:   typeName,
+	}
+
+	a.description(location, s.GetDescription(), s.GetMarkdownDescription())
+	a.attributes(location, path.MatchRoot, s.GetAttributes(), s.GetBlocks())
+}
+
+// attributes audits the given attributes and blocks, and any nested
+// attributes and blocks. The atName function returns the path of an
+// attribute or block name underneath the parent path.
+func (a *schemaAudit) attributes(location SchemaAuditFinding, atName func(string) path.Expression, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) {
+	for name, attribute := range attributes {
+		attributeLocation := location
+		attributeLocation.Path = atName(name)
+
+		a.description(attributeLocation, attribute.GetDescription(), attribute.GetMarkdownDescription())
+
+		if attribute.IsSensitive() && !strings.Contains(strings.ToLower(attribute.GetDescription()+" "+attribute.GetMarkdownDescription()), "sensitive") {
+			attributeLocation.Check = SchemaAuditCheckSensitiveWithoutWarning
+			attributeLocation.Message = "Sensitive attribute description does not mention that the value is sensitive."
+
+			a.findings = append(a.findings, attributeLocation)
+		}
+
+		nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+		if !ok {
+			continue
+		}
+
+		nestedPath := atName(name)
+
+		switch nestedAttribute.GetNestingMode() {
+		case fwschema.NestingModeList:
+			nestedPath = nestedPath.AtAnyListIndex()
+		case fwschema.NestingModeMap:
+			nestedPath = nestedPath.AtAnyMapKey()
+		case fwschema.NestingModeSet:
+			nestedPath = nestedPath.AtAnySetValue()
+		}
+
+		a.attributes(location, nestedPath.AtName, nestedAttribute.GetNestedObject().GetAttributes(), nil)
+	}
+
+	for name, block := range blocks {
+		blockLocation := location
+		blockLocation.Path = atName(name)
+
+		a.description(blockLocation, block.GetDescription(), block.GetMarkdownDescription())
+
+		nestedPath := atName(name)
+
+		switch block.GetNestingMode() {
+		case fwschema.BlockNestingModeList:
+			nestedPath = nestedPath.AtAnyListIndex()
+		case fwschema.BlockNestingModeSet:
+			nestedPath = nestedPath.AtAnySetValue()
+		}
+
+		nestedObject := block.GetNestedObject()
+
+		a.attributes(location, nestedPath.AtName, nestedObject.GetAttributes(), nestedObject.GetBlocks())
+	}
+}
+
+// description reports an empty description or saves the description for the
+// trailing period check. The plaintext description is preferred.
+func (a *schemaAudit) description(location SchemaAuditFinding, description string, markdownDescription string) {
+	description = strings.TrimSpace(description)
+
+	if description == "" {
+		description = strings.TrimSpace(markdownDescription)
+	}
+
+	if description != "" {
+		a.descriptions = append(a.descriptions, schemaAuditDescription{
+			description: description,
+			location:    location,
+		})
+
+		return
+	}
+
+	location.Check = SchemaAuditCheckEmptyDescription
+	location.Message = "Description and MarkdownDescription are empty."
+
+	a.findings = append(a.findings, location)
+}
+
+// result returns all findings, including the trailing period check, sorted.
+func (a *schemaAudit) result() []SchemaAuditFinding {
+	var withPeriod int
+
+	for _, d := range a.descriptions {
+		if strings.HasSuffix(d.description, ".") {
+			withPeriod++
+		}
+	}
+
+	expectPeriod := withPeriod*2 >= len(a.descriptions)
+
+	for _, d := range a.descriptions {
+		if strings.HasSuffix(d.description, ".") == expectPeriod {
+			continue
+		}
+
+		finding := d.location
+		finding.Check = SchemaAuditCheckTrailingPeriod
+
+		if expectPeriod {
+			finding.Message = "Description does not end with a period, unlike most descriptions of the provider."
+		} else {
+			finding.Message = "Description ends with a period, unlike most descriptions of the provider."
+		}
+
+		a.findings = append(a.findings, finding)
+	}
+
+	sort.Slice(a.findings, func(i, j int) bool {
+		fi, fj := a.findings[i], a.findings[j]
+
+		if fi.SchemaType != fj.SchemaType {
+			return schemaAuditSchemaTypes[fi.SchemaType] < schemaAuditSchemaTypes[fj.SchemaType]
+		}
+
+		if fi.TypeName != fj.TypeName {
+			return fi.TypeName < fj.TypeName
+		}
+
+		if fi.Path.String() != fj.Path.String() {
+			return fi.Path.String() < fj.Path.String()
+		}
+
+		return fi.Check < fj.Check
+	})
+
+	return a.findings
+}
@@ -0,0 +1,224 @@
+package providerserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestAuditSchemas(t *testing.T) {
+	t.Parallel()
+
+	testResource := func(s resourceschema.Schema) func() resource.Resource {
+		return func() resource.Resource {
+			return &testprovider.Resource{
+				MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+					resp.TypeName = "test_resource"
+				},
+				SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+					resp.Schema = s
+				},
+			}
+		}
+	}
+
+	testCases := map[string]struct {
+		provider         provider.Provider
+		expectedFindings []string
+		expectedError    bool
+	}{
+		"no-findings": {
+			provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						testResource(resourceschema.Schema{
+							Description: "Test resource.",
+							Attributes: map[string]resourceschema.Attribute{
+								"test": resourceschema.StringAttribute{
+									Description: "Test attribute.",
+									Required:    true,
+								},
+								"test_secret": resourceschema.StringAttribute{
+									MarkdownDescription: "Test secret. This value is **sensitive**.",
+									Required:            true,
+									Sensitive:           true,
+								},
+							},
+						}),
+					}
+				},
+			},
+		},
+		"empty-description": {
+			provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						testResource(resourceschema.Schema{
+							Attributes: map[string]resourceschema.Attribute{
+								"test": resourceschema.ListNestedAttribute{
+									Description: "Test attribute.",
+									NestedObject: resourceschema.NestedAttributeObject{
+										Attributes: map[string]resourceschema.Attribute{
+											"nested": resourceschema.StringAttribute{
+												Optional: true,
+											},
+										},
+									},
+									Optional: true,
+								},
+							},
+							Blocks: map[string]resourceschema.Block{
+								"test_block": resourceschema.SetNestedBlock{
+									NestedObject: resourceschema.NestedBlockObject{
+										Attributes: map[string]resourceschema.Attribute{
+											"nested": resourceschema.StringAttribute{
+												Description: "Test nested attribute.",
+												Optional:    true,
+											},
+										},
+									},
+								},
+							},
+						}),
+					}
+				},
+			},
+			expectedFindings: []string{
+				"resource test_resource: Description and MarkdownDescription are empty. (empty_description)",
+				"resource test_resource test[*].nested: Description and MarkdownDescription are empty. (empty_description)",
+				"resource test_resource test_block: Description and MarkdownDescription are empty. (empty_description)",
+			},
+		},
+		"trailing-period": {
+			provider: &testprovider.Provider{
+				DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+					return []func() datasource.DataSource{
+						func() datasource.DataSource {
+							return &testprovider.DataSource{
+								MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+									resp.TypeName = "test_data_source"
+								},
+								SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+									resp.Schema = datasourceschema.Schema{
+										Description: "Test data source",
+										Attributes: map[string]datasourceschema.Attribute{
+											"test": datasourceschema.StringAttribute{
+												Description: "Test attribute.",
+												Computed:    true,
+											},
+										},
+									}
+								},
+							}
+						},
+					}
+				},
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+					resp.Schema = providerschema.Schema{
+						Description: "Test provider.",
+						Attributes: map[string]providerschema.Attribute{
+							"test": providerschema.StringAttribute{
+								Description: "Test attribute.",
+								Optional:    true,
+							},
+						},
+					}
+				},
+			},
+			expectedFindings: []string{
+				"data_source test_data_source: Description does not end with a period, unlike most descriptions of the provider. (trailing_period)",
+			},
+		},
+		"trailing-period-majority-without": {
+			provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						testResource(resourceschema.Schema{
+							Description: "Test resource",
+							Attributes: map[string]resourceschema.Attribute{
+								"test": resourceschema.StringAttribute{
+									Description: "Test attribute",
+									Required:    true,
+								},
+								"test_other": resourceschema.StringAttribute{
+									Description: "Test other attribute.",
+									Required:    true,
+								},
+							},
+						}),
+					}
+				},
+			},
+			expectedFindings: []string{
+				"resource test_resource test_other: Description ends with a period, unlike most descriptions of the provider. (trailing_period)",
+			},
+		},
+		"sensitive-without-warning": {
+			provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						testResource(resourceschema.Schema{
+							Description: "Test resource.",
+							Attributes: map[string]resourceschema.Attribute{
+								"test_secret": resourceschema.StringAttribute{
+									Description: "Test secret.",
+									Required:    true,
+									Sensitive:   true,
+								},
+							},
+						}),
+					}
+				},
+			},
+			expectedFindings: []string{
+				"resource test_resource test_secret: Sensitive attribute description does not mention that the value is sensitive. (sensitive_without_warning)",
+			},
+		},
+		"invalid-schema": {
+			provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						testResource(resourceschema.Schema{
+							Attributes: map[string]resourceschema.Attribute{
+								"test": resourceschema.StringAttribute{},
+							},
+						}),
+					}
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, diags := AuditSchemas(context.Background(), testCase.provider)
+
+			if diags.HasError() != testCase.expectedError {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var got []string
+
+			for _, finding := range findings {
+				got = append(got, finding.String())
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedFindings); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package providerserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ResourceSchemaHistories returns a mapping of resource type names to the
+// schema version history registered by each resource implementing the
+// resource.ResourceWithSchemaHistory interface, sorted by version.
+//
+// This enables tooling to introspect the changes between provider releases,
+// such as from a separate command within the provider binary, without
+// parsing changelogs. Error diagnostics are returned for invalid resource
+// definitions or schema histories.
+func ResourceSchemaHistories(ctx context.Context, p provider.Provider) (map[string][]resource.SchemaChange, diag.Diagnostics) {
+	server := &fwserver.Server{
+		Provider: p,
+	}
+
+	req := &fwserver.GetResourceSchemaHistoriesRequest{}
+	resp := &fwserver.GetResourceSchemaHistoriesResponse{}
+
+	server.GetResourceSchemaHistories(ctx, req, resp)
+
+	return resp.ResourceSchemaHistories, resp.Diagnostics
+}
@@ -0,0 +1,96 @@
+package providerserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto5server"
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto6server"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Schemas is a precomputed export of all provider, provider meta, resource,
+// and data source schemas of a provider, created with the ExportSchemas
+// function. It can be shared by multiple provider servers of the same
+// provider within one process, such as servers combined with
+// terraform-plugin-mux or acceptance testing provider factories which create
+// a new server for every test step, so each server does not call the
+// provider defined Schema methods again.
+//
+// Schemas is safe for concurrent use and must not be used with a different
+// provider.
+type Schemas struct {
+	schemas fwserver.GetProviderSchemaResponse
+}
+
+// ExportSchemas calls all provider defined Schema methods of the given
+// provider once and returns the result for the NewProtocol5WithSchemas and
+// NewProtocol6WithSchemas functions. Error diagnostics are returned for
+// invalid schemas, in which case the Schemas are nil.
+func ExportSchemas(ctx context.Context, p provider.Provider) (*Schemas, diag.Diagnostics) {
+	server := &fwserver.Server{
+		Provider: p,
+	}
+
+	req := &fwserver.GetProviderSchemaRequest{}
+	resp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	return &Schemas{
+		schemas: *resp,
+	}, resp.Diagnostics
+}
+
+// NewProtocol5WithSchemas is equivalent to NewProtocol5, except the returned
+// servers use the given precomputed Schemas instead of calling the provider
+// defined Schema methods. The Schemas must be exported from the same
+// provider implementation.
+func NewProtocol5WithSchemas(p provider.Provider, schemas *Schemas) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		server := &proto5server.Server{
+			FrameworkServer: fwserver.Server{
+				Provider: p,
+			},
+		}
+
+		schemas.importInto(&server.FrameworkServer)
+
+		return withFormatDiagnosticsProtocol5(server, server)
+	}
+}
+
+// NewProtocol6WithSchemas is equivalent to NewProtocol6, except the returned
+// servers use the given precomputed Schemas instead of calling the provider
+// defined Schema methods. The Schemas must be exported from the same
+// provider implementation.
+func NewProtocol6WithSchemas(p provider.Provider, schemas *Schemas) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		server := &proto6server.Server{
+			FrameworkServer: fwserver.Server{
+				Provider: p,
+			},
+		}
+
+		schemas.importInto(&server.FrameworkServer)
+
+		return withFormatDiagnosticsProtocol6(server, server)
+	}
+}
+
+// importInto caches the schemas in the given framework server. Nil Schemas
+// are ignored, so the server calls the provider defined Schema methods.
+func (s *Schemas) importInto(server *fwserver.Server) {
+	if s == nil {
+		return
+	}
+
+	server.ImportSchemas(context.Background(), &s.schemas)
+}
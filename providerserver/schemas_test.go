@@ -0,0 +1,187 @@
+package providerserver
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func testSchemasProvider(schemaCalls *int32) provider.Provider {
+	return &testprovider.Provider{
+		SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+			atomic.AddInt32(schemaCalls, 1)
+
+			resp.Schema = providerschema.Schema{
+				Attributes: map[string]providerschema.Attribute{
+					"test": providerschema.StringAttribute{
+						Optional: true,
+					},
+				},
+			}
+		},
+		DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+			return []func() datasource.DataSource{
+				func() datasource.DataSource {
+					return &testprovider.DataSource{
+						MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+							resp.TypeName = "test_data_source"
+						},
+						SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+							atomic.AddInt32(schemaCalls, 1)
+
+							resp.Schema = datasourceschema.Schema{
+								Attributes: map[string]datasourceschema.Attribute{
+									"test": datasourceschema.StringAttribute{
+										Computed: true,
+									},
+								},
+							}
+						},
+					}
+				},
+			}
+		},
+		ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+			return []func() resource.Resource{
+				func() resource.Resource {
+					return &testprovider.Resource{
+						MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+							resp.TypeName = "test_resource"
+						},
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							atomic.AddInt32(schemaCalls, 1)
+
+							resp.Schema = resourceschema.Schema{
+								Attributes: map[string]resourceschema.Attribute{
+									"test": resourceschema.StringAttribute{
+										Required: true,
+									},
+								},
+							}
+						},
+					}
+				},
+			}
+		},
+	}
+}
+
+func TestExportSchemas(t *testing.T) {
+	var schemaCalls int32
+
+	schemas, diags := ExportSchemas(context.Background(), testSchemasProvider(&schemaCalls))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if schemas == nil {
+		t.Fatal("expected schemas, got nil")
+	}
+
+	if got := atomic.LoadInt32(&schemaCalls); got != 3 {
+		t.Errorf("expected 3 Schema method calls, got %d", got)
+	}
+}
+
+func TestNewProtocol5WithSchemas(t *testing.T) {
+	var schemaCalls int32
+
+	p := testSchemasProvider(&schemaCalls)
+
+	schemas, diags := ExportSchemas(context.Background(), p)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	providerServerFunc := NewProtocol5WithSchemas(p, schemas)
+
+	for i := 0; i < 2; i++ {
+		resp, err := providerServerFunc().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+		if err != nil {
+			t.Fatalf("unexpected error calling ProviderServer: %s", err)
+		}
+
+		if len(resp.Diagnostics) > 0 {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		if _, ok := resp.ResourceSchemas["test_resource"]; !ok {
+			t.Errorf("expected test_resource schema, got: %v", resp.ResourceSchemas)
+		}
+
+		if _, ok := resp.DataSourceSchemas["test_data_source"]; !ok {
+			t.Errorf("expected test_data_source schema, got: %v", resp.DataSourceSchemas)
+		}
+	}
+
+	if got := atomic.LoadInt32(&schemaCalls); got != 3 {
+		t.Errorf("expected 3 Schema method calls, got %d", got)
+	}
+}
+
+func TestNewProtocol6WithSchemas(t *testing.T) {
+	var schemaCalls int32
+
+	p := testSchemasProvider(&schemaCalls)
+
+	schemas, diags := ExportSchemas(context.Background(), p)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	providerServerFunc := NewProtocol6WithSchemas(p, schemas)
+
+	for i := 0; i < 2; i++ {
+		resp, err := providerServerFunc().GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+		if err != nil {
+			t.Fatalf("unexpected error calling ProviderServer: %s", err)
+		}
+
+		if len(resp.Diagnostics) > 0 {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		if _, ok := resp.ResourceSchemas["test_resource"]; !ok {
+			t.Errorf("expected test_resource schema, got: %v", resp.ResourceSchemas)
+		}
+
+		if _, ok := resp.DataSourceSchemas["test_data_source"]; !ok {
+			t.Errorf("expected test_data_source schema, got: %v", resp.DataSourceSchemas)
+		}
+	}
+
+	if got := atomic.LoadInt32(&schemaCalls); got != 3 {
+		t.Errorf("expected 3 Schema method calls, got %d", got)
+	}
+}
+
+func TestNewProtocol6WithSchemas_Nil(t *testing.T) {
+	var schemaCalls int32
+
+	providerServerFunc := NewProtocol6WithSchemas(testSchemasProvider(&schemaCalls), nil)
+
+	_, err := providerServerFunc().GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	if got := atomic.LoadInt32(&schemaCalls); got != 3 {
+		t.Errorf("expected 3 Schema method calls, got %d", got)
+	}
+}
@@ -4,9 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
-// ServeOpts are options for serving the provider.
+// ServeOpts are options for serving the provider. They can also configure
+// servers created with the NewProtocol5WithServeOpts and
+// NewProtocol6WithServeOpts functions, such as for terraform-plugin-mux or
+// acceptance testing.
 type ServeOpts struct {
 	// Address is the full address of the provider. Full address form has three
 	// parts separated by forward slashes (/): Hostname, namespace, and
@@ -15,12 +21,26 @@ type ServeOpts struct {
 	// For example: registry.terraform.io/hashicorp/random.
 	Address string
 
+	// ContextDecorators are applied, in order, to the context of every RPC
+	// before it is handled by the provider. Each decorator receives the
+	// context returned by the previous decorator. This enables providers to
+	// uniformly attach loggers, tracing, tenant identifiers, or feature flags
+	// without wrapping the provider server. Refer to the ContextDecorator
+	// documentation for details.
+	ContextDecorators []ContextDecorator
+
 	// Debug runs the provider in a mode acceptable for debugging and testing
 	// processes, such as delve, by managing the process lifecycle. Information
 	// needed for Terraform CLI to connect to the provider is output to stdout.
 	// os.Interrupt (Ctrl-c) can be used to stop the provider.
 	Debug bool
 
+	// PrivateStateCodec, if set, transparently encrypts resource private
+	// state values before they are saved into the Terraform state and
+	// decrypts them before resource methods are called. Refer to the
+	// resource.PrivateStateCodec documentation for details.
+	PrivateStateCodec resource.PrivateStateCodec
+
 	// ProtocolVersion is the protocol version that should be used when serving
 	// the provider. Either protocol version 5 or protocol version 6 can be
 	// used. Defaults to protocol version 6.
@@ -31,6 +51,38 @@ type ServeOpts struct {
 	//     - tfsdk.Attribute cannot use Attributes field (nested attributes).
 	//
 	ProtocolVersion int
+
+	// RPCBudget, if set while Debug is enabled, measures the wall time and
+	// allocations of each RPC and returns warning diagnostics for any RPC
+	// which exceeds the budget. It is ignored when Debug is not enabled.
+	RPCBudget RPCBudget
+
+	// SecretResolver, if set, resolves provider configuration string
+	// attribute values from an external secret source before the provider
+	// Configure method is called. Refer to the provider.SecretResolver
+	// documentation for details.
+	SecretResolver provider.SecretResolver
+
+	// SupportBundleDir, if set, enables support bundles for troubleshooting.
+	// When an RPC panics, a JSON file containing the RPC name, resource or
+	// data source type name, a schema snapshot, the diagnostic summaries, and
+	// the panic stack trace is written to a new temporary file in this
+	// directory, such as os.TempDir(). The file path is referenced in the
+	// returned diagnostics. Panics are also recovered and returned as an
+	// error diagnostic instead of stopping the provider. Error diagnostics do
+	// not write support bundles.
+	//
+	// Configuration, plan, and state values and diagnostic details are never
+	// written, however panic values are, so review support bundles before
+	// sharing them.
+	SupportBundleDir string
+
+	// ValueLimits are the limits of configuration, plan, and state values
+	// which Terraform sends to the provider. By default, values are not
+	// limited. Values which exceed an enabled limit return an error
+	// diagnostic, so set limits well above the largest expected values.
+	// Refer to the ValueLimits documentation for details.
+	ValueLimits ValueLimits
 }
 
 // Validate a given provider address. This is only used for the Address field
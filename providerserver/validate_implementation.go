@@ -0,0 +1,29 @@
+package providerserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// ValidateImplementation calls all provider defined Metadata and Schema
+// methods of the given provider and returns any diagnostics for provider
+// developer mistakes, such as duplicate resource type names, attributes using
+// reserved names, or attributes which do not enable one of Required,
+// Optional, or Computed. Provider servers return the same diagnostics when
+// Terraform first requests the provider schema, however calling this function
+// in a provider unit test reports them without running Terraform.
+func ValidateImplementation(ctx context.Context, p provider.Provider) diag.Diagnostics {
+	server := &fwserver.Server{
+		Provider: p,
+	}
+
+	req := &fwserver.GetProviderSchemaRequest{}
+	resp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(ctx, req, resp)
+
+	return resp.Diagnostics
+}
@@ -0,0 +1,83 @@
+package providerserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestValidateImplementation(t *testing.T) {
+	t.Parallel()
+
+	testResource := func(attribute resourceschema.Attribute) func() resource.Resource {
+		return func() resource.Resource {
+			return &testprovider.Resource{
+				MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+					resp.TypeName = "test_resource"
+				},
+				SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+					resp.Schema = resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test": attribute,
+						},
+					}
+				},
+			}
+		}
+	}
+
+	testCases := map[string]struct {
+		provider      provider.Provider
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						testResource(resourceschema.StringAttribute{
+							Required: true,
+						}),
+					}
+				},
+			},
+		},
+		"invalid-attribute": {
+			provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						testResource(resourceschema.StringAttribute{}),
+					}
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Implementation",
+					`Attribute "test" must enable one of Required, Optional, or Computed. `+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ValidateImplementation(context.Background(), testCase.provider)
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package providerserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+)
+
+// ValueLimits are the limits of configuration, plan, and state values which
+// Terraform sends to the provider. Values are checked before they are
+// decoded by the framework, so pathologically deep or large values, such as
+// from malformed requests, return an error diagnostic instead of exhausting
+// the provider resources.
+//
+// The zero value disables all checks, so limits are opt-in.
+type ValueLimits struct {
+	// MaxDepth is the maximum nesting depth of collection and object values,
+	// where each list, map, object, set, and tuple is one level of depth.
+	// Zero or negative values disable the check.
+	MaxDepth int
+
+	// MaxElements is the maximum total number of collection elements and
+	// object attributes within a single value, across all levels of depth.
+	// Zero or negative values disable the check.
+	MaxElements int
+}
+
+// fwserverValueLimits returns the framework server equivalent of the limits.
+func (l ValueLimits) fwserverValueLimits() fwserver.ValueLimits {
+	return fwserver.ValueLimits{
+		MaxDepth:    l.MaxDepth,
+		MaxElements: l.MaxElements,
+	}
+}
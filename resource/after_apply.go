@@ -0,0 +1,48 @@
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// AfterApplyRequest represents a request for the provider to run logic after
+// a resource was successfully created or updated. An instance of this request
+// struct is supplied as an argument to the ResourceWithAfterApply interface
+// AfterApply method.
+type AfterApplyRequest struct {
+	// Config is the configuration the user supplied for the resource.
+	Config tfsdk.Config
+
+	// Plan is the planned state for the resource.
+	Plan tfsdk.Plan
+
+	// PriorState is the state of the resource prior to the Create or Update
+	// operation. This is always null after resource creation.
+	PriorState tfsdk.State
+
+	// State is the final state of the resource following the Create or
+	// Update operation, which will be returned to Terraform.
+	State tfsdk.State
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta tfsdk.Config
+
+	// Private is the final provider-defined resource private state data
+	// following the Create or Update operation. Use the GetKey method to
+	// read data.
+	Private *privatestate.ProviderData
+}
+
+// AfterApplyResponse represents a response to an AfterApplyRequest. An
+// instance of this response struct is supplied as an argument to the
+// ResourceWithAfterApply interface AfterApply method.
+type AfterApplyResponse struct {
+	// Diagnostics report errors or warnings related to the logic after the
+	// resource was created or updated. An empty slice indicates a successful
+	// operation with no warnings or errors generated.
+	//
+	// The resource state is always saved by Terraform, even if error
+	// diagnostics are returned.
+	Diagnostics diag.Diagnostics
+}
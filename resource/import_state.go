@@ -2,6 +2,8 @@ package resource
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -57,3 +59,52 @@ func ImportStatePassthroughID(ctx context.Context, attrPath path.Path, req Impor
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, req.ID)...)
 }
+
+// ImportStateCompositeID is a helper function to split the import identifier
+// on the given separator and set each part to the state attribute path at the
+// same position, such as separator "/" and paths for project, region, and
+// name attributes with an import identifier of "my-project/us-east1/my-name".
+// Each attribute must accept a string value.
+//
+// An error diagnostic is returned if the import identifier does not contain
+// exactly one non-empty part for each attribute path, which describes the
+// expected import identifier format to practitioners.
+func ImportStateCompositeID(ctx context.Context, separator string, attrPaths []path.Path, req ImportStateRequest, resp *ImportStateResponse) {
+	if separator == "" || len(attrPaths) == 0 {
+		resp.Diagnostics.AddError(
+			"Resource Import Composite ID Missing Separator or Attribute Paths",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ImportStateCompositeID must set a non-empty separator and at least one attribute path that can accept a string value.",
+		)
+
+		return
+	}
+
+	idParts := strings.Split(req.ID, separator)
+	validFormat := len(idParts) == len(attrPaths)
+
+	for _, idPart := range idParts {
+		if idPart == "" {
+			validFormat = false
+		}
+	}
+
+	if !validFormat {
+		formatParts := make([]string, 0, len(attrPaths))
+
+		for _, attrPath := range attrPaths {
+			formatParts = append(formatParts, attrPath.String())
+		}
+
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", strings.Join(formatParts, separator), req.ID),
+		)
+
+		return
+	}
+
+	for index, attrPath := range attrPaths {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, idParts[index])...)
+	}
+}
@@ -0,0 +1,157 @@
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImportStateCompositeID(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"project": schema.StringAttribute{
+				Required: true,
+			},
+			"region": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+
+	testAttrPaths := []path.Path{
+		path.Root("project"),
+		path.Root("region"),
+		path.Root("name"),
+	}
+
+	testValue := func(project, region, name interface{}) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"name":    tftypes.NewValue(tftypes.String, name),
+			"project": tftypes.NewValue(tftypes.String, project),
+			"region":  tftypes.NewValue(tftypes.String, region),
+		})
+	}
+
+	testCases := map[string]struct {
+		separator     string
+		attrPaths     []path.Path
+		id            string
+		expectedState tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			separator:     "/",
+			attrPaths:     testAttrPaths,
+			id:            "test-project/test-region/test-name",
+			expectedState: testValue("test-project", "test-region", "test-name"),
+		},
+		"valid-multiple-character-separator": {
+			separator:     "::",
+			attrPaths:     testAttrPaths,
+			id:            "test-project::test-region::test-name",
+			expectedState: testValue("test-project", "test-region", "test-name"),
+		},
+		"too-few-parts": {
+			separator:     "/",
+			attrPaths:     testAttrPaths,
+			id:            "test-project/test-name",
+			expectedState: testValue(nil, nil, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected Import Identifier",
+					`Expected import identifier with format: project/region/name. Got: "test-project/test-name"`,
+				),
+			},
+		},
+		"too-many-parts": {
+			separator:     "/",
+			attrPaths:     testAttrPaths,
+			id:            "test-project/test-region/test-name/extra",
+			expectedState: testValue(nil, nil, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected Import Identifier",
+					`Expected import identifier with format: project/region/name. Got: "test-project/test-region/test-name/extra"`,
+				),
+			},
+		},
+		"empty-part": {
+			separator:     "/",
+			attrPaths:     testAttrPaths,
+			id:            "test-project//test-name",
+			expectedState: testValue(nil, nil, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected Import Identifier",
+					`Expected import identifier with format: project/region/name. Got: "test-project//test-name"`,
+				),
+			},
+		},
+		"missing-separator": {
+			attrPaths:     testAttrPaths,
+			id:            "test-project/test-region/test-name",
+			expectedState: testValue(nil, nil, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Import Composite ID Missing Separator or Attribute Paths",
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Resource ImportState method call to ImportStateCompositeID must set a non-empty separator and at least one attribute path that can accept a string value.",
+				),
+			},
+		},
+		"missing-attribute-paths": {
+			separator:     "/",
+			id:            "test-project/test-region/test-name",
+			expectedState: testValue(nil, nil, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Import Composite ID Missing Separator or Attribute Paths",
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Resource ImportState method call to ImportStateCompositeID must set a non-empty separator and at least one attribute path that can accept a string value.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ImportStateRequest{
+				ID: testCase.id,
+			}
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Raw:    testValue(nil, nil, nil),
+					Schema: testSchema,
+				},
+			}
+
+			resource.ImportStateCompositeID(context.Background(), testCase.separator, testCase.attrPaths, req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.State.Raw, testCase.expectedState); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
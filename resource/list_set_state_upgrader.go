@@ -0,0 +1,324 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ListSetStateUpgrader returns a StateUpgrader for the common schema change
+// of switching attributes or blocks between list and set types, such as
+// schema.ListAttribute to schema.SetAttribute or schema.SetNestedBlock to
+// schema.ListNestedBlock. The prior schema must otherwise be identical to the
+// current schema, or an error diagnostic is returned.
+//
+// All prior state data is copied to the upgraded state. List values converted
+// to sets have duplicate elements removed, which raises a warning diagnostic
+// for each affected attribute. Set values converted to lists keep the prior
+// state element ordering.
+func ListSetStateUpgrader(priorSchema schema.Schema) StateUpgrader {
+	return StateUpgrader{
+		PriorSchema: &priorSchema,
+		StateUpgrader: func(ctx context.Context, req UpgradeStateRequest, resp *UpgradeStateResponse) {
+			if req.State == nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"The prior resource state was not available for conversion. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The ListSetStateUpgrader StateUpgrader was called without the UpgradeStateRequest State field.",
+				)
+
+				return
+			}
+
+			currentType := resp.State.Schema.Type().TerraformType(ctx)
+
+			err := listSetConvertibleType(tftypes.NewAttributePath(), req.State.Raw.Type(), currentType)
+
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"The prior schema cannot be converted to the current schema by only switching between list and set types. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						err.Error(),
+				)
+
+				return
+			}
+
+			upgradedValue, diags := listSetConvertValue(ctx, tftypes.NewAttributePath(), req.State.Raw, currentType, resp.State.Schema)
+
+			resp.Diagnostics.Append(diags...)
+
+			if diags.HasError() {
+				return
+			}
+
+			resp.State.Raw = upgradedValue
+		},
+	}
+}
+
+// listSetConvertibleType returns an error if the given types differ in any
+// way other than switching between list and set types.
+func listSetConvertibleType(tfPath *tftypes.AttributePath, from tftypes.Type, to tftypes.Type) error {
+	if from.Equal(to) {
+		return nil
+	}
+
+	fromElementType, fromIsCollection := listSetElementType(from)
+	toElementType, toIsCollection := listSetElementType(to)
+
+	if fromIsCollection && toIsCollection {
+		return listSetConvertibleType(tfPath.WithElementKeyInt(0), fromElementType, toElementType)
+	}
+
+	switch fromType := from.(type) {
+	case tftypes.Map:
+		toType, ok := to.(tftypes.Map)
+
+		if !ok {
+			break
+		}
+
+		return listSetConvertibleType(tfPath.WithElementKeyString("*"), fromType.ElementType, toType.ElementType)
+	case tftypes.Object:
+		toType, ok := to.(tftypes.Object)
+
+		if !ok || len(fromType.AttributeTypes) != len(toType.AttributeTypes) {
+			break
+		}
+
+		for name, toAttributeType := range toType.AttributeTypes {
+			fromAttributeType, ok := fromType.AttributeTypes[name]
+
+			if !ok {
+				return listSetTypeError(tfPath, fmt.Sprintf("attribute %q is missing in the prior schema", name))
+			}
+
+			err := listSetConvertibleType(tfPath.WithAttributeName(name), fromAttributeType, toAttributeType)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case tftypes.Tuple:
+		toType, ok := to.(tftypes.Tuple)
+
+		if !ok || len(fromType.ElementTypes) != len(toType.ElementTypes) {
+			break
+		}
+
+		for index, toElementType := range toType.ElementTypes {
+			err := listSetConvertibleType(tfPath.WithElementKeyInt(index), fromType.ElementTypes[index], toElementType)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return listSetTypeError(tfPath, fmt.Sprintf("cannot convert prior schema type %s to current schema type %s", from, to))
+}
+
+// listSetTypeError returns an error with the message, prefixed with the
+// attribute path if not the root of the schema.
+func listSetTypeError(tfPath *tftypes.AttributePath, message string) error {
+	if len(tfPath.Steps()) == 0 {
+		return errors.New(message)
+	}
+
+	return fmt.Errorf("%s: %s", tfPath, message)
+}
+
+// listSetConvertValue converts the value into the given type, which must have
+// been verified with listSetConvertibleType.
+func listSetConvertValue(ctx context.Context, tfPath *tftypes.AttributePath, value tftypes.Value, to tftypes.Type, currentSchema fwschema.Schema) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.Type().Equal(to) {
+		return value, diags
+	}
+
+	if value.IsNull() {
+		return tftypes.NewValue(to, nil), diags
+	}
+
+	if !value.IsKnown() {
+		return tftypes.NewValue(to, tftypes.UnknownValue), diags
+	}
+
+	if toElementType, ok := listSetElementType(to); ok {
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			diags.Append(listSetConvertValueErrorDiag(tfPath, err))
+
+			return value, diags
+		}
+
+		convertedElements := make([]tftypes.Value, 0, len(elements))
+		duplicates := 0
+
+		for index, element := range elements {
+			convertedElement, elementDiags := listSetConvertValue(ctx, tfPath.WithElementKeyInt(index), element, toElementType, currentSchema)
+
+			diags.Append(elementDiags...)
+
+			if diags.HasError() {
+				return value, diags
+			}
+
+			if _, ok := to.(tftypes.Set); ok && listSetContainsValue(convertedElements, convertedElement) {
+				duplicates++
+
+				continue
+			}
+
+			convertedElements = append(convertedElements, convertedElement)
+		}
+
+		if duplicates > 0 {
+			summary := "Duplicate Set Elements Removed"
+			detail := "The prior resource state contained a list with duplicate elements, which are not allowed in sets. " +
+				"The duplicate elements were removed while upgrading the resource state.\n\n" +
+				"Duplicate Elements Removed: " + strconv.Itoa(duplicates)
+
+			fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfPath, currentSchema)
+
+			if fwPathDiags.HasError() {
+				diags.AddWarning(summary, detail+"\nAttribute Path: "+tfPath.String())
+			} else {
+				diags.AddAttributeWarning(fwPath, summary, detail)
+			}
+		}
+
+		return tftypes.NewValue(to, convertedElements), diags
+	}
+
+	switch toType := to.(type) {
+	case tftypes.Map:
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			diags.Append(listSetConvertValueErrorDiag(tfPath, err))
+
+			return value, diags
+		}
+
+		convertedElements := make(map[string]tftypes.Value, len(elements))
+
+		for key, element := range elements {
+			convertedElement, elementDiags := listSetConvertValue(ctx, tfPath.WithElementKeyString(key), element, toType.ElementType, currentSchema)
+
+			diags.Append(elementDiags...)
+
+			if diags.HasError() {
+				return value, diags
+			}
+
+			convertedElements[key] = convertedElement
+		}
+
+		return tftypes.NewValue(to, convertedElements), diags
+	case tftypes.Object:
+		var attributes map[string]tftypes.Value
+
+		if err := value.As(&attributes); err != nil {
+			diags.Append(listSetConvertValueErrorDiag(tfPath, err))
+
+			return value, diags
+		}
+
+		convertedAttributes := make(map[string]tftypes.Value, len(attributes))
+
+		for name, attribute := range attributes {
+			convertedAttribute, attributeDiags := listSetConvertValue(ctx, tfPath.WithAttributeName(name), attribute, toType.AttributeTypes[name], currentSchema)
+
+			diags.Append(attributeDiags...)
+
+			if diags.HasError() {
+				return value, diags
+			}
+
+			convertedAttributes[name] = convertedAttribute
+		}
+
+		return tftypes.NewValue(to, convertedAttributes), diags
+	case tftypes.Tuple:
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			diags.Append(listSetConvertValueErrorDiag(tfPath, err))
+
+			return value, diags
+		}
+
+		convertedElements := make([]tftypes.Value, 0, len(elements))
+
+		for index, element := range elements {
+			convertedElement, elementDiags := listSetConvertValue(ctx, tfPath.WithElementKeyInt(index), element, toType.ElementTypes[index], currentSchema)
+
+			diags.Append(elementDiags...)
+
+			if diags.HasError() {
+				return value, diags
+			}
+
+			convertedElements = append(convertedElements, convertedElement)
+		}
+
+		return tftypes.NewValue(to, convertedElements), diags
+	}
+
+	diags.Append(listSetConvertValueErrorDiag(tfPath, fmt.Errorf("cannot convert %s to %s", value.Type(), to)))
+
+	return value, diags
+}
+
+// listSetConvertValueErrorDiag returns an error diagnostic for unexpected
+// errors while converting prior state data.
+func listSetConvertValueErrorDiag(tfPath *tftypes.AttributePath, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Unable to Upgrade Resource State",
+		"An unexpected error was encountered while converting the prior resource state. "+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"Attribute Path: "+tfPath.String()+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// listSetContainsValue returns true if the values contain an equal value.
+func listSetContainsValue(values []tftypes.Value, value tftypes.Value) bool {
+	for _, v := range values {
+		if v.Equal(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listSetElementType returns the element type and true if the given type is
+// a list or set type.
+func listSetElementType(t tftypes.Type) (tftypes.Type, bool) {
+	switch t := t.(type) {
+	case tftypes.List:
+		return t.ElementType, true
+	case tftypes.Set:
+		return t.ElementType, true
+	default:
+		return nil, false
+	}
+}
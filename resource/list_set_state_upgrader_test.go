@@ -0,0 +1,256 @@
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestListSetStateUpgrader(t *testing.T) {
+	t.Parallel()
+
+	testListSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ports": schema.ListAttribute{
+							ElementType: types.Int64Type,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testSetSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ports": schema.SetAttribute{
+							ElementType: types.Int64Type,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testValue := func(collection func(tftypes.Type) tftypes.Type, tags any, rules any) tftypes.Value {
+		ruleType := tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"ports": collection(tftypes.Number),
+			},
+		}
+
+		return tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":   tftypes.String,
+					"tags": collection(tftypes.String),
+					"rule": collection(ruleType),
+				},
+			},
+			map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"tags": tftypes.NewValue(collection(tftypes.String), tags),
+				"rule": tftypes.NewValue(collection(ruleType), rules),
+			},
+		)
+	}
+
+	testRule := func(collection func(tftypes.Type) tftypes.Type, ports ...int) tftypes.Value {
+		portValues := make([]tftypes.Value, 0, len(ports))
+
+		for _, port := range ports {
+			portValues = append(portValues, tftypes.NewValue(tftypes.Number, port))
+		}
+
+		return tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"ports": collection(tftypes.Number),
+				},
+			},
+			map[string]tftypes.Value{
+				"ports": tftypes.NewValue(collection(tftypes.Number), portValues),
+			},
+		)
+	}
+
+	testStrings := func(values ...string) []tftypes.Value {
+		result := make([]tftypes.Value, 0, len(values))
+
+		for _, value := range values {
+			result = append(result, tftypes.NewValue(tftypes.String, value))
+		}
+
+		return result
+	}
+
+	list := func(elementType tftypes.Type) tftypes.Type {
+		return tftypes.List{ElementType: elementType}
+	}
+
+	set := func(elementType tftypes.Type) tftypes.Type {
+		return tftypes.Set{ElementType: elementType}
+	}
+
+	testCases := map[string]struct {
+		priorSchema   schema.Schema
+		currentSchema schema.Schema
+		priorState    *tfsdk.State
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"list-to-set": {
+			priorSchema:   testListSchema,
+			currentSchema: testSetSchema,
+			priorState: &tfsdk.State{
+				Raw:    testValue(list, testStrings("one", "two"), []tftypes.Value{testRule(list, 80, 443)}),
+				Schema: testListSchema,
+			},
+			expected: testValue(set, testStrings("one", "two"), []tftypes.Value{testRule(set, 80, 443)}),
+		},
+		"list-to-set-null": {
+			priorSchema:   testListSchema,
+			currentSchema: testSetSchema,
+			priorState: &tfsdk.State{
+				Raw:    testValue(list, nil, []tftypes.Value{}),
+				Schema: testListSchema,
+			},
+			expected: testValue(set, nil, []tftypes.Value{}),
+		},
+		"list-to-set-duplicates": {
+			priorSchema:   testListSchema,
+			currentSchema: testSetSchema,
+			priorState: &tfsdk.State{
+				Raw:    testValue(list, testStrings("one", "two", "one", "one"), []tftypes.Value{testRule(list, 80)}),
+				Schema: testListSchema,
+			},
+			expected: testValue(set, testStrings("one", "two"), []tftypes.Value{testRule(set, 80)}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("tags"),
+					"Duplicate Set Elements Removed",
+					"The prior resource state contained a list with duplicate elements, which are not allowed in sets. "+
+						"The duplicate elements were removed while upgrading the resource state.\n\n"+
+						"Duplicate Elements Removed: 2",
+				),
+			},
+		},
+		"set-to-list": {
+			priorSchema:   testSetSchema,
+			currentSchema: testListSchema,
+			priorState: &tfsdk.State{
+				Raw:    testValue(set, testStrings("one", "two"), []tftypes.Value{testRule(set, 80, 443)}),
+				Schema: testSetSchema,
+			},
+			expected: testValue(list, testStrings("one", "two"), []tftypes.Value{testRule(list, 80, 443)}),
+		},
+		"incompatible-schema": {
+			priorSchema: testListSchema,
+			currentSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			priorState: &tfsdk.State{
+				Raw:    testValue(list, testStrings("one"), []tftypes.Value{}),
+				Schema: testListSchema,
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id": tftypes.String,
+				},
+			}, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Upgrade Resource State",
+					"The prior schema cannot be converted to the current schema by only switching between list and set types. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`cannot convert prior schema type tftypes.Object["id":tftypes.String, "rule":tftypes.List[tftypes.Object["ports":tftypes.List[tftypes.Number]]], "tags":tftypes.List[tftypes.String]] to current schema type tftypes.Object["id":tftypes.String]`,
+				),
+			},
+		},
+		"missing-prior-state": {
+			priorSchema:   testListSchema,
+			currentSchema: testSetSchema,
+			expected:      tftypes.NewValue(testSetSchema.Type().TerraformType(context.Background()), nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Upgrade Resource State",
+					"The prior resource state was not available for conversion. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The ListSetStateUpgrader StateUpgrader was called without the UpgradeStateRequest State field.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			upgrader := resource.ListSetStateUpgrader(testCase.priorSchema)
+
+			if diff := cmp.Diff(*upgrader.PriorSchema, testCase.priorSchema); diff != "" {
+				t.Errorf("unexpected prior schema difference: %s", diff)
+			}
+
+			req := resource.UpgradeStateRequest{
+				State: testCase.priorState,
+			}
+			resp := &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(testCase.currentSchema.Type().TerraformType(ctx), nil),
+					Schema: testCase.currentSchema,
+				},
+			}
+
+			upgrader.StateUpgrader(ctx, req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.State.Raw, testCase.expected); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
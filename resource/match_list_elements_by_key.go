@@ -0,0 +1,171 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// MatchListElementsByKey returns the current list value with its elements
+// reordered to match the prior list value, where elements are objects which
+// are identified by the value of the given key attribute rather than their
+// position. This is intended for rebuilding state or planned values of lists
+// of objects, such as during the Read method, where the API may return the
+// same elements in a different order, which would otherwise surface as a
+// change to the entire list.
+//
+// Elements in the current list which match a prior element key are placed in
+// the prior ordering, followed by any remaining elements in their current
+// ordering. Elements with null or unknown key values are never matched. If
+// either list is null or unknown, the current list is returned unmodified.
+//
+// An error diagnostic is returned if the elements are not objects, the key
+// attribute does not exist, or the current list contains duplicate keys.
+func MatchListElementsByKey(ctx context.Context, prior types.List, current types.List, key string) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior.IsNull() || prior.IsUnknown() || current.IsNull() || current.IsUnknown() {
+		return current, diags
+	}
+
+	priorElements := prior.Elements()
+	priorKeys := make([]attr.Value, 0, len(priorElements))
+
+	for index, element := range priorElements {
+		keyValue, keyDiags := matchListElementKey(ctx, element, key, index)
+
+		diags.Append(keyDiags...)
+
+		if diags.HasError() {
+			return current, diags
+		}
+
+		priorKeys = append(priorKeys, keyValue)
+	}
+
+	currentElements := current.Elements()
+	currentKeys := make([]attr.Value, 0, len(currentElements))
+
+	for index, element := range currentElements {
+		keyValue, keyDiags := matchListElementKey(ctx, element, key, index)
+
+		diags.Append(keyDiags...)
+
+		if diags.HasError() {
+			return current, diags
+		}
+
+		if matchListElementKeyIndex(currentKeys, keyValue) >= 0 {
+			diags.AddError(
+				"Unable to Match List Elements",
+				"The current list contains multiple elements with the same key, so they cannot be matched to the prior list elements. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Duplicate %q Attribute Value: %s", key, keyValue),
+			)
+
+			return current, diags
+		}
+
+		currentKeys = append(currentKeys, keyValue)
+	}
+
+	matched := make([]bool, len(currentElements))
+	elements := make([]attr.Value, 0, len(currentElements))
+
+	for _, priorKey := range priorKeys {
+		index := matchListElementKeyIndex(currentKeys, priorKey)
+
+		if index < 0 || matched[index] {
+			continue
+		}
+
+		matched[index] = true
+		elements = append(elements, currentElements[index])
+	}
+
+	for index, element := range currentElements {
+		if !matched[index] {
+			elements = append(elements, element)
+		}
+	}
+
+	result, resultDiags := types.ListValue(current.ElementType(ctx), elements)
+
+	diags.Append(resultDiags...)
+
+	if diags.HasError() {
+		return current, diags
+	}
+
+	return result, diags
+}
+
+// matchListElementKey returns the key attribute value of the list element, or
+// nil if the element or the key attribute value is null or unknown.
+func matchListElementKey(ctx context.Context, element attr.Value, key string, index int) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objectValuable, ok := element.(basetypes.ObjectValuable)
+
+	if !ok {
+		diags.AddError(
+			"Unable to Match List Elements",
+			"The list elements must be objects to be matched by key. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Element %d Type: %T", index, element),
+		)
+
+		return nil, diags
+	}
+
+	object, objectDiags := objectValuable.ToObjectValue(ctx)
+
+	diags.Append(objectDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if _, ok := object.AttributeTypes(ctx)[key]; !ok {
+		diags.AddError(
+			"Unable to Match List Elements",
+			"The list element objects do not contain the key attribute. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Missing Key Attribute: %q", key),
+		)
+
+		return nil, diags
+	}
+
+	if object.IsNull() || object.IsUnknown() {
+		return nil, diags
+	}
+
+	keyValue := object.Attributes()[key]
+
+	if keyValue == nil || keyValue.IsNull() || keyValue.IsUnknown() {
+		return nil, diags
+	}
+
+	return keyValue, diags
+}
+
+// matchListElementKeyIndex returns the index of the equal key, or -1 if the
+// key is nil or not found.
+func matchListElementKeyIndex(keys []attr.Value, key attr.Value) int {
+	if key == nil {
+		return -1
+	}
+
+	for index, k := range keys {
+		if k != nil && k.Equal(key) {
+			return index
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,167 @@
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMatchListElementsByKey(t *testing.T) {
+	t.Parallel()
+
+	testAttributeTypes := map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	}
+
+	testElementType := types.ObjectType{
+		AttrTypes: testAttributeTypes,
+	}
+
+	testElement := func(id attr.Value, name string) attr.Value {
+		return types.ObjectValueMust(
+			testAttributeTypes,
+			map[string]attr.Value{
+				"id":   id,
+				"name": types.StringValue(name),
+			},
+		)
+	}
+
+	testList := func(elements ...attr.Value) types.List {
+		return types.ListValueMust(testElementType, elements)
+	}
+
+	testCases := map[string]struct {
+		prior         types.List
+		current       types.List
+		key           string
+		expected      types.List
+		expectedDiags diag.Diagnostics
+	}{
+		"reordered": {
+			prior: testList(
+				testElement(types.StringValue("a"), "one"),
+				testElement(types.StringValue("b"), "two"),
+				testElement(types.StringValue("c"), "three"),
+			),
+			current: testList(
+				testElement(types.StringValue("c"), "three"),
+				testElement(types.StringValue("a"), "one"),
+				testElement(types.StringValue("b"), "two-updated"),
+			),
+			key: "id",
+			expected: testList(
+				testElement(types.StringValue("a"), "one"),
+				testElement(types.StringValue("b"), "two-updated"),
+				testElement(types.StringValue("c"), "three"),
+			),
+		},
+		"added-and-removed": {
+			prior: testList(
+				testElement(types.StringValue("a"), "one"),
+				testElement(types.StringValue("b"), "two"),
+			),
+			current: testList(
+				testElement(types.StringValue("d"), "four"),
+				testElement(types.StringNull(), "null"),
+				testElement(types.StringValue("b"), "two"),
+			),
+			key: "id",
+			expected: testList(
+				testElement(types.StringValue("b"), "two"),
+				testElement(types.StringValue("d"), "four"),
+				testElement(types.StringNull(), "null"),
+			),
+		},
+		"prior-null": {
+			prior: types.ListNull(testElementType),
+			current: testList(
+				testElement(types.StringValue("b"), "two"),
+				testElement(types.StringValue("a"), "one"),
+			),
+			key: "id",
+			expected: testList(
+				testElement(types.StringValue("b"), "two"),
+				testElement(types.StringValue("a"), "one"),
+			),
+		},
+		"current-unknown": {
+			prior:    testList(testElement(types.StringValue("a"), "one")),
+			current:  types.ListUnknown(testElementType),
+			key:      "id",
+			expected: types.ListUnknown(testElementType),
+		},
+		"duplicate-current-keys": {
+			prior: testList(testElement(types.StringValue("a"), "one")),
+			current: testList(
+				testElement(types.StringValue("a"), "one"),
+				testElement(types.StringValue("a"), "two"),
+			),
+			key: "id",
+			expected: testList(
+				testElement(types.StringValue("a"), "one"),
+				testElement(types.StringValue("a"), "two"),
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Match List Elements",
+					"The current list contains multiple elements with the same key, so they cannot be matched to the prior list elements. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`Duplicate "id" Attribute Value: "a"`,
+				),
+			},
+		},
+		"missing-key-attribute": {
+			prior:    testList(testElement(types.StringValue("a"), "one")),
+			current:  testList(testElement(types.StringValue("a"), "one")),
+			key:      "missing",
+			expected: testList(testElement(types.StringValue("a"), "one")),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Match List Elements",
+					"The list element objects do not contain the key attribute. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`Missing Key Attribute: "missing"`,
+				),
+			},
+		},
+		"non-object-elements": {
+			prior:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			current:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			key:      "id",
+			expected: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Match List Elements",
+					"The list elements must be objects to be matched by key. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Element 0 Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := resource.MatchListElementsByKey(context.Background(), testCase.prior, testCase.current, testCase.key)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected list difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package resource
+
+import (
+	"context"
+)
+
+// PrivateStateCodec encrypts and decrypts resource private state values, so
+// sensitive bookkeeping data, such as temporary credentials, is protected
+// while stored in the Terraform state. Register a PrivateStateCodec with the
+// providerserver.ServeOpts type PrivateStateCodec field.
+//
+// The codec is applied transparently. Values set with the private state
+// SetKey method are encrypted before the response is returned to Terraform
+// and encrypted values are decrypted before the resource methods are called,
+// so GetKey always returns the unencrypted value. Prior private state values
+// which were not encrypted, such as those saved before a codec was
+// registered, are kept as is until they are next returned to Terraform.
+// Framework private state data is never passed to the codec.
+type PrivateStateCodec interface {
+	// Encrypt returns the encrypted form of the private state value at the
+	// given key. Returning an error raises an error diagnostic.
+	Encrypt(ctx context.Context, key string, value []byte) ([]byte, error)
+
+	// Decrypt returns the value originally given to Encrypt for the private
+	// state value at the given key. Returning an error raises an error
+	// diagnostic.
+	Decrypt(ctx context.Context, key string, value []byte) ([]byte, error)
+}
@@ -0,0 +1,44 @@
+package resource
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// DefaultReadIncrementalMaxAge is the maximum duration since the last full
+// refresh of a resource which implements ResourceWithReadIncremental, after
+// which Read is called instead of ReadIncremental, unless the resource
+// implements ResourceWithReadIncrementalMaxAge.
+const DefaultReadIncrementalMaxAge = 24 * time.Hour
+
+// ReadIncrementalRequest represents a request for the provider to read only
+// some values of a resource, i.e., update values in state according to the
+// real state of the resource. An instance of this request struct is supplied
+// as an argument to the ResourceWithReadIncremental interface ReadIncremental
+// method.
+type ReadIncrementalRequest struct {
+	// State is the current state of the resource prior to the
+	// ReadIncremental operation.
+	State tfsdk.State
+
+	// Paths are the top level attribute and block paths whose values were
+	// changed since the last refresh of the resource. Only values at these
+	// paths need to be read and set in ReadResponse.State. If empty, no values
+	// were changed since the last refresh.
+	Paths path.Paths
+
+	// Private is provider-defined resource private state data which was previously
+	// stored with the resource state. This data is opaque to Terraform and does
+	// not affect plan output. Any existing data is copied to
+	// ReadResponse.Private to prevent accidental private state data loss.
+	//
+	// Use the GetKey method to read data. Use the SetKey method on
+	// ReadResponse.Private to update or remove a value.
+	Private *privatestate.ProviderData
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta tfsdk.Config
+}
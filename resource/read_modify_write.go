@@ -0,0 +1,224 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
+// ErrUpdateConflict should be returned, or wrapped, by the ReadModifyWrite
+// type Write function when the conditional write was rejected because the
+// remote object changed after it was read, such as an HTTP 412 Precondition
+// Failed response to a request with an If-Match header.
+var ErrUpdateConflict = errors.New("resource update conflict")
+
+const (
+	// DefaultReadModifyWriteETagKey is the provider private state key which
+	// ReadModifyWrite uses to store the ETag, if ETagKey is not set.
+	DefaultReadModifyWriteETagKey = "etag"
+
+	// DefaultReadModifyWriteMaxAttempts is the number of read, modify, and
+	// write attempts which ReadModifyWrite makes, if MaxAttempts is not set.
+	DefaultReadModifyWriteMaxAttempts = 3
+
+	// DefaultReadModifyWriteRetryDelay is the delay between attempts which
+	// ReadModifyWrite waits after a conflict, if RetryDelay is not set.
+	DefaultReadModifyWriteRetryDelay = time.Second
+)
+
+// ReadModifyWrite is an opt-in helper, which can be called from the Update
+// method of a resource, that structures an update of a remote object against
+// APIs supporting optimistic concurrency, such as with ETag response headers
+// and If-Match request headers. Each attempt:
+//
+//   - Reads the current remote object and its ETag.
+//   - Modifies the current remote object with the planned changes.
+//   - Writes the modified remote object only if the remote ETag is unchanged.
+//
+// If the write returns ErrUpdateConflict, because another client changed the
+// remote object in between, the whole attempt is retried with the updated
+// remote object. The ETag of the successful write is stored in the resource
+// private state, so it is available in later operations with the ETag
+// method. Out-of-band changes are therefore merged with the planned changes
+// instead of being silently overwritten.
+//
+// The type parameter T is the provider defined representation of the remote
+// object, such as an API client model.
+type ReadModifyWrite[T any] struct {
+	// Read should return the current remote object and its ETag.
+	Read func(ctx context.Context) (current T, etag string, diags diag.Diagnostics)
+
+	// Modify should return the current remote object with the planned
+	// changes applied, typically from the UpdateRequest Plan.
+	Modify func(ctx context.Context, current T) (desired T, diags diag.Diagnostics)
+
+	// Write should write the desired remote object, conditional on the remote
+	// ETag still matching the given ETag, and return the written remote
+	// object and its new ETag. Write should return an error wrapping
+	// ErrUpdateConflict if the condition failed, which retries the update.
+	Write func(ctx context.Context, desired T, etag string) (written T, newETag string, err error)
+
+	// ETagKey is the provider private state key for storing the ETag.
+	// Defaults to DefaultReadModifyWriteETagKey.
+	ETagKey string
+
+	// MaxAttempts is the maximum number of attempts, including the first
+	// attempt, before returning an error diagnostic for the conflict.
+	// Defaults to DefaultReadModifyWriteMaxAttempts.
+	MaxAttempts int
+
+	// RetryDelay is the delay before retrying after a conflict. Defaults to
+	// DefaultReadModifyWriteRetryDelay.
+	RetryDelay time.Duration
+}
+
+// Update runs the read, modify, and write attempts and returns the written
+// remote object, which is typically used to set the UpdateResponse State.
+// The ETag is stored in the UpdateResponse Private. Diagnostics are appended
+// to the UpdateResponse Diagnostics and the returned remote object should
+// not be used if they contain an error.
+func (rmw ReadModifyWrite[T]) Update(ctx context.Context, resp *UpdateResponse) T {
+	var written T
+
+	if rmw.Read == nil || rmw.Modify == nil || rmw.Write == nil {
+		resp.Diagnostics.AddError(
+			"Invalid ReadModifyWrite Implementation",
+			"The ReadModifyWrite Read, Modify, and Write functions must all be set. "+
+				"This is always an issue in the provider and should be reported to the provider developers.",
+		)
+
+		return written
+	}
+
+	maxAttempts := rmw.MaxAttempts
+
+	if maxAttempts < 1 {
+		maxAttempts = DefaultReadModifyWriteMaxAttempts
+	}
+
+	retryDelay := rmw.RetryDelay
+
+	if retryDelay <= 0 {
+		retryDelay = DefaultReadModifyWriteRetryDelay
+	}
+
+	var writeErr error
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if attempt > 1 {
+			logging.FrameworkDebug(
+				ctx,
+				"Retrying ReadModifyWrite after conflict",
+				map[string]interface{}{
+					"attempt":     attempt,
+					"retry_delay": retryDelay.String(),
+				},
+			)
+
+			select {
+			case <-ctx.Done():
+				resp.Diagnostics.AddError(
+					"Unable to Update Resource",
+					"The resource update was cancelled while waiting to retry after a conflicting remote change.\n\n"+
+						"Error: "+writeErr.Error(),
+				)
+
+				return written
+			case <-time.After(retryDelay):
+			}
+		}
+
+		current, etag, diags := rmw.Read(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return written
+		}
+
+		desired, diags := rmw.Modify(ctx, current)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return written
+		}
+
+		var newETag string
+
+		written, newETag, writeErr = rmw.Write(ctx, desired, etag)
+
+		if writeErr == nil {
+			resp.Diagnostics.Append(rmw.SetETag(ctx, resp.Private, newETag)...)
+
+			return written
+		}
+
+		if !errors.Is(writeErr, ErrUpdateConflict) {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				"An unexpected error occurred while writing the remote object.\n\n"+
+					"Error: "+writeErr.Error(),
+			)
+
+			return written
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Resource Update Conflict",
+		fmt.Sprintf("The remote object was changed by another client during each of the %d update attempts. ", maxAttempts)+
+			"Verify no other clients are continuously updating the remote object and apply again.\n\n"+
+			"Error: "+writeErr.Error(),
+	)
+
+	return written
+}
+
+// ETag returns the ETag stored in the given private state, or an empty
+// string if no ETag is stored.
+func (rmw ReadModifyWrite[T]) ETag(ctx context.Context, private *privatestate.ProviderData) (string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, rmw.etagKey())
+
+	if diags.HasError() || len(value) == 0 {
+		return "", diags
+	}
+
+	var etag string
+
+	if err := json.Unmarshal(value, &etag); err != nil {
+		diags.AddError(
+			"Unable to Read Resource Private State",
+			fmt.Sprintf("An unexpected error occurred while reading the ETag from the %q private state key. ", rmw.etagKey())+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	return etag, diags
+}
+
+// SetETag stores the ETag in the given private state, such as the
+// CreateResponse or ReadResponse Private, so the ETag is available for later
+// operations.
+func (rmw ReadModifyWrite[T]) SetETag(ctx context.Context, private *privatestate.ProviderData, etag string) diag.Diagnostics {
+	// Marshaling a string cannot return an error.
+	value, _ := json.Marshal(etag)
+
+	return private.SetKey(ctx, rmw.etagKey(), value)
+}
+
+// etagKey returns the private state key for the ETag.
+func (rmw ReadModifyWrite[T]) etagKey() string {
+	if rmw.ETagKey == "" {
+		return DefaultReadModifyWriteETagKey
+	}
+
+	return rmw.ETagKey
+}
@@ -0,0 +1,142 @@
+package resource_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestReadModifyWriteUpdate(t *testing.T) {
+	t.Parallel()
+
+	// testRemote is a remote object with an ETag, which changes on each write.
+	type testRemote struct {
+		name    string
+		version int
+	}
+
+	testReadModifyWrite := func(remote *testRemote, conflicts int) resource.ReadModifyWrite[testRemote] {
+		return resource.ReadModifyWrite[testRemote]{
+			Read: func(_ context.Context) (testRemote, string, diag.Diagnostics) {
+				return *remote, fmt.Sprintf("etag-%d", remote.version), nil
+			},
+			Modify: func(_ context.Context, current testRemote) (testRemote, diag.Diagnostics) {
+				current.name = "planned"
+
+				return current, nil
+			},
+			Write: func(_ context.Context, desired testRemote, etag string) (testRemote, string, error) {
+				if conflicts > 0 {
+					conflicts--
+					remote.version++
+
+					return testRemote{}, "", fmt.Errorf("precondition failed: %w", resource.ErrUpdateConflict)
+				}
+
+				if etag != fmt.Sprintf("etag-%d", remote.version) {
+					return testRemote{}, "", errors.New("unexpected etag")
+				}
+
+				desired.version++
+				*remote = desired
+
+				return desired, fmt.Sprintf("etag-%d", desired.version), nil
+			},
+			RetryDelay: time.Millisecond,
+		}
+	}
+
+	testCases := map[string]struct {
+		conflicts     int
+		maxAttempts   int
+		expected      testRemote
+		expectedETag  string
+		expectedDiags diag.Diagnostics
+	}{
+		"success": {
+			expected:     testRemote{name: "planned", version: 2},
+			expectedETag: "etag-2",
+		},
+		"conflict-retry": {
+			conflicts:    2,
+			expected:     testRemote{name: "planned", version: 4},
+			expectedETag: "etag-4",
+		},
+		"conflict-max-attempts": {
+			conflicts:   2,
+			maxAttempts: 2,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Update Conflict",
+					"The remote object was changed by another client during each of the 2 update attempts. "+
+						"Verify no other clients are continuously updating the remote object and apply again.\n\n"+
+						"Error: precondition failed: resource update conflict",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			remote := &testRemote{name: "original", version: 1}
+			rmw := testReadModifyWrite(remote, testCase.conflicts)
+			rmw.MaxAttempts = testCase.maxAttempts
+
+			resp := &resource.UpdateResponse{
+				Private: privatestate.EmptyProviderData(ctx),
+			}
+
+			got := rmw.Update(ctx, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(testRemote{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			gotETag, diags := rmw.ETag(ctx, resp.Private)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if gotETag != testCase.expectedETag {
+				t.Errorf("expected ETag %q, got %q", testCase.expectedETag, gotETag)
+			}
+		})
+	}
+}
+
+func TestReadModifyWriteUpdate_missingFunctions(t *testing.T) {
+	t.Parallel()
+
+	resp := &resource.UpdateResponse{}
+
+	resource.ReadModifyWrite[string]{}.Update(context.Background(), resp)
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Invalid ReadModifyWrite Implementation",
+			"The ReadModifyWrite Read, Modify, and Write functions must all be set. "+
+				"This is always an issue in the provider and should be reported to the provider developers.",
+		),
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"time"
 )
 
 // Resource represents an instance of a managed resource type. This is the core
@@ -16,6 +17,14 @@ import (
 //   - Plan Modification: Schema-based or entire plan
 //     via ResourceWithModifyPlan.
 //   - State Upgrades: ResourceWithUpgradeState
+//   - Schema History: ResourceWithSchemaHistory
+//   - Finalization: ResourceWithAfterApply
+//   - Incremental Refresh: ResourceWithReadIncremental and
+//     ResourceWithReadIncrementalMaxAge
+//   - Attribute Renames: ResourceWithAttributeAliases
+//   - Resource Type Renames: ResourceWithLegacyTypeNames
+//   - State Canonicalization: ResourceWithStateCanonicalizers
+//   - Provider-Internal Ordering: ResourceWithApplyDependsOn
 //
 // Although not required, it is conventional for resources to implement the
 // ResourceWithImportState interface.
@@ -51,6 +60,72 @@ type Resource interface {
 	Delete(context.Context, DeleteRequest, *DeleteResponse)
 }
 
+// ResourceWithAfterApply is an interface type that extends Resource to
+// include a method which the framework will automatically call after the
+// Create or Update method completes without error diagnostics, once the new
+// resource state is finalized but before it is returned to Terraform. This is
+// intended for logic which is separate from the API interaction, such as
+// cache invalidation, event emission, or verification reads.
+//
+// The AfterApply method cannot modify the resource state.
+type ResourceWithAfterApply interface {
+	Resource
+
+	// AfterApply is called after the resource was successfully created or
+	// updated.
+	AfterApply(context.Context, AfterApplyRequest, *AfterApplyResponse)
+}
+
+// ResourceWithApplyDependsOn is an interface type that extends Resource to
+// declare provider-internal soft dependencies on other resource types, such
+// as when the remote system rejects operations on this resource type while
+// operations on another resource type are in progress. Terraform cannot see
+// these dependencies unless they are expressed in the configuration.
+//
+// Before this resource is created, updated, or deleted, the framework waits
+// until no create, update, or delete operations of the given resource types
+// are in progress within the provider. Operations which start later are not
+// waited on, so this reduces but does not replace dependencies in the
+// configuration. Including this resource type serializes its own operations.
+type ResourceWithApplyDependsOn interface {
+	Resource
+
+	// ApplyDependsOn returns the resource type names, such as
+	// "examplecloud_network", whose in-progress operations must finish before
+	// operations of this resource start.
+	ApplyDependsOn(context.Context) []string
+}
+
+// ResourceWithAttributeAliases is an interface type that extends Resource to
+// declare attribute renames, so renaming an attribute does not require a
+// schema version and state upgrader.
+//
+// When the framework reads the prior resource state during the
+// UpgradeResourceState RPC with the current schema version, the value of
+// each previous attribute is copied to the current attribute, if the current
+// attribute is missing or null. The previous attribute is then removed from
+// the state, unless it is still defined in the schema. States returned by a
+// StateUpgrader are mapped the same way.
+//
+// To support existing configurations during a transition period, keep the
+// previous attribute defined in the schema as Optional. The framework then
+// returns a warning diagnostic when the previous attribute is configured and
+// an error diagnostic when both attributes are configured. During the
+// ValidateResourceConfig and PlanResourceChange RPCs, a configured previous
+// attribute value is copied to the current attribute in the configuration,
+// and in the plan if the current attribute is Computed, as Terraform
+// requires other planned values to match the configuration.
+type ResourceWithAttributeAliases interface {
+	Resource
+
+	// AttributeAliases returns a mapping of previous attribute paths to
+	// current attribute paths. Nested attributes are separated with dots
+	// and omit list, map, and set elements, such as "rule.from_port" to
+	// "rule.port". The previous and current attributes must be within the
+	// same object.
+	AttributeAliases(context.Context) map[string]string
+}
+
 // ResourceWithConfigure is an interface type that extends Resource to
 // include a method which the framework will automatically call so provider
 // developers have the opportunity to setup any necessary provider-level data
@@ -98,6 +173,29 @@ type ResourceWithImportState interface {
 	ImportState(context.Context, ImportStateRequest, *ImportStateResponse)
 }
 
+// ResourceWithLegacyTypeNames is an interface type that extends Resource to
+// declare previous resource type names, so renaming a resource type does not
+// require practitioners to update their configurations immediately.
+//
+// The framework serves the resource under each legacy type name in addition
+// to the type name returned by the Metadata method, using the same schema
+// and methods. Resources using a legacy type name are read-only: they can be
+// refreshed, imported, and destroyed, however planning a resource creation
+// or any change to the resource returns an error diagnostic. Validating a
+// configuration using a legacy type name returns a warning diagnostic.
+//
+// Terraform versions supported by this framework cannot move resource state
+// between resource types with the moved configuration block, so
+// practitioners must remove resources from the state and import them again
+// with the current resource type name.
+type ResourceWithLegacyTypeNames interface {
+	Resource
+
+	// LegacyTypeNames returns the previous full names of the resource, such
+	// as examplecloud_old_thing.
+	LegacyTypeNames(context.Context) []string
+}
+
 // ResourceWithModifyPlan represents a resource instance with a ModifyPlan
 // function.
 type ResourceWithModifyPlan interface {
@@ -124,6 +222,78 @@ type ResourceWithModifyPlan interface {
 	ModifyPlan(context.Context, ModifyPlanRequest, *ModifyPlanResponse)
 }
 
+// ResourceWithReadIncremental is an interface type that extends Resource to
+// include a method which the framework will call instead of Read when it is
+// only necessary to refresh the attribute values which may be out of date.
+// This is intended for resources representing large remote objects, where
+// fetching and updating the entire object on every refresh is expensive.
+//
+// After each successful refresh, the framework records a fingerprint of each
+// top level attribute and block value in the resource private state. When the
+// fingerprints are available, ReadIncremental is called with the paths of the
+// values which were changed since the last refresh, such as by Create or
+// Update. Values which were not changed since the last refresh are not
+// requested, therefore changes made outside Terraform to those values are not
+// detected until the resource is fully refreshed. Read is still called when
+// no fingerprints were recorded, such as the first refresh after Create or
+// ImportState, when the resource private state was removed, and when the last
+// full refresh is older than DefaultReadIncrementalMaxAge or the duration
+// returned by the ResourceWithReadIncrementalMaxAge interface.
+type ResourceWithReadIncremental interface {
+	Resource
+
+	// ReadIncremental is called when the provider must read resource values
+	// at the requested paths in order to update state. Values at other paths
+	// are copied from the current state.
+	ReadIncremental(context.Context, ReadIncrementalRequest, *ReadResponse)
+}
+
+// ResourceWithReadIncrementalMaxAge is an interface type that extends
+// ResourceWithReadIncremental to configure the maximum duration since the
+// last full refresh before Read is called instead of ReadIncremental, which
+// bounds how long changes made outside Terraform can remain undetected.
+type ResourceWithReadIncrementalMaxAge interface {
+	ResourceWithReadIncremental
+
+	// ReadIncrementalMaxAge should return the maximum duration since the last
+	// full refresh. Zero uses DefaultReadIncrementalMaxAge and negative values
+	// disable full refreshes after the initial Read.
+	ReadIncrementalMaxAge(context.Context) time.Duration
+}
+
+// ResourceWithSchemaHistory is an interface type that extends Resource to
+// include schema version history metadata. The history is not used by
+// Terraform, but is embedded in the provider binary and can be queried via
+// the providerserver.ResourceSchemaHistories function, such as to power
+// tooling which describes the changes between provider releases.
+type ResourceWithSchemaHistory interface {
+	Resource
+
+	// SchemaHistory returns the list of changes to the resource schema. The
+	// Version of each change must not be greater than the current schema
+	// version. The framework will sort the changes by Version, preserving the
+	// order of changes for the same Version.
+	SchemaHistory(context.Context) []SchemaChange
+}
+
+// ResourceWithStateCanonicalizers is an interface type that extends Resource
+// to convert attribute values into a canonical form before the resource state
+// is returned to Terraform, such as sorting lists or normalizing JSON
+// strings, so the stored state is deterministic across applies.
+//
+// The framework applies the state canonicalizers after the Create, Read, and
+// Update methods, including semantic equality handling. After Create and
+// Update, values which were known in the plan are not canonicalized, since
+// Terraform requires them to match the new state. Canonicalizers are
+// therefore most useful for computed values.
+type ResourceWithStateCanonicalizers interface {
+	Resource
+
+	// StateCanonicalizers returns the state canonicalizers, which are
+	// applied in order.
+	StateCanonicalizers(context.Context) []StateCanonicalizer
+}
+
 // Optional interface on top of Resource that enables provider control over
 // the UpgradeResourceState RPC. This RPC is automatically called by Terraform
 // when the current Schema type Version field is greater than the stored state.
@@ -0,0 +1,182 @@
+package resourceexample
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Config returns a minimal example Terraform configuration for a resource of
+// the given type name, such as "examplecloud_thing", and schema. Only
+// required attributes are included, with placeholder values based on the
+// attribute type:
+//
+//   - Bool attributes are false and number attributes are 0.
+//   - String attributes are "example".
+//   - List, set, and tuple attributes contain one element and map attributes
+//     contain one "key" element, each with a placeholder value.
+//   - Object attributes include all object attributes with placeholder values.
+//   - Nested attributes include their required nested attributes.
+//
+// Attributes are sorted by name and the equals signs of consecutive single
+// line attributes are aligned, matching the terraform fmt command output.
+// The placeholder values are not guaranteed to pass attribute validation.
+func Config(ctx context.Context, typeName string, s schema.Schema) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "resource %q \"example\" {\n", typeName)
+
+	writeAttributes(ctx, &b, 1, s.GetAttributes())
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// writeAttributes writes the required attributes at the indentation level.
+func writeAttributes(ctx context.Context, b *strings.Builder, level int, attributes map[string]fwschema.Attribute) {
+	names := make([]string, 0, len(attributes))
+
+	for name, attribute := range attributes {
+		if attribute.IsRequired() {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	values := make([]string, 0, len(names))
+
+	for _, name := range names {
+		values = append(values, attributeValue(ctx, level, attributes[name]))
+	}
+
+	writeAligned(b, level, names, values)
+}
+
+// writeAligned writes each name and value, aligning the equals signs of
+// consecutive single line values.
+func writeAligned(b *strings.Builder, level int, names []string, values []string) {
+	indent := strings.Repeat("  ", level)
+
+	for start := 0; start < len(names); {
+		end := start + 1
+
+		if !strings.Contains(values[start], "\n") {
+			for end < len(names) && !strings.Contains(values[end], "\n") {
+				end++
+			}
+		}
+
+		width := 0
+
+		for _, name := range names[start:end] {
+			if len(name) > width {
+				width = len(name)
+			}
+		}
+
+		for i := start; i < end; i++ {
+			fmt.Fprintf(b, "%s%-*s = %s\n", indent, width, names[i], values[i])
+		}
+
+		start = end
+	}
+}
+
+// attributeValue returns the placeholder value of the attribute.
+func attributeValue(ctx context.Context, level int, attribute fwschema.Attribute) string {
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok || nestedAttribute.GetNestedObject() == nil {
+		return typeValue(level, attribute.GetType().TerraformType(ctx))
+	}
+
+	indent := strings.Repeat("  ", level)
+	attributes := nestedAttribute.GetNestedObject().GetAttributes()
+
+	switch nestedAttribute.GetNestingMode() {
+	case fwschema.NestingModeList, fwschema.NestingModeSet:
+		return "[\n" + indent + "  " + nestedObjectValue(ctx, level+1, attributes) + ",\n" + indent + "]"
+	case fwschema.NestingModeMap:
+		return "{\n" + indent + "  key = " + nestedObjectValue(ctx, level+1, attributes) + "\n" + indent + "}"
+	default:
+		return nestedObjectValue(ctx, level, attributes)
+	}
+}
+
+// nestedObjectValue returns the placeholder value of a nested attribute
+// object, where the closing brace is at the indentation level.
+func nestedObjectValue(ctx context.Context, level int, attributes map[string]fwschema.Attribute) string {
+	var b strings.Builder
+
+	b.WriteString("{\n")
+	writeAttributes(ctx, &b, level+1, attributes)
+	b.WriteString(strings.Repeat("  ", level) + "}")
+
+	return b.String()
+}
+
+// typeValue returns the placeholder value of the Terraform type.
+func typeValue(level int, t tftypes.Type) string {
+	indent := strings.Repeat("  ", level)
+
+	switch t := t.(type) {
+	case tftypes.List:
+		return "[" + typeValue(level, t.ElementType) + "]"
+	case tftypes.Set:
+		return "[" + typeValue(level, t.ElementType) + "]"
+	case tftypes.Tuple:
+		elements := make([]string, 0, len(t.ElementTypes))
+
+		for _, elementType := range t.ElementTypes {
+			elements = append(elements, typeValue(level, elementType))
+		}
+
+		return "[" + strings.Join(elements, ", ") + "]"
+	case tftypes.Map:
+		return "{\n" + indent + "  key = " + typeValue(level+1, t.ElementType) + "\n" + indent + "}"
+	case tftypes.Object:
+		if len(t.AttributeTypes) == 0 {
+			return "{}"
+		}
+
+		names := make([]string, 0, len(t.AttributeTypes))
+
+		for name := range t.AttributeTypes {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		values := make([]string, 0, len(names))
+
+		for _, name := range names {
+			values = append(values, typeValue(level+1, t.AttributeTypes[name]))
+		}
+
+		var b strings.Builder
+
+		b.WriteString("{\n")
+		writeAligned(&b, level+1, names, values)
+		b.WriteString(indent + "}")
+
+		return b.String()
+	}
+
+	switch {
+	case t.Is(tftypes.Bool):
+		return "false"
+	case t.Is(tftypes.Number):
+		return "0"
+	case t.Is(tftypes.String):
+		return `"example"`
+	}
+
+	return "null"
+}
@@ -0,0 +1,163 @@
+package resourceexample_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/resourceexample"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected string
+	}{
+		"empty": {
+			schema: schema.Schema{},
+			expected: `resource "examplecloud_thing" "example" {
+}
+`,
+		},
+		"primitives": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Required: true,
+					},
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"description": schema.StringAttribute{
+						Optional: true,
+					},
+					"size": schema.Int64Attribute{
+						Required: true,
+					},
+				},
+			},
+			expected: `resource "examplecloud_thing" "example" {
+  enabled = false
+  name    = "example"
+  size    = 0
+}
+`,
+		},
+		"collections": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"ports": schema.ListAttribute{
+						ElementType: types.Int64Type,
+						Required:    true,
+					},
+					"settings": schema.ObjectAttribute{
+						AttributeTypes: map[string]attr.Type{
+							"key":     types.StringType,
+							"enabled": types.BoolType,
+						},
+						Required: true,
+					},
+					"tags": schema.MapAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+					},
+					"zones": schema.SetAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+					},
+				},
+			},
+			expected: `resource "examplecloud_thing" "example" {
+  ports = [0]
+  settings = {
+    enabled = false
+    key     = "example"
+  }
+  tags = {
+    key = "example"
+  }
+  zones = ["example"]
+}
+`,
+		},
+		"nested-attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"network": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"cidr": schema.StringAttribute{
+								Required: true,
+							},
+							"name": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Required: true,
+					},
+					"rules": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"port": schema.Int64Attribute{
+									Required: true,
+								},
+								"protocol": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+						Required: true,
+					},
+					"users": schema.MapNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"role": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+						Required: true,
+					},
+				},
+			},
+			expected: `resource "examplecloud_thing" "example" {
+  network = {
+    cidr = "example"
+  }
+  rules = [
+    {
+      port     = 0
+      protocol = "example"
+    },
+  ]
+  users = {
+    key = {
+      role = "example"
+    }
+  }
+}
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := resourceexample.Config(context.Background(), "examplecloud_thing", testCase.schema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,4 @@
+// Package resourceexample contains functions for rendering example Terraform
+// configurations of resources from their schemas, such as for provider
+// documentation pipelines, so examples stay synchronized with the schemas.
+package resourceexample
@@ -0,0 +1,4 @@
+// Package resourceschema contains functions for generating resource schemas
+// from annotated Go model structs, such as for simple resources where
+// keeping a separate schema and model synchronized is error prone.
+package resourceschema
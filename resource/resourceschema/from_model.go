@@ -0,0 +1,559 @@
+package resourceschema
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Struct tag options supported in the "tfsdk" struct tag after the attribute
+// name, such as `tfsdk:"name,required"`.
+const (
+	// OptionComputed sets the attribute Computed field.
+	OptionComputed = "computed"
+
+	// OptionOptional sets the attribute Optional field.
+	OptionOptional = "optional"
+
+	// OptionRequired sets the attribute Required field.
+	OptionRequired = "required"
+
+	// OptionSensitive sets the attribute Sensitive field.
+	OptionSensitive = "sensitive"
+
+	// OptionSet generates a set attribute, rather than a list attribute,
+	// for Go slice fields.
+	OptionSet = "set"
+)
+
+// DescriptionTag is the struct tag which sets the attribute Description
+// field, such as `description:"Name of the thing."`.
+const DescriptionTag = "description"
+
+// FromModel returns a resource schema generated from the given Go model
+// struct or pointer to a struct, which can also be used with the framework
+// Get and Set methods on plan, state, and configuration data. Each exported
+// field must have a "tfsdk" struct tag with the attribute name and one or
+// more of the OptionComputed, OptionOptional, OptionRequired, and
+// OptionSensitive options, such as:
+//
+//	type thingModel struct {
+//		ID   types.String `tfsdk:"id,computed" description:"Thing identifier."`
+//		Name types.String `tfsdk:"name,required"`
+//	}
+//
+// Fields tagged with "-" are skipped. Attribute types are determined by the
+// field type:
+//
+//   - Framework value types, such as types.String, and custom value types
+//     based on the bool, float64, int64, number, and string base types. Zero
+//     value list, map, object, and set types do not contain their element
+//     or attribute types, so use Go types for collections instead.
+//   - Go bool, float, integer, string, and *big.Float types, or pointers to
+//     them. Go types cannot represent unknown values, so use framework value
+//     types for computed attributes.
+//   - Go slices and maps with string keys, which become list or map
+//     attributes. Use OptionSet for set attributes.
+//   - Go structs, including slices and maps of structs, which become nested
+//     attributes.
+//
+// The generated schema can be further customized before it is returned from
+// the resource Schema method, such as adding validators or plan modifiers.
+func FromModel(ctx context.Context, model any) (schema.Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	modelType := reflect.TypeOf(model)
+
+	for modelType != nil && modelType.Kind() == reflect.Pointer {
+		modelType = modelType.Elem()
+	}
+
+	if modelType == nil || modelType.Kind() != reflect.Struct {
+		diags.AddError(
+			"Unable to Generate Schema",
+			"The resource schema could not be generated from the model. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Expected a struct model, got: %T", model),
+		)
+
+		return schema.Schema{}, diags
+	}
+
+	attributes, attributesDiags := structAttributes(ctx, path.Empty(), modelType)
+
+	diags.Append(attributesDiags...)
+
+	return schema.Schema{
+		Attributes: attributes,
+	}, diags
+}
+
+// attributeOptions are the schema attribute fields set from the struct tags.
+type attributeOptions struct {
+	computed    bool
+	description string
+	optional    bool
+	required    bool
+	sensitive   bool
+	set         bool
+}
+
+// structAttributes returns the schema attributes for each field of the given
+// struct type.
+func structAttributes(ctx context.Context, p path.Path, structType reflect.Type) (map[string]schema.Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := make(map[string]schema.Attribute, structType.NumField())
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name, tagOptions := refl.StructFieldTag(field)
+
+		if name == "-" {
+			continue
+		}
+
+		attributePath := p.AtName(name)
+
+		if name == "" {
+			diags.Append(attributeError(p, fmt.Sprintf("Field %s is missing the \"tfsdk\" struct tag.", field.Name)))
+
+			continue
+		}
+
+		if _, ok := attributes[name]; ok {
+			diags.Append(attributeError(attributePath, fmt.Sprintf("Field %s uses a duplicate attribute name.", field.Name)))
+
+			continue
+		}
+
+		options := attributeOptions{
+			description: field.Tag.Get(DescriptionTag),
+		}
+
+		for _, tagOption := range tagOptions {
+			switch tagOption {
+			case OptionComputed:
+				options.computed = true
+			case OptionOptional:
+				options.optional = true
+			case OptionRequired:
+				options.required = true
+			case OptionSensitive:
+				options.sensitive = true
+			case OptionSet:
+				options.set = true
+			default:
+				diags.Append(attributeError(attributePath, fmt.Sprintf("Field %s has unsupported struct tag option %q.", field.Name, tagOption)))
+			}
+		}
+
+		if !options.computed && !options.optional && !options.required {
+			diags.Append(attributeError(attributePath, fmt.Sprintf("Field %s must include one of the computed, optional, or required struct tag options.", field.Name)))
+
+			continue
+		}
+
+		attribute, attributeDiags := fieldAttribute(ctx, attributePath, field.Type, options)
+
+		diags.Append(attributeDiags...)
+
+		if attributeDiags.HasError() {
+			continue
+		}
+
+		attributes[name] = attribute
+	}
+
+	return attributes, diags
+}
+
+// fieldAttribute returns the schema attribute for the given field type.
+func fieldAttribute(ctx context.Context, p path.Path, fieldType reflect.Type, options attributeOptions) (schema.Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value, ok := reflect.Zero(fieldType).Interface().(attr.Value); ok && fieldType.Kind() != reflect.Pointer {
+		typ := value.Type(ctx)
+
+		if !knownAttrType(typ) {
+			diags.Append(attributeError(p, fmt.Sprintf("Unable to determine the element or attribute types of %s. Use a Go slice, map, or struct type instead.", fieldType)))
+
+			return nil, diags
+		}
+
+		return valueAttribute(p, typ, options)
+	}
+
+	goType := fieldType
+
+	if goType.Kind() == reflect.Pointer && goType != bigFloatType {
+		goType = goType.Elem()
+	}
+
+	switch goType.Kind() {
+	case reflect.Map, reflect.Slice:
+		elemType := goType.Elem()
+
+		if goType.Kind() == reflect.Map && goType.Key().Kind() != reflect.String {
+			diags.Append(attributeError(p, fmt.Sprintf("Map type %s must use string keys.", fieldType)))
+
+			return nil, diags
+		}
+
+		if elemType.Kind() == reflect.Pointer && elemType.Elem().Kind() == reflect.Struct && elemType != bigFloatType {
+			elemType = elemType.Elem()
+		}
+
+		if _, ok := reflect.Zero(elemType).Interface().(attr.Value); !ok && elemType.Kind() == reflect.Struct && elemType != bigFloatType.Elem() {
+			nestedAttributes, nestedDiags := structAttributes(ctx, p, elemType)
+
+			diags.Append(nestedDiags...)
+
+			return nestedAttribute(goType.Kind(), nestedAttributes, options), diags
+		}
+	case reflect.Struct:
+		if _, ok := reflect.Zero(goType).Interface().(attr.Value); !ok {
+			nestedAttributes, nestedDiags := structAttributes(ctx, p, goType)
+
+			diags.Append(nestedDiags...)
+
+			return schema.SingleNestedAttribute{
+				Attributes:  nestedAttributes,
+				Computed:    options.computed,
+				Description: options.description,
+				Optional:    options.optional,
+				Required:    options.required,
+				Sensitive:   options.sensitive,
+			}, diags
+		}
+	}
+
+	typ, err := goAttrType(ctx, fieldType, options.set)
+
+	if err != nil {
+		diags.Append(attributeError(p, "Unable to determine the attribute type: "+err.Error()))
+
+		return nil, diags
+	}
+
+	return valueAttribute(p, typ, options)
+}
+
+// bigFloatType is the reflect.Type of *big.Float, which is a pointer type
+// for number attributes.
+var bigFloatType = reflect.TypeOf((*big.Float)(nil))
+
+// goAttrType returns the framework type for the given Go type.
+func goAttrType(ctx context.Context, goType reflect.Type, set bool) (attr.Type, error) {
+	if goType == bigFloatType {
+		return types.NumberType, nil
+	}
+
+	if value, ok := reflect.Zero(goType).Interface().(attr.Value); ok && goType.Kind() != reflect.Pointer {
+		typ := value.Type(ctx)
+
+		if !knownAttrType(typ) {
+			return nil, fmt.Errorf("cannot determine element or attribute types of %s, use a Go slice, map, or struct type instead", goType)
+		}
+
+		return typ, nil
+	}
+
+	switch goType.Kind() {
+	case reflect.Pointer:
+		return goAttrType(ctx, goType.Elem(), set)
+	case reflect.Bool:
+		return types.BoolType, nil
+	case reflect.Float32, reflect.Float64:
+		return types.Float64Type, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return types.Int64Type, nil
+	case reflect.String:
+		return types.StringType, nil
+	case reflect.Map:
+		if goType.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("map type %s must use string keys", goType)
+		}
+
+		elemType, err := goAttrType(ctx, goType.Elem(), false)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.MapType{ElemType: elemType}, nil
+	case reflect.Slice:
+		elemType, err := goAttrType(ctx, goType.Elem(), false)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if set {
+			return types.SetType{ElemType: elemType}, nil
+		}
+
+		return types.ListType{ElemType: elemType}, nil
+	case reflect.Struct:
+		attrTypes := make(map[string]attr.Type, goType.NumField())
+
+		for i := 0; i < goType.NumField(); i++ {
+			field := goType.Field(i)
+
+			if !field.IsExported() {
+				continue
+			}
+
+			name, tagOptions := refl.StructFieldTag(field)
+
+			if name == "-" {
+				continue
+			}
+
+			if name == "" {
+				return nil, fmt.Errorf("field %s of %s is missing the \"tfsdk\" struct tag", field.Name, goType)
+			}
+
+			attrType, err := goAttrType(ctx, field.Type, hasOption(tagOptions, OptionSet))
+
+			if err != nil {
+				return nil, err
+			}
+
+			attrTypes[name] = attrType
+		}
+
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", goType)
+}
+
+// knownAttrType returns false if the framework type is a collection or
+// object type without element or attribute types.
+func knownAttrType(typ attr.Type) bool {
+	switch typ := typ.(type) {
+	case basetypes.ListType:
+		return typ.ElemType != nil
+	case basetypes.MapType:
+		return typ.ElemType != nil
+	case basetypes.ObjectType:
+		return typ.AttrTypes != nil
+	case basetypes.SetType:
+		return typ.ElemType != nil
+	}
+
+	return true
+}
+
+// valueAttribute returns the schema attribute for the given framework type.
+func valueAttribute(p path.Path, typ attr.Type, options attributeOptions) (schema.Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch typ := typ.(type) {
+	case basetypes.BoolType:
+		return schema.BoolAttribute{
+			Computed:    options.computed,
+			Description: options.description,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.Float64Type:
+		return schema.Float64Attribute{
+			Computed:    options.computed,
+			Description: options.description,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.Int64Type:
+		return schema.Int64Attribute{
+			Computed:    options.computed,
+			Description: options.description,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.NumberType:
+		return schema.NumberAttribute{
+			Computed:    options.computed,
+			Description: options.description,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.StringType:
+		return schema.StringAttribute{
+			Computed:    options.computed,
+			Description: options.description,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.ListType:
+		return schema.ListAttribute{
+			Computed:    options.computed,
+			Description: options.description,
+			ElementType: typ.ElemType,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.MapType:
+		return schema.MapAttribute{
+			Computed:    options.computed,
+			Description: options.description,
+			ElementType: typ.ElemType,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.ObjectType:
+		return schema.ObjectAttribute{
+			AttributeTypes: typ.AttrTypes,
+			Computed:       options.computed,
+			Description:    options.description,
+			Optional:       options.optional,
+			Required:       options.required,
+			Sensitive:      options.sensitive,
+		}, diags
+	case basetypes.SetType:
+		return schema.SetAttribute{
+			Computed:    options.computed,
+			Description: options.description,
+			ElementType: typ.ElemType,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.BoolTypable:
+		return schema.BoolAttribute{
+			Computed:    options.computed,
+			CustomType:  typ,
+			Description: options.description,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.Float64Typable:
+		return schema.Float64Attribute{
+			Computed:    options.computed,
+			CustomType:  typ,
+			Description: options.description,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.Int64Typable:
+		return schema.Int64Attribute{
+			Computed:    options.computed,
+			CustomType:  typ,
+			Description: options.description,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.NumberTypable:
+		return schema.NumberAttribute{
+			Computed:    options.computed,
+			CustomType:  typ,
+			Description: options.description,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	case basetypes.StringTypable:
+		return schema.StringAttribute{
+			Computed:    options.computed,
+			CustomType:  typ,
+			Description: options.description,
+			Optional:    options.optional,
+			Required:    options.required,
+			Sensitive:   options.sensitive,
+		}, diags
+	}
+
+	diags.Append(attributeError(p, fmt.Sprintf("Unsupported attribute type %s.", typ)))
+
+	return nil, diags
+}
+
+// nestedAttribute returns the list, map, or set nested attribute for the
+// given Go collection kind.
+func nestedAttribute(kind reflect.Kind, attributes map[string]schema.Attribute, options attributeOptions) schema.Attribute {
+	nestedObject := schema.NestedAttributeObject{
+		Attributes: attributes,
+	}
+
+	if kind == reflect.Map {
+		return schema.MapNestedAttribute{
+			Computed:     options.computed,
+			Description:  options.description,
+			NestedObject: nestedObject,
+			Optional:     options.optional,
+			Required:     options.required,
+			Sensitive:    options.sensitive,
+		}
+	}
+
+	if options.set {
+		return schema.SetNestedAttribute{
+			Computed:     options.computed,
+			Description:  options.description,
+			NestedObject: nestedObject,
+			Optional:     options.optional,
+			Required:     options.required,
+			Sensitive:    options.sensitive,
+		}
+	}
+
+	return schema.ListNestedAttribute{
+		Computed:     options.computed,
+		Description:  options.description,
+		NestedObject: nestedObject,
+		Optional:     options.optional,
+		Required:     options.required,
+		Sensitive:    options.sensitive,
+	}
+}
+
+// hasOption returns true if the struct tag options contain the option.
+func hasOption(options []string, option string) bool {
+	for _, o := range options {
+		if o == option {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attributeError returns an error diagnostic for the given path and detail.
+func attributeError(p path.Path, detail string) diag.Diagnostic {
+	summary := "Unable to Generate Schema"
+	detail = "The resource schema could not be generated from the model. " +
+		"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+		detail
+
+	if len(p.Steps()) == 0 {
+		return diag.NewErrorDiagnostic(summary, detail)
+	}
+
+	return diag.NewAttributeErrorDiagnostic(p, summary, detail)
+}
@@ -0,0 +1,356 @@
+package resourceschema_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/resourceschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFromModel(t *testing.T) {
+	t.Parallel()
+
+	type testNestedModel struct {
+		Name types.String `tfsdk:"name,required"`
+	}
+
+	testCases := map[string]struct {
+		model         any
+		expected      schema.Schema
+		expectedDiags diag.Diagnostics
+	}{
+		"framework-types": {
+			model: struct {
+				Bool    types.Bool    `tfsdk:"bool,optional"`
+				Float64 types.Float64 `tfsdk:"float64,optional,computed"`
+				ID      types.String  `tfsdk:"id,computed" description:"Identifier."`
+				Int64   types.Int64   `tfsdk:"int64,required"`
+				Number  types.Number  `tfsdk:"number,optional"`
+				Secret  types.String  `tfsdk:"secret,required,sensitive"`
+				Skipped types.String  `tfsdk:"-"`
+				private types.String  //nolint:unused
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"bool": schema.BoolAttribute{
+						Optional: true,
+					},
+					"float64": schema.Float64Attribute{
+						Computed: true,
+						Optional: true,
+					},
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "Identifier.",
+					},
+					"int64": schema.Int64Attribute{
+						Required: true,
+					},
+					"number": schema.NumberAttribute{
+						Optional: true,
+					},
+					"secret": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+		"go-types": {
+			model: &struct {
+				Bool    *bool      `tfsdk:"bool,optional"`
+				Float64 float64    `tfsdk:"float64,required"`
+				Int     int        `tfsdk:"int,required"`
+				Number  *big.Float `tfsdk:"number,required"`
+				String  string     `tfsdk:"string,required"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"bool": schema.BoolAttribute{
+						Optional: true,
+					},
+					"float64": schema.Float64Attribute{
+						Required: true,
+					},
+					"int": schema.Int64Attribute{
+						Required: true,
+					},
+					"number": schema.NumberAttribute{
+						Required: true,
+					},
+					"string": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+		"collections": {
+			model: struct {
+				List   []types.String          `tfsdk:"list,optional"`
+				Map    map[string]int64        `tfsdk:"map,optional"`
+				Nested [][]string              `tfsdk:"nested,optional"`
+				Object map[string]struct{}     `tfsdk:"object,optional"`
+				Set    []string                `tfsdk:"set,optional,set"`
+				Values map[string][]types.Bool `tfsdk:"values,optional"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"map": schema.MapAttribute{
+						ElementType: types.Int64Type,
+						Optional:    true,
+					},
+					"nested": schema.ListAttribute{
+						ElementType: types.ListType{ElemType: types.StringType},
+						Optional:    true,
+					},
+					"object": schema.MapNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{},
+						},
+						Optional: true,
+					},
+					"set": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"values": schema.MapAttribute{
+						ElementType: types.ListType{ElemType: types.BoolType},
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"nested-attributes": {
+			model: struct {
+				List   []testNestedModel          `tfsdk:"list,optional"`
+				Map    map[string]testNestedModel `tfsdk:"map,optional"`
+				Set    []*testNestedModel         `tfsdk:"set,optional,set"`
+				Single *testNestedModel           `tfsdk:"single,required" description:"Single nested."`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"map": schema.MapNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"set": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"single": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Required: true,
+							},
+						},
+						Description: "Single nested.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"not-struct": {
+			model:    "test",
+			expected: schema.Schema{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Generate Schema",
+					"The resource schema could not be generated from the model. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Expected a struct model, got: string",
+				),
+			},
+		},
+		"missing-tag": {
+			model: struct {
+				Name types.String
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Generate Schema",
+					"The resource schema could not be generated from the model. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Field Name is missing the \"tfsdk\" struct tag.",
+				),
+			},
+		},
+		"missing-options": {
+			model: struct {
+				Name types.String `tfsdk:"name"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Unable to Generate Schema",
+					"The resource schema could not be generated from the model. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Field Name must include one of the computed, optional, or required struct tag options.",
+				),
+			},
+		},
+		"unsupported-option": {
+			model: struct {
+				Name types.String `tfsdk:"name,required,secret"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Unable to Generate Schema",
+					"The resource schema could not be generated from the model. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Field Name has unsupported struct tag option \"secret\".",
+				),
+			},
+		},
+		"unknown-element-type": {
+			model: struct {
+				Nested struct {
+					Tags types.List `tfsdk:"tags,optional"`
+				} `tfsdk:"nested,optional"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("nested").AtName("tags"),
+					"Unable to Generate Schema",
+					"The resource schema could not be generated from the model. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Unable to determine the element or attribute types of basetypes.ListValue. Use a Go slice, map, or struct type instead.",
+				),
+			},
+		},
+		"unsupported-type": {
+			model: struct {
+				Channel chan string `tfsdk:"channel,optional"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("channel"),
+					"Unable to Generate Schema",
+					"The resource schema could not be generated from the model. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Unable to determine the attribute type: unsupported type chan string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := resourceschema.FromModel(context.Background(), testCase.model)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected schema difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromModel_StateSet(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		ID   types.String   `tfsdk:"id,computed"`
+		Name string         `tfsdk:"name,required"`
+		Tags []types.String `tfsdk:"tags,optional,set"`
+	}
+
+	ctx := context.Background()
+
+	s, diags := resourceschema.FromModel(ctx, testModel{})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		Schema: s,
+	}
+
+	diags = state.Set(ctx, testModel{
+		ID:   types.StringValue("test-id"),
+		Name: "test-name",
+		Tags: []types.String{types.StringValue("test-tag")},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	var got types.Set
+
+	diags = state.GetAttribute(ctx, path.Root("tags"), &got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	expected := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test-tag")})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
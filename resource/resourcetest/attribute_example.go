@@ -0,0 +1,148 @@
+package resourcetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributeExample is an example value for a resource schema attribute, which
+// is verified with the CheckAttributeExamples function. Examples act as
+// executable documentation of the values an attribute accepts and as
+// regression protection for attribute types, such as custom types, and
+// attribute validators.
+type AttributeExample struct {
+	// Path is the path of the attribute in the resource schema, such as
+	// path.Root("name").
+	Path path.Path
+
+	// Config is the example configuration value, which must conform to the
+	// attribute type, such as tftypes.NewValue(tftypes.String, "example")
+	// for a schema.StringAttribute.
+	Config tftypes.Value
+
+	// State is the expected value after the configuration value is decoded
+	// into the attribute value type and encoded again, as it would be saved
+	// into the resource state. If not set, the Config value is expected.
+	State tftypes.Value
+
+	// ExpectError should be true if the configuration value is expected to
+	// return an error diagnostic during decoding or validation at, or
+	// underneath, the attribute path.
+	ExpectError bool
+}
+
+// CheckAttributeExamples verifies each example against the resource schema
+// and returns an error combining all failed examples, or nil if all examples
+// passed. Every example is checked regardless of earlier failures.
+//
+// Each example configuration value is decoded with the attribute type, then
+// validated with the ValidateConfig function using a configuration where
+// all other attributes are null. Only diagnostics at, or underneath, the
+// example path are considered so unrelated validation, such as of required
+// attributes, does not affect the example. If no error is expected, the
+// decoded value is then encoded and compared with the expected State value.
+func CheckAttributeExamples(ctx context.Context, r resource.Resource, examples ...AttributeExample) error {
+	schemaResp := resource.SchemaResponse{}
+
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	if schemaResp.Diagnostics.HasError() {
+		return fmt.Errorf("unable to get resource schema: %s", diagsString(schemaResp.Diagnostics))
+	}
+
+	var messages []string
+
+	for index, example := range examples {
+		err := checkAttributeExample(ctx, r, schemaResp, example)
+
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("example %d (%s): %s", index, example.Path, err))
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(messages, "\n"))
+}
+
+// checkAttributeExample verifies a single example.
+func checkAttributeExample(ctx context.Context, r resource.Resource, schemaResp resource.SchemaResponse, example AttributeExample) error {
+	attribute, diags := schemaResp.Schema.AttributeAtPath(ctx, example.Path)
+
+	if diags.HasError() {
+		return fmt.Errorf("unable to find attribute: %s", diagsString(diags))
+	}
+
+	value, err := attribute.GetType().ValueFromTerraform(ctx, example.Config)
+
+	if err != nil {
+		if example.ExpectError {
+			return nil
+		}
+
+		return fmt.Errorf("unable to decode configuration value: %w", err)
+	}
+
+	config := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	diags = config.SetAttribute(ctx, example.Path, value)
+
+	if diags.HasError() {
+		return fmt.Errorf("unable to create configuration: %s", diagsString(diags))
+	}
+
+	var validateDiags diag.Diagnostics
+
+	for _, d := range ValidateConfig(ctx, r, config.Raw) {
+		diagWithPath, ok := d.(diag.DiagnosticWithPath)
+
+		if !ok || !pathIsAncestorOrEqual(example.Path, diagWithPath.Path()) {
+			continue
+		}
+
+		validateDiags.Append(d)
+	}
+
+	if example.ExpectError {
+		if validateDiags.HasError() {
+			return nil
+		}
+
+		return fmt.Errorf("expected error diagnostic, got none")
+	}
+
+	if validateDiags.HasError() {
+		return fmt.Errorf("unexpected error diagnostics: %s", diagsString(validateDiags))
+	}
+
+	state, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		return fmt.Errorf("unable to encode value: %w", err)
+	}
+
+	expected := example.State
+
+	if expected.Type() == nil {
+		expected = example.Config
+	}
+
+	if !state.Equal(expected) {
+		return fmt.Errorf("expected state value %s, got: %s", expected, state)
+	}
+
+	return nil
+}
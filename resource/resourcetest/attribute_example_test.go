@@ -0,0 +1,140 @@
+package resourcetest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/resourcetest"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCheckAttributeExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		examples []resourcetest.AttributeExample
+		expected string
+	}{
+		"no-examples": {},
+		"valid": {
+			examples: []resourcetest.AttributeExample{
+				{
+					Path:   path.Root("name"),
+					Config: tftypes.NewValue(tftypes.String, "example"),
+				},
+				{
+					Path:   path.Root("size"),
+					Config: tftypes.NewValue(tftypes.Number, 1),
+				},
+			},
+		},
+		"valid-state": {
+			examples: []resourcetest.AttributeExample{
+				{
+					Path:   path.Root("name"),
+					Config: tftypes.NewValue(tftypes.String, "example"),
+					State:  tftypes.NewValue(tftypes.String, "example"),
+				},
+			},
+		},
+		"valid-state-mismatch": {
+			examples: []resourcetest.AttributeExample{
+				{
+					Path:   path.Root("name"),
+					Config: tftypes.NewValue(tftypes.String, "example"),
+					State:  tftypes.NewValue(tftypes.String, "other"),
+				},
+			},
+			expected: `example 0 (name): expected state value tftypes.String<"other">, got: tftypes.String<"example">`,
+		},
+		"valid-expect-error": {
+			examples: []resourcetest.AttributeExample{
+				{
+					Path:        path.Root("name"),
+					Config:      tftypes.NewValue(tftypes.String, "example"),
+					ExpectError: true,
+				},
+			},
+			expected: "example 0 (name): expected error diagnostic, got none",
+		},
+		"invalid": {
+			examples: []resourcetest.AttributeExample{
+				{
+					Path:   path.Root("name"),
+					Config: tftypes.NewValue(tftypes.String, "example"),
+				},
+				{
+					Path:   path.Root("name"),
+					Config: tftypes.NewValue(tftypes.String, "invalid"),
+				},
+			},
+			expected: "example 1 (name): unexpected error diagnostics: Invalid Name: name must not be invalid",
+		},
+		"invalid-expect-error": {
+			examples: []resourcetest.AttributeExample{
+				{
+					Path:        path.Root("name"),
+					Config:      tftypes.NewValue(tftypes.String, "invalid"),
+					ExpectError: true,
+				},
+				{
+					Path:        path.Root("size"),
+					Config:      tftypes.NewValue(tftypes.Number, -1),
+					ExpectError: true,
+				},
+			},
+		},
+		"invalid-type": {
+			examples: []resourcetest.AttributeExample{
+				{
+					Path:   path.Root("name"),
+					Config: tftypes.NewValue(tftypes.Number, 1),
+				},
+			},
+			expected: "example 0 (name): unable to decode configuration value: can't unmarshal tftypes.Number into *string, expected string",
+		},
+		"invalid-type-expect-error": {
+			examples: []resourcetest.AttributeExample{
+				{
+					Path:        path.Root("name"),
+					Config:      tftypes.NewValue(tftypes.Number, 1),
+					ExpectError: true,
+				},
+			},
+		},
+		"invalid-path": {
+			examples: []resourcetest.AttributeExample{
+				{
+					Path:   path.Root("nonexistent"),
+					Config: tftypes.NewValue(tftypes.String, "example"),
+				},
+			},
+			expected: "example 0 (nonexistent): unable to find attribute: Invalid Schema Path: " +
+				"When attempting to get the framework attribute associated with a schema path, an unexpected error was returned. " +
+				"This is always an issue with the provider. Please report this to the provider developers.\n\n" +
+				"Path: nonexistent\n" +
+				`Original Error: AttributeName("nonexistent") still remains in the path: could not find attribute or block "nonexistent" in schema`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := resourcetest.CheckAttributeExamples(context.Background(), testResource(), testCase.examples...)
+
+			var got string
+
+			if err != nil {
+				got = err.Error()
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected error %q, got: %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+// Package resourcetest contains helpers for unit testing resource
+// implementations without Terraform, such as running the framework
+// configuration validation across many example configurations or running the
+// framework plan and verifying the result with reusable plan checks.
+package resourcetest
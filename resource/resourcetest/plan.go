@@ -0,0 +1,174 @@
+package resourcetest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// PlanResult is the result of the Plan function, which can be verified with
+// the Check method.
+type PlanResult struct {
+	// PriorState is the prior state given to the Plan function.
+	PriorState tfsdk.State
+
+	// Plan is the planned new state after all framework and provider plan
+	// modification logic.
+	Plan tfsdk.Plan
+
+	// RequiresReplace is the sorted and deduplicated list of attribute paths
+	// which require resource replacement.
+	RequiresReplace path.Paths
+}
+
+// Check runs all the given plan checks against the result. Refer to
+// CheckPlan for details.
+func (r PlanResult) Check(ctx context.Context, checks ...PlanCheck) error {
+	req := CheckPlanRequest{
+		PriorState:      r.PriorState,
+		Plan:            r.Plan,
+		RequiresReplace: r.RequiresReplace,
+	}
+
+	return CheckPlan(ctx, req, checks...)
+}
+
+// Plan runs the full framework plan of the given resource configuration data
+// and prior state data, as Terraform would during the PlanResourceChange RPC,
+// and returns the result and any diagnostics. This includes marking computed
+// attributes as unknown, attribute plan modifiers, and the ModifyPlan method.
+//
+// The configuration and prior state data must be object values which conform
+// to the resource schema type. Use a null prior state to plan resource
+// creation. The proposed new state is created similar to Terraform, by
+// copying prior state values for computed attributes which are null in the
+// configuration. If the resource implements ResourceWithConfigure, the
+// Configure method is called with nil provider data beforehand.
+func Plan(ctx context.Context, r resource.Resource, config tftypes.Value, priorState tftypes.Value) (PlanResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schemaResp := resource.SchemaResponse{}
+
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	diags.Append(schemaResp.Diagnostics...)
+
+	if diags.HasError() {
+		return PlanResult{}, diags
+	}
+
+	diags.Append(schemaResp.Schema.Validate()...)
+
+	if diags.HasError() {
+		return PlanResult{}, diags
+	}
+
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	for description, value := range map[string]tftypes.Value{"Configuration": config, "Prior State": priorState} {
+		if value.Type() != nil && value.Type().Equal(schemaType) {
+			continue
+		}
+
+		diags.AddError(
+			"Invalid Resource "+description+" Data",
+			"The given "+description+" data does not match the resource schema type.\n\n"+
+				fmt.Sprintf("Expected Type: %s\n", schemaType)+
+				fmt.Sprintf("Given Type: %s", value.Type()),
+		)
+	}
+
+	if diags.HasError() {
+		return PlanResult{}, diags
+	}
+
+	proposedNewState, err := tftypes.Transform(config, proposedNewStateFunc(ctx, priorState, schemaResp.Schema))
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Create Proposed New State",
+			"An unexpected error was encountered while creating the proposed new state from the configuration and prior state.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return PlanResult{}, diags
+	}
+
+	server := &fwserver.Server{}
+
+	req := &fwserver.PlanResourceChangeRequest{
+		Config: &tfsdk.Config{
+			Raw:    config,
+			Schema: schemaResp.Schema,
+		},
+		PriorState: &tfsdk.State{
+			Raw:    priorState,
+			Schema: schemaResp.Schema,
+		},
+		ProposedNewState: &tfsdk.Plan{
+			Raw:    proposedNewState,
+			Schema: schemaResp.Schema,
+		},
+		ResourceSchema: schemaResp.Schema,
+		Resource:       r,
+	}
+	resp := &fwserver.PlanResourceChangeResponse{}
+
+	server.PlanResourceChange(ctx, req, resp)
+
+	diags.Append(resp.Diagnostics...)
+
+	result := PlanResult{
+		PriorState:      *req.PriorState,
+		RequiresReplace: resp.RequiresReplace,
+	}
+
+	if resp.PlannedState != nil {
+		result.Plan = tfsdk.Plan{
+			Raw:    resp.PlannedState.Raw,
+			Schema: resp.PlannedState.Schema,
+		}
+	}
+
+	return result, diags
+}
+
+// proposedNewStateFunc returns a tftypes.Transform function which copies
+// prior state values for computed attributes which are null in the
+// configuration.
+func proposedNewStateFunc(ctx context.Context, priorState tftypes.Value, resourceSchema fwschema.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(tfPath *tftypes.AttributePath, configValue tftypes.Value) (tftypes.Value, error) {
+		if len(tfPath.Steps()) == 0 || !configValue.IsNull() || priorState.IsNull() {
+			return configValue, nil
+		}
+
+		attribute, err := resourceSchema.AttributeAtTerraformPath(ctx, tfPath)
+
+		// Values which are not attributes, such as blocks or elements of
+		// collection attributes, cannot be computed.
+		if err != nil || !attribute.IsComputed() {
+			return configValue, nil //nolint:nilerr // Intentionally ignoring the error
+		}
+
+		priorValueRaw, remaining, err := tftypes.WalkAttributePath(priorState, tfPath)
+
+		if err != nil || len(remaining.Steps()) > 0 {
+			return configValue, nil //nolint:nilerr // Intentionally ignoring the error
+		}
+
+		priorValue, ok := priorValueRaw.(tftypes.Value)
+
+		if !ok {
+			return configValue, nil
+		}
+
+		return priorValue, nil
+	}
+}
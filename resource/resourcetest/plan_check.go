@@ -0,0 +1,81 @@
+package resourcetest
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// PlanCheck defines an assertion against a resource plan, such as the
+// result of the Plan function or the response of a ModifyPlan method.
+type PlanCheck interface {
+	// CheckPlan should perform the assertion and set the response Error
+	// field if the assertion fails.
+	CheckPlan(context.Context, CheckPlanRequest, *CheckPlanResponse)
+}
+
+// CheckPlanRequest is the request for the PlanCheck interface CheckPlan
+// method.
+type CheckPlanRequest struct {
+	// PriorState is the prior state of the resource, which is null when the
+	// resource is planned for creation.
+	PriorState tfsdk.State
+
+	// Plan is the planned new state of the resource, which is null when the
+	// resource is planned for destruction.
+	Plan tfsdk.Plan
+
+	// RequiresReplace is the list of attribute paths which require resource
+	// replacement.
+	RequiresReplace path.Paths
+}
+
+// CheckPlanResponse is the response for the PlanCheck interface CheckPlan
+// method.
+type CheckPlanResponse struct {
+	// Error is the reason the assertion failed, if any.
+	Error error
+}
+
+// CheckPlan runs all the given plan checks against the plan and returns an
+// error combining all failed assertions, or nil if all assertions passed.
+// Every check is run regardless of earlier failures.
+//
+// Within resource ModifyPlan method unit tests, create the request from the
+// ModifyPlanRequest State field and the ModifyPlanResponse Plan and
+// RequiresReplace fields.
+func CheckPlan(ctx context.Context, req CheckPlanRequest, checks ...PlanCheck) error {
+	var messages []string
+
+	for _, check := range checks {
+		resp := CheckPlanResponse{}
+
+		check.CheckPlan(ctx, req, &resp)
+
+		if resp.Error != nil {
+			messages = append(messages, resp.Error.Error())
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(messages, "\n"))
+}
+
+// diagsString returns the summary and detail of error diagnostics, for use
+// in plan check errors.
+func diagsString(diags diag.Diagnostics) string {
+	messages := make([]string, 0, diags.ErrorsCount())
+
+	for _, d := range diags.Errors() {
+		messages = append(messages, d.Summary()+": "+d.Detail())
+	}
+
+	return strings.Join(messages, "; ")
+}
@@ -0,0 +1,57 @@
+package resourcetest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var _ PlanCheck = expectKnownValue{}
+
+type expectKnownValue struct {
+	path     path.Path
+	expected attr.Value
+}
+
+// CheckPlan implements the plan check logic.
+func (e expectKnownValue) CheckPlan(ctx context.Context, req CheckPlanRequest, resp *CheckPlanResponse) {
+	var value attr.Value
+
+	diags := req.Plan.GetAttribute(ctx, e.path, &value)
+
+	if diags.HasError() {
+		resp.Error = fmt.Errorf("%s: unable to read planned value: %s", e.path, diagsString(diags))
+
+		return
+	}
+
+	if value == nil || value.IsUnknown() {
+		resp.Error = fmt.Errorf("%s: expected known planned value, got unknown", e.path)
+
+		return
+	}
+
+	if e.expected != nil && !value.Equal(e.expected) {
+		resp.Error = fmt.Errorf("%s: expected planned value %s, got %s", e.path, e.expected, value)
+	}
+}
+
+// ExpectKnownValue returns a plan check which asserts that the planned value
+// at the given path is known, meaning it will not show as
+// (known after apply). Null values are considered known.
+func ExpectKnownValue(p path.Path) PlanCheck {
+	return expectKnownValue{
+		path: p,
+	}
+}
+
+// ExpectValue returns a plan check which asserts that the planned value at
+// the given path is known and equal to the expected value.
+func ExpectValue(p path.Path, expected attr.Value) PlanCheck {
+	return expectKnownValue{
+		path:     p,
+		expected: expected,
+	}
+}
@@ -0,0 +1,110 @@
+package resourcetest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var _ PlanCheck = expectNoChangesExcept{}
+
+type expectNoChangesExcept struct {
+	paths path.Paths
+}
+
+// CheckPlan implements the plan check logic.
+func (e expectNoChangesExcept) CheckPlan(ctx context.Context, req CheckPlanRequest, resp *CheckPlanResponse) {
+	if req.PriorState.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		resp.Error = fmt.Errorf("expected resource update, got resource creation or destruction")
+
+		return
+	}
+
+	diffs, err := req.PriorState.Raw.Diff(req.Plan.Raw)
+
+	if err != nil {
+		resp.Error = fmt.Errorf("unable to compare prior state and plan: %w", err)
+
+		return
+	}
+
+	changed := make(map[string]struct{})
+
+	for _, diff := range diffs {
+		if len(diff.Path.Steps()) == 0 {
+			continue
+		}
+
+		fwPath, diags := fromtftypes.AttributePath(ctx, diff.Path, req.Plan.Schema)
+
+		if diags.HasError() {
+			changed[diff.Path.String()] = struct{}{}
+
+			continue
+		}
+
+		if e.allowed(fwPath) {
+			continue
+		}
+
+		changed[fwPath.String()] = struct{}{}
+	}
+
+	if len(changed) == 0 {
+		return
+	}
+
+	paths := make([]string, 0, len(changed))
+
+	for p := range changed {
+		paths = append(paths, p)
+	}
+
+	sort.Strings(paths)
+
+	resp.Error = fmt.Errorf("expected no planned changes except %s, got changes: %s", e.paths, strings.Join(paths, ", "))
+}
+
+// allowed returns true if the given path is equal to, nested underneath, or
+// a parent of one of the allowed paths. Parent paths are allowed since
+// differences are reported for both the parent and nested values.
+func (e expectNoChangesExcept) allowed(p path.Path) bool {
+	for _, allowedPath := range e.paths {
+		if pathIsAncestorOrEqual(allowedPath, p) || pathIsAncestorOrEqual(p, allowedPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pathIsAncestorOrEqual returns true if the ancestor path is equal to the
+// path or one of its parent paths.
+func pathIsAncestorOrEqual(ancestor path.Path, p path.Path) bool {
+	for {
+		if p.Equal(ancestor) {
+			return true
+		}
+
+		if len(p.Steps()) == 0 {
+			return false
+		}
+
+		p = p.ParentPath()
+	}
+}
+
+// ExpectNoChangesExcept returns a plan check which asserts that the resource
+// is planned for update and that the planned values only differ from the
+// prior state values at, or underneath, the given paths. Unknown planned
+// values are considered a change, unless the prior state value was also
+// unknown. Call with no paths to assert that the plan contains no changes.
+func ExpectNoChangesExcept(paths ...path.Path) PlanCheck {
+	return expectNoChangesExcept{
+		paths: paths,
+	}
+}
@@ -0,0 +1,50 @@
+package resourcetest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var _ PlanCheck = expectRequiresReplace{}
+
+type expectRequiresReplace struct {
+	path     path.Path
+	expected bool
+}
+
+// CheckPlan implements the plan check logic.
+func (e expectRequiresReplace) CheckPlan(ctx context.Context, req CheckPlanRequest, resp *CheckPlanResponse) {
+	got := req.RequiresReplace.Contains(e.path)
+
+	if got == e.expected {
+		return
+	}
+
+	if e.expected {
+		resp.Error = fmt.Errorf("%s: expected resource replacement, got none", e.path)
+
+		return
+	}
+
+	resp.Error = fmt.Errorf("%s: expected no resource replacement, got replacement", e.path)
+}
+
+// ExpectRequiresReplace returns a plan check which asserts that a change to
+// the value at the given path requires resource replacement.
+func ExpectRequiresReplace(p path.Path) PlanCheck {
+	return expectRequiresReplace{
+		path:     p,
+		expected: true,
+	}
+}
+
+// ExpectNoRequiresReplace returns a plan check which asserts that the value
+// at the given path does not require resource replacement.
+func ExpectNoRequiresReplace(p path.Path) PlanCheck {
+	return expectRequiresReplace{
+		path:     p,
+		expected: false,
+	}
+}
@@ -0,0 +1,41 @@
+package resourcetest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var _ PlanCheck = expectUnknownValue{}
+
+type expectUnknownValue struct {
+	path path.Path
+}
+
+// CheckPlan implements the plan check logic.
+func (e expectUnknownValue) CheckPlan(ctx context.Context, req CheckPlanRequest, resp *CheckPlanResponse) {
+	var value attr.Value
+
+	diags := req.Plan.GetAttribute(ctx, e.path, &value)
+
+	if diags.HasError() {
+		resp.Error = fmt.Errorf("%s: unable to read planned value: %s", e.path, diagsString(diags))
+
+		return
+	}
+
+	if value == nil || !value.IsUnknown() {
+		resp.Error = fmt.Errorf("%s: expected unknown planned value, got %s", e.path, value)
+	}
+}
+
+// ExpectUnknownValue returns a plan check which asserts that the planned
+// value at the given path is unknown, meaning it will show as
+// (known after apply).
+func ExpectUnknownValue(p path.Path) PlanCheck {
+	return expectUnknownValue{
+		path: p,
+	}
+}
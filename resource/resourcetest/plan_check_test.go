@@ -0,0 +1,170 @@
+package resourcetest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/resourcetest"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCheckPlan(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"settings": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional: true,
+					},
+					"mode": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testSettingsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"mode":    tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":     tftypes.String,
+			"settings": testSettingsType,
+		},
+	}
+
+	testValue := func(name any, enabled any, mode any) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+			"settings": tftypes.NewValue(testSettingsType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, enabled),
+				"mode":    tftypes.NewValue(tftypes.String, mode),
+			}),
+		})
+	}
+
+	testRequest := func(priorState tftypes.Value, plan tftypes.Value, requiresReplace ...path.Path) resourcetest.CheckPlanRequest {
+		return resourcetest.CheckPlanRequest{
+			PriorState: tfsdk.State{
+				Raw:    priorState,
+				Schema: testSchema,
+			},
+			Plan: tfsdk.Plan{
+				Raw:    plan,
+				Schema: testSchema,
+			},
+			RequiresReplace: requiresReplace,
+		}
+	}
+
+	testCases := map[string]struct {
+		request       resourcetest.CheckPlanRequest
+		checks        []resourcetest.PlanCheck
+		expectedError string
+	}{
+		"no-checks": {
+			request: testRequest(testValue("test", true, "a"), testValue("test", true, "a")),
+		},
+		"expect-known-value-nested": {
+			request: testRequest(testValue("test", true, "a"), testValue("test", true, tftypes.UnknownValue)),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectKnownValue(path.Root("settings").AtName("enabled")),
+				resourcetest.ExpectKnownValue(path.Root("settings").AtName("mode")),
+			},
+			expectedError: "settings.mode: expected known planned value, got unknown",
+		},
+		"expect-known-value-invalid-path": {
+			request: testRequest(testValue("test", true, "a"), testValue("test", true, "a")),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectKnownValue(path.Root("missing")),
+			},
+			expectedError: "missing: unable to read planned value: Plan Read Error: " +
+				"An unexpected error was encountered trying to retrieve type information at a given path. " +
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+				"Error: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+		},
+		"expect-value": {
+			request: testRequest(testValue("test", true, "a"), testValue("test", false, "a")),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectValue(path.Root("name"), types.StringValue("test")),
+				resourcetest.ExpectValue(path.Root("settings").AtName("enabled"), types.BoolValue(true)),
+			},
+			expectedError: "settings.enabled: expected planned value true, got false",
+		},
+		"expect-unknown-value": {
+			request: testRequest(testValue("test", true, "a"), testValue(tftypes.UnknownValue, true, nil)),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectUnknownValue(path.Root("name")),
+				resourcetest.ExpectUnknownValue(path.Root("settings").AtName("mode")),
+			},
+			expectedError: "settings.mode: expected unknown planned value, got <null>",
+		},
+		"expect-no-changes-except-nested-allowed": {
+			request: testRequest(testValue("test", true, "a"), testValue("test", true, "b")),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectNoChangesExcept(path.Root("settings").AtName("mode")),
+				resourcetest.ExpectNoChangesExcept(path.Root("settings")),
+			},
+		},
+		"expect-no-changes-except-nested-disallowed": {
+			request: testRequest(testValue("test", true, "a"), testValue("test-new", false, "b")),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectNoChangesExcept(path.Root("settings").AtName("mode")),
+			},
+			expectedError: "expected no planned changes except [settings.mode], got changes: name, settings.enabled",
+		},
+		"expect-no-changes-except-create": {
+			request: testRequest(tftypes.NewValue(testType, nil), testValue("test", true, "a")),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectNoChangesExcept(),
+			},
+			expectedError: "expected resource update, got resource creation or destruction",
+		},
+		"expect-requires-replace": {
+			request: testRequest(testValue("test", true, "a"), testValue("test-new", true, "a"), path.Root("name")),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectRequiresReplace(path.Root("name")),
+				resourcetest.ExpectRequiresReplace(path.Root("settings")),
+				resourcetest.ExpectNoRequiresReplace(path.Root("name")),
+			},
+			expectedError: "settings: expected resource replacement, got none\n" +
+				"name: expected no resource replacement, got replacement",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := resourcetest.CheckPlan(context.Background(), testCase.request, testCase.checks...)
+
+			var gotError string
+
+			if err != nil {
+				gotError = err.Error()
+			}
+
+			if diff := cmp.Diff(gotError, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
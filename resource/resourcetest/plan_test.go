@@ -0,0 +1,185 @@
+package resourcetest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/resourcetest"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testPlanResource() resource.Resource {
+	return &testprovider.ResourceWithModifyPlan{
+		Resource: &testprovider.Resource{
+			SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+				resp.Schema = schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"name": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+						"size": schema.Int64Attribute{
+							Optional: true,
+						},
+					},
+				}
+			},
+		},
+		ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+			var size types.Int64
+
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("size"), &size)...)
+
+			if size.ValueInt64() < 0 {
+				resp.Diagnostics.AddAttributeError(path.Root("size"), "Invalid Size", "size must not be negative")
+			}
+		},
+	}
+}
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":     tftypes.String,
+			"name":   tftypes.String,
+			"status": tftypes.String,
+			"size":   tftypes.Number,
+		},
+	}
+
+	testValue := func(id, name, status, size any) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id":     tftypes.NewValue(tftypes.String, id),
+			"name":   tftypes.NewValue(tftypes.String, name),
+			"status": tftypes.NewValue(tftypes.String, status),
+			"size":   tftypes.NewValue(tftypes.Number, size),
+		})
+	}
+
+	testCases := map[string]struct {
+		config        tftypes.Value
+		priorState    tftypes.Value
+		checks        []resourcetest.PlanCheck
+		expectedDiags diag.Diagnostics
+		expectedError string
+	}{
+		"create": {
+			config:     testValue(nil, "test", nil, 1),
+			priorState: tftypes.NewValue(testType, nil),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectUnknownValue(path.Root("id")),
+				resourcetest.ExpectUnknownValue(path.Root("status")),
+				resourcetest.ExpectValue(path.Root("name"), types.StringValue("test")),
+			},
+		},
+		"update": {
+			config:     testValue(nil, "test", nil, 2),
+			priorState: testValue("test-id", "test", "test-status", 1),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectKnownValue(path.Root("id")),
+				resourcetest.ExpectValue(path.Root("id"), types.StringValue("test-id")),
+				resourcetest.ExpectUnknownValue(path.Root("status")),
+				resourcetest.ExpectNoRequiresReplace(path.Root("name")),
+				resourcetest.ExpectNoChangesExcept(path.Root("size"), path.Root("status")),
+			},
+		},
+		"no-changes": {
+			config:     testValue(nil, "test", nil, 1),
+			priorState: testValue("test-id", "test", "test-status", 1),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectValue(path.Root("status"), types.StringValue("test-status")),
+				resourcetest.ExpectNoChangesExcept(),
+			},
+		},
+		"replace": {
+			config:     testValue(nil, "test-new", nil, 1),
+			priorState: testValue("test-id", "test", "test-status", 1),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectRequiresReplace(path.Root("name")),
+			},
+		},
+		"check-failures": {
+			config:     testValue(nil, "test", nil, 2),
+			priorState: testValue("test-id", "test", "test-status", 1),
+			checks: []resourcetest.PlanCheck{
+				resourcetest.ExpectKnownValue(path.Root("status")),
+				resourcetest.ExpectNoChangesExcept(path.Root("status")),
+			},
+			expectedError: "status: expected known planned value, got unknown\n" +
+				"expected no planned changes except [status], got changes: size",
+		},
+		"modifyplan-diagnostics": {
+			config:     testValue(nil, "test", nil, -1),
+			priorState: tftypes.NewValue(testType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("size"), "Invalid Size", "size must not be negative"),
+			},
+		},
+		"invalid-prior-state-type": {
+			config:     testValue(nil, "test", nil, 1),
+			priorState: tftypes.NewValue(tftypes.String, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Resource Prior State Data",
+					"The given Prior State data does not match the resource schema type.\n\n"+
+						"Expected Type: tftypes.Object[\"id\":tftypes.String, \"name\":tftypes.String, \"size\":tftypes.Number, \"status\":tftypes.String]\n"+
+						"Given Type: tftypes.String",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			result, diags := resourcetest.Plan(ctx, testPlanResource(), testCase.config, testCase.priorState)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			err := result.Check(ctx, testCase.checks...)
+
+			var gotError string
+
+			if err != nil {
+				gotError = err.Error()
+			}
+
+			if diff := cmp.Diff(gotError, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package resourcetest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ValidateConfig runs the full framework validation of the given resource
+// configuration data, as Terraform would during the ValidateResourceConfig
+// RPC, and returns any diagnostics. This includes schema attribute and type
+// validation, the ConfigValidators method, and the ValidateConfig method.
+//
+// The configuration data must be an object value which conforms to the
+// resource schema type. If the resource implements ResourceWithConfigure,
+// the Configure method is called with nil provider data beforehand.
+func ValidateConfig(ctx context.Context, r resource.Resource, config tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schemaResp := resource.SchemaResponse{}
+
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	diags.Append(schemaResp.Diagnostics...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(schemaResp.Schema.Validate()...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	if config.Type() == nil || !config.Type().Equal(schemaType) {
+		diags.AddError(
+			"Invalid Resource Configuration Data",
+			"The given configuration data does not match the resource schema type.\n\n"+
+				fmt.Sprintf("Expected Type: %s\n", schemaType)+
+				fmt.Sprintf("Given Type: %s", config.Type()),
+		)
+
+		return diags
+	}
+
+	server := &fwserver.Server{}
+
+	req := &fwserver.ValidateResourceConfigRequest{
+		Config: &tfsdk.Config{
+			Raw:    config,
+			Schema: schemaResp.Schema,
+		},
+		Resource: r,
+	}
+	resp := &fwserver.ValidateResourceConfigResponse{}
+
+	server.ValidateResourceConfig(ctx, req, resp)
+
+	diags.Append(resp.Diagnostics...)
+
+	return diags
+}
+
+// ValidateConfigJSON is equivalent to ValidateConfig, except the
+// configuration data is given as a JSON object, such as from a fixture file.
+// Object attributes which are not present in the JSON are null. Unknown values
+// cannot be represented.
+func ValidateConfigJSON(ctx context.Context, r resource.Resource, configJSON []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schemaResp := resource.SchemaResponse{}
+
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	diags.Append(schemaResp.Diagnostics...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	config, err := tftypes.ValueFromJSON(configJSON, schemaResp.Schema.Type().TerraformType(ctx))
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Resource Configuration JSON",
+			"The given configuration JSON could not be converted to the resource schema type.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	return ValidateConfig(ctx, r, config)
+}
+
+// ValidateConfigValues is equivalent to ValidateConfigJSON, except the
+// configuration data is given as Go literal values, such as
+// map[string]any{"name": "example", "size": 1}, which are encoded with
+// encoding/json.
+func ValidateConfigValues(ctx context.Context, r resource.Resource, config map[string]any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configJSON, err := json.Marshal(config)
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Resource Configuration Values",
+			"The given configuration values could not be encoded as JSON.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	return ValidateConfigJSON(ctx, r, configJSON)
+}
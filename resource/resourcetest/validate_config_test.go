@@ -0,0 +1,229 @@
+package resourcetest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/resourcetest"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testResource() resource.Resource {
+	return &testprovider.ResourceWithValidateConfig{
+		Resource: &testprovider.Resource{
+			SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+				resp.Schema = schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								testvalidator.String{
+									ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+										if req.ConfigValue.ValueString() == "invalid" {
+											resp.Diagnostics.AddAttributeError(req.Path, "Invalid Name", "name must not be invalid")
+										}
+									},
+								},
+							},
+						},
+						"size": schema.Int64Attribute{
+							Optional: true,
+						},
+					},
+				}
+			},
+		},
+		ValidateConfigMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+			var size types.Int64
+
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("size"), &size)...)
+
+			if size.ValueInt64() < 0 {
+				resp.Diagnostics.AddAttributeError(path.Root("size"), "Invalid Size", "size must not be negative")
+			}
+		},
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"size": tftypes.Number,
+		},
+	}
+
+	testCases := map[string]struct {
+		config   tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"valid": {
+			config: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "example"),
+				"size": tftypes.NewValue(tftypes.Number, nil),
+			}),
+		},
+		"unknown": {
+			config: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"size": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			}),
+		},
+		"attribute-validator": {
+			config: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "invalid"),
+				"size": tftypes.NewValue(tftypes.Number, nil),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("name"), "Invalid Name", "name must not be invalid"),
+			},
+		},
+		"validate-config": {
+			config: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "example"),
+				"size": tftypes.NewValue(tftypes.Number, -1),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("size"), "Invalid Size", "size must not be negative"),
+			},
+		},
+		"wrong-type": {
+			config: tftypes.NewValue(tftypes.String, "example"),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Resource Configuration Data",
+					"The given configuration data does not match the resource schema type.\n\n"+
+						"Expected Type: tftypes.Object[\"name\":tftypes.String, \"size\":tftypes.Number]\n"+
+						"Given Type: tftypes.String",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := resourcetest.ValidateConfig(context.Background(), testResource(), testCase.config)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateConfigJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configJSON string
+		expected   diag.Diagnostics
+	}{
+		"valid": {
+			configJSON: `{"name": "example", "size": 1}`,
+		},
+		"missing-optional": {
+			configJSON: `{"name": "example"}`,
+		},
+		"attribute-validator": {
+			configJSON: `{"name": "invalid"}`,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("name"), "Invalid Name", "name must not be invalid"),
+			},
+		},
+		"missing-required": {
+			configJSON: `{"size": 1}`,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Missing Configuration for Required Attribute",
+					"Must set a configuration value for the name attribute as the provider has marked it as required.\n\n"+
+						"Refer to the provider documentation or contact the provider developers for additional information about configurable attributes that are required.",
+				),
+			},
+		},
+		"unsupported-attribute": {
+			configJSON: `{"name": "example", "other": true}`,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Resource Configuration JSON",
+					"The given configuration JSON could not be converted to the resource schema type.\n\n"+
+						"Error: AttributeName(\"other\"): unsupported attribute \"other\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := resourcetest.ValidateConfigJSON(context.Background(), testResource(), []byte(testCase.configJSON))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateConfigValues(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config   map[string]any
+		expected diag.Diagnostics
+	}{
+		"valid": {
+			config: map[string]any{
+				"name": "example",
+				"size": 1,
+			},
+		},
+		"null": {
+			config: map[string]any{
+				"name": "example",
+				"size": nil,
+			},
+		},
+		"validate-config": {
+			config: map[string]any{
+				"name": "example",
+				"size": -1,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("size"), "Invalid Size", "size must not be negative"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := resourcetest.ValidateConfigValues(context.Background(), testResource(), testCase.config)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package resourcevalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// AtLeastOneOf returns a validator which ensures that at least one of the
+// attributes matching the given path expressions is not null. Unknown values
+// are not considered null.
+func AtLeastOneOf(expressions ...path.Expression) resource.ConfigValidator {
+	return atLeastOneOfValidator{
+		expressions: expressions,
+	}
+}
+
+var _ resource.ConfigValidator = atLeastOneOfValidator{}
+
+// atLeastOneOfValidator implements the validator.
+type atLeastOneOfValidator struct {
+	expressions path.Expressions
+}
+
+// Description describes the validation in plain text formatting.
+func (v atLeastOneOfValidator) Description(_ context.Context) string {
+	return "at least one of these attributes must be configured: " + schemavalidator.ConfigCombinationExpressions(v.expressions)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atLeastOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation.
+func (v atLeastOneOfValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	combinationReq := schemavalidator.ConfigCombinationRequest{
+		Config:      req.Config,
+		Expressions: v.expressions,
+	}
+
+	resp.Diagnostics.Append(schemavalidator.ConfigAtLeastOneOf(ctx, combinationReq)...)
+}
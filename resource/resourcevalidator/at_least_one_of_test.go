@@ -0,0 +1,64 @@
+package resourcevalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAtLeastOneOfValidatorValidateResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config   tfsdk.Config
+		expected diag.Diagnostics
+	}{
+		"one": {
+			config: testConfig("one-value", nil),
+		},
+		"both": {
+			config: testConfig("one-value", "two-value"),
+		},
+		"unknown": {
+			config: testConfig(tftypes.UnknownValue, nil),
+		},
+		"neither": {
+			config: testConfig(nil, nil),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Attribute Configuration",
+					"At least one attribute out of [one,two] must be specified",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ValidateConfigRequest{
+				Config: testCase.config,
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			resourcevalidator.AtLeastOneOf(
+				path.MatchRoot("one"),
+				path.MatchRoot("two"),
+			).ValidateResource(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package resourcevalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Conflicting returns a validator which ensures that at most one of the
+// attributes matching the given path expressions is not null. Unknown values
+// are skipped, since they may become null.
+func Conflicting(expressions ...path.Expression) resource.ConfigValidator {
+	return conflictingValidator{
+		expressions: expressions,
+	}
+}
+
+var _ resource.ConfigValidator = conflictingValidator{}
+
+// conflictingValidator implements the validator.
+type conflictingValidator struct {
+	expressions path.Expressions
+}
+
+// Description describes the validation in plain text formatting.
+func (v conflictingValidator) Description(_ context.Context) string {
+	return "these attributes cannot be configured together: " + schemavalidator.ConfigCombinationExpressions(v.expressions)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v conflictingValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation.
+func (v conflictingValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	combinationReq := schemavalidator.ConfigCombinationRequest{
+		Config:      req.Config,
+		Expressions: v.expressions,
+	}
+
+	resp.Diagnostics.Append(schemavalidator.ConfigConflicting(ctx, combinationReq)...)
+}
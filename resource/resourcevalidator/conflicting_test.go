@@ -0,0 +1,65 @@
+package resourcevalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConflictingValidatorValidateResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config   tfsdk.Config
+		expected diag.Diagnostics
+	}{
+		"neither": {
+			config: testConfig(nil, nil),
+		},
+		"one": {
+			config: testConfig("one-value", nil),
+		},
+		"unknown": {
+			config: testConfig("one-value", tftypes.UnknownValue),
+		},
+		"both": {
+			config: testConfig("one-value", "two-value"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("one"),
+					"Invalid Attribute Combination",
+					"These attributes cannot be configured together: [one,two]",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ValidateConfigRequest{
+				Config: testCase.config,
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			resourcevalidator.Conflicting(
+				path.MatchRoot("one"),
+				path.MatchRoot("two"),
+			).ValidateResource(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Package resourcevalidator contains common use case resource.ConfigValidator
+// implementations for whole resource configuration rules, such as requiring
+// exactly one of multiple attributes to be configured. Return them from the
+// resource.ResourceWithConfigValidators interface ConfigValidators method.
+//
+// The validators accept path expressions, which must be absolute from the
+// root of the schema, such as path.MatchRoot("name"). Expressions can match
+// multiple attributes, such as attributes within nested blocks.
+package resourcevalidator
@@ -0,0 +1,45 @@
+package resourcevalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ExactlyOneOf returns a validator which ensures that exactly one of the
+// attributes matching the given path expressions is not null. Validation is
+// skipped while any of the values are unknown.
+func ExactlyOneOf(expressions ...path.Expression) resource.ConfigValidator {
+	return exactlyOneOfValidator{
+		expressions: expressions,
+	}
+}
+
+var _ resource.ConfigValidator = exactlyOneOfValidator{}
+
+// exactlyOneOfValidator implements the validator.
+type exactlyOneOfValidator struct {
+	expressions path.Expressions
+}
+
+// Description describes the validation in plain text formatting.
+func (v exactlyOneOfValidator) Description(_ context.Context) string {
+	return "exactly one of these attributes must be configured: " + schemavalidator.ConfigCombinationExpressions(v.expressions)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v exactlyOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation.
+func (v exactlyOneOfValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	combinationReq := schemavalidator.ConfigCombinationRequest{
+		Config:      req.Config,
+		Expressions: v.expressions,
+	}
+
+	resp.Diagnostics.Append(schemavalidator.ConfigExactlyOneOf(ctx, combinationReq)...)
+}
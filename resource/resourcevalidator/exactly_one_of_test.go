@@ -0,0 +1,74 @@
+package resourcevalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExactlyOneOfValidatorValidateResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config   tfsdk.Config
+		expected diag.Diagnostics
+	}{
+		"one": {
+			config: testConfig("one-value", nil),
+		},
+		"two": {
+			config: testConfig(nil, "two-value"),
+		},
+		"unknown": {
+			config: testConfig(tftypes.UnknownValue, "two-value"),
+		},
+		"neither": {
+			config: testConfig(nil, nil),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Attribute Configuration",
+					"No attribute specified when one (and only one) of [one,two] is required",
+				),
+			},
+		},
+		"both": {
+			config: testConfig("one-value", "two-value"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("one"),
+					"Invalid Attribute Combination",
+					"2 attributes specified when one (and only one) of [one,two] is required",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ValidateConfigRequest{
+				Config: testCase.config,
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			resourcevalidator.ExactlyOneOf(
+				path.MatchRoot("one"),
+				path.MatchRoot("two"),
+			).ValidateResource(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
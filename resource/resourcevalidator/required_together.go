@@ -0,0 +1,45 @@
+package resourcevalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// RequiredTogether returns a validator which ensures that either all or none
+// of the attributes matching the given path expressions are null. Validation
+// is skipped while any of the values are unknown.
+func RequiredTogether(expressions ...path.Expression) resource.ConfigValidator {
+	return requiredTogetherValidator{
+		expressions: expressions,
+	}
+}
+
+var _ resource.ConfigValidator = requiredTogetherValidator{}
+
+// requiredTogetherValidator implements the validator.
+type requiredTogetherValidator struct {
+	expressions path.Expressions
+}
+
+// Description describes the validation in plain text formatting.
+func (v requiredTogetherValidator) Description(_ context.Context) string {
+	return "these attributes must be configured together: " + schemavalidator.ConfigCombinationExpressions(v.expressions)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v requiredTogetherValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation.
+func (v requiredTogetherValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	combinationReq := schemavalidator.ConfigCombinationRequest{
+		Config:      req.Config,
+		Expressions: v.expressions,
+	}
+
+	resp.Diagnostics.Append(schemavalidator.ConfigRequiredTogether(ctx, combinationReq)...)
+}
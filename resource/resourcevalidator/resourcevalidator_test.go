@@ -0,0 +1,36 @@
+package resourcevalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testConfig returns a configuration with the given "one" and "two" string
+// attribute values, which can be nil, a string, or tftypes.UnknownValue.
+func testConfig(one any, two any) tfsdk.Config {
+	return tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"one": tftypes.String,
+					"two": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"one": tftypes.NewValue(tftypes.String, one),
+				"two": tftypes.NewValue(tftypes.String, two),
+			},
+		),
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"one": schema.StringAttribute{
+					Optional: true,
+				},
+				"two": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
@@ -4,13 +4,16 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -81,6 +84,40 @@ func TestBoolAttributeApplyTerraform5AttributePathStep(t *testing.T) {
 	}
 }
 
+func TestBoolAttributeBoolDefaultValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.BoolAttribute
+		expected  defaults.Bool
+	}{
+		"no-default": {
+			attribute: schema.BoolAttribute{},
+			expected:  nil,
+		},
+		"default": {
+			attribute: schema.BoolAttribute{
+				Default: testdefaults.Bool{},
+			},
+			expected: testdefaults.Bool{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.BoolDefaultValue()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestBoolAttributeBoolPlanModifiers(t *testing.T) {
 	t.Parallel()
 
@@ -149,6 +186,40 @@ func TestBoolAttributeBoolValidators(t *testing.T) {
 	}
 }
 
+func TestBoolAttributeGetAllowNullAfterApply(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.BoolAttribute
+		expected  bool
+	}{
+		"no-allow-null-after-apply": {
+			attribute: schema.BoolAttribute{},
+			expected:  false,
+		},
+		"allow-null-after-apply": {
+			attribute: schema.BoolAttribute{
+				AllowNullAfterApply: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetAllowNullAfterApply()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestBoolAttributeGetDeprecationMessage(t *testing.T) {
 	t.Parallel()
 
@@ -252,6 +323,40 @@ func TestBoolAttributeGetDescription(t *testing.T) {
 	}
 }
 
+func TestBoolAttributeGetEventualConsistencyWindow(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.BoolAttribute
+		expected  time.Duration
+	}{
+		"no-eventual-consistency-window": {
+			attribute: schema.BoolAttribute{},
+			expected:  0,
+		},
+		"eventual-consistency-window": {
+			attribute: schema.BoolAttribute{
+				EventualConsistencyWindow: 30 * time.Second,
+			},
+			expected: 30 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetEventualConsistencyWindow()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestBoolAttributeGetMarkdownDescription(t *testing.T) {
 	t.Parallel()
 
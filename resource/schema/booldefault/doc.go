@@ -0,0 +1,2 @@
+// Package booldefault provides default values for types.Bool attributes.
+package booldefault
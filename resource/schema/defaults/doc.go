@@ -0,0 +1,13 @@
+// Package defaults contains schema default value interfaces and
+// request/response implementations. These default value interfaces are used
+// by resource/schema and internally in the framework. Refer to the typed
+// default packages, such as stringdefault, for framework-defined default
+// values that can be used in provider-defined schemas.
+//
+// Each attr.Type has a corresponding {TYPE} interface which implements
+// concretely typed Default{TYPE} methods, such as String and DefaultString.
+//
+// Default values are applied during resource planning when the configuration
+// value is null. Provider-defined implementations can compute the default
+// value at plan time, such as from provider-level data.
+package defaults
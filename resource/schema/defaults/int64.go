@@ -0,0 +1,35 @@
+package defaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Int64 is a schema default value for types.Int64 attributes.
+type Int64 interface {
+	Describer
+
+	// DefaultInt64 should set the default value.
+	DefaultInt64(context.Context, Int64Request, *Int64Response)
+}
+
+// Int64Request is a request for types.Int64 schema default value setting.
+type Int64Request struct {
+	// Path contains the path of the attribute for setting the default value.
+	// Use this path for any response diagnostics.
+	Path path.Path
+}
+
+// Int64Response is a response to a Int64Request.
+type Int64Response struct {
+	// Diagnostics report errors or warnings related to setting the default
+	// value. An empty slice indicates success, with no warnings or errors
+	// generated.
+	Diagnostics diag.Diagnostics
+
+	// PlanValue is the planned new state for the attribute.
+	PlanValue types.Int64
+}
@@ -0,0 +1,35 @@
+package defaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// List is a schema default value for types.List attributes.
+type List interface {
+	Describer
+
+	// DefaultList should set the default value.
+	DefaultList(context.Context, ListRequest, *ListResponse)
+}
+
+// ListRequest is a request for types.List schema default value setting.
+type ListRequest struct {
+	// Path contains the path of the attribute for setting the default value.
+	// Use this path for any response diagnostics.
+	Path path.Path
+}
+
+// ListResponse is a response to a ListRequest.
+type ListResponse struct {
+	// Diagnostics report errors or warnings related to setting the default
+	// value. An empty slice indicates success, with no warnings or errors
+	// generated.
+	Diagnostics diag.Diagnostics
+
+	// PlanValue is the planned new state for the attribute.
+	PlanValue types.List
+}
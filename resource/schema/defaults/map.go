@@ -0,0 +1,35 @@
+package defaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Map is a schema default value for types.Map attributes.
+type Map interface {
+	Describer
+
+	// DefaultMap should set the default value.
+	DefaultMap(context.Context, MapRequest, *MapResponse)
+}
+
+// MapRequest is a request for types.Map schema default value setting.
+type MapRequest struct {
+	// Path contains the path of the attribute for setting the default value.
+	// Use this path for any response diagnostics.
+	Path path.Path
+}
+
+// MapResponse is a response to a MapRequest.
+type MapResponse struct {
+	// Diagnostics report errors or warnings related to setting the default
+	// value. An empty slice indicates success, with no warnings or errors
+	// generated.
+	Diagnostics diag.Diagnostics
+
+	// PlanValue is the planned new state for the attribute.
+	PlanValue types.Map
+}
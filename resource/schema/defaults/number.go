@@ -0,0 +1,35 @@
+package defaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Number is a schema default value for types.Number attributes.
+type Number interface {
+	Describer
+
+	// DefaultNumber should set the default value.
+	DefaultNumber(context.Context, NumberRequest, *NumberResponse)
+}
+
+// NumberRequest is a request for types.Number schema default value setting.
+type NumberRequest struct {
+	// Path contains the path of the attribute for setting the default value.
+	// Use this path for any response diagnostics.
+	Path path.Path
+}
+
+// NumberResponse is a response to a NumberRequest.
+type NumberResponse struct {
+	// Diagnostics report errors or warnings related to setting the default
+	// value. An empty slice indicates success, with no warnings or errors
+	// generated.
+	Diagnostics diag.Diagnostics
+
+	// PlanValue is the planned new state for the attribute.
+	PlanValue types.Number
+}
@@ -0,0 +1,35 @@
+package defaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Object is a schema default value for types.Object attributes.
+type Object interface {
+	Describer
+
+	// DefaultObject should set the default value.
+	DefaultObject(context.Context, ObjectRequest, *ObjectResponse)
+}
+
+// ObjectRequest is a request for types.Object schema default value setting.
+type ObjectRequest struct {
+	// Path contains the path of the attribute for setting the default value.
+	// Use this path for any response diagnostics.
+	Path path.Path
+}
+
+// ObjectResponse is a response to a ObjectRequest.
+type ObjectResponse struct {
+	// Diagnostics report errors or warnings related to setting the default
+	// value. An empty slice indicates success, with no warnings or errors
+	// generated.
+	Diagnostics diag.Diagnostics
+
+	// PlanValue is the planned new state for the attribute.
+	PlanValue types.Object
+}
@@ -0,0 +1,26 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// EmptyObjectPolicy controls how the framework stores object values in the
+// resource state when the provider sets either a null object or an object
+// where all attributes are null, such as when a remote system returns an
+// empty object. Choosing a single representation prevents perpetual
+// differences between the two.
+type EmptyObjectPolicy = fwschema.EmptyObjectPolicy
+
+const (
+	// EmptyObjectPolicyUnset stores object values exactly as they were
+	// set by the provider. This is the default behavior.
+	EmptyObjectPolicyUnset = fwschema.EmptyObjectPolicyUnset
+
+	// EmptyObjectPolicyNull stores known object values where all
+	// attributes are null as a null object.
+	EmptyObjectPolicyNull = fwschema.EmptyObjectPolicyNull
+
+	// EmptyObjectPolicyEmpty stores null object values as an object where
+	// all attributes are null.
+	EmptyObjectPolicyEmpty = fwschema.EmptyObjectPolicyEmpty
+)
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -80,6 +83,40 @@ func TestFloat64AttributeApplyTerraform5AttributePathStep(t *testing.T) {
 	}
 }
 
+func TestFloat64AttributeFloat64DefaultValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Float64Attribute
+		expected  defaults.Float64
+	}{
+		"no-default": {
+			attribute: schema.Float64Attribute{},
+			expected:  nil,
+		},
+		"default": {
+			attribute: schema.Float64Attribute{
+				Default: testdefaults.Float64{},
+			},
+			expected: testdefaults.Float64{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.Float64DefaultValue()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestFloat64AttributeFloat64PlanModifiers(t *testing.T) {
 	t.Parallel()
 
@@ -148,6 +185,40 @@ func TestFloat64AttributeFloat64Validators(t *testing.T) {
 	}
 }
 
+func TestFloat64AttributeGetAllowNullAfterApply(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Float64Attribute
+		expected  bool
+	}{
+		"no-allow-null-after-apply": {
+			attribute: schema.Float64Attribute{},
+			expected:  false,
+		},
+		"allow-null-after-apply": {
+			attribute: schema.Float64Attribute{
+				AllowNullAfterApply: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetAllowNullAfterApply()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestFloat64AttributeGetDeprecationMessage(t *testing.T) {
 	t.Parallel()
 
@@ -251,6 +322,40 @@ func TestFloat64AttributeGetDescription(t *testing.T) {
 	}
 }
 
+func TestFloat64AttributeGetEventualConsistencyWindow(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Float64Attribute
+		expected  time.Duration
+	}{
+		"no-eventual-consistency-window": {
+			attribute: schema.Float64Attribute{},
+			expected:  0,
+		},
+		"eventual-consistency-window": {
+			attribute: schema.Float64Attribute{
+				EventualConsistencyWindow: 30 * time.Second,
+			},
+			expected: 30 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetEventualConsistencyWindow()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestFloat64AttributeGetMarkdownDescription(t *testing.T) {
 	t.Parallel()
 
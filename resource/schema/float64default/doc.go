@@ -0,0 +1,2 @@
+// Package float64default provides default values for types.Float64 attributes.
+package float64default
@@ -1,9 +1,12 @@
 package schema
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,9 +16,12 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                                 = Int64Attribute{}
-	_ fwxschema.AttributeWithInt64PlanModifiers = Int64Attribute{}
-	_ fwxschema.AttributeWithInt64Validators    = Int64Attribute{}
+	_ Attribute                                        = Int64Attribute{}
+	_ fwxschema.AttributeWithAllowNullAfterApply       = Int64Attribute{}
+	_ fwxschema.AttributeWithEventualConsistencyWindow = Int64Attribute{}
+	_ fwschema.AttributeWithInt64DefaultValue          = Int64Attribute{}
+	_ fwxschema.AttributeWithInt64PlanModifiers        = Int64Attribute{}
+	_ fwxschema.AttributeWithInt64Validators           = Int64Attribute{}
 )
 
 // Int64Attribute represents a schema attribute that is a 64-bit integer.
@@ -138,6 +144,33 @@ type Int64Attribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Int64
+
+	// Default defines a proposed new state (plan) value for the attribute
+	// if the configuration value is null. Default prevents the framework
+	// from automatically marking the value as unknown during planning when
+	// other proposed new state changes are detected. If the attribute is
+	// computed and the value could be altered by other changes then a default
+	// should be avoided and a plan modifier should be used instead. Computed
+	// must be true or Terraform will return an error.
+	Default defaults.Int64
+
+	// EventualConsistencyWindow declares that the remote system may briefly
+	// return stale values for this attribute after the resource is created or
+	// updated. When greater than zero and until the window has passed after
+	// the last Create or Update, the framework keeps the prior state value if
+	// Read sets a different value, so stale values are not reported as drift,
+	// and logs a warning with the attribute path. Values set by Create or
+	// Update are not modified, so those methods should set the planned value.
+	// Changes made outside Terraform during the window are not detected until
+	// a later refresh.
+	EventualConsistencyWindow time.Duration
+
+	// AllowNullAfterApply declares that this computed attribute may be null
+	// after the resource is created or updated, such as when the remote
+	// system only returns the value in certain conditions. When the provider
+	// enables the null computed attribute check, the framework does not
+	// return a warning diagnostic if this attribute is null after apply.
+	AllowNullAfterApply bool
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -156,6 +189,11 @@ func (a Int64Attribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetAllowNullAfterApply returns the AllowNullAfterApply field value.
+func (a Int64Attribute) GetAllowNullAfterApply() bool {
+	return a.AllowNullAfterApply
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a Int64Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -166,6 +204,12 @@ func (a Int64Attribute) GetDescription() string {
 	return a.Description
 }
 
+// GetEventualConsistencyWindow returns the EventualConsistencyWindow field
+// value.
+func (a Int64Attribute) GetEventualConsistencyWindow() time.Duration {
+	return a.EventualConsistencyWindow
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (a Int64Attribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -180,6 +224,11 @@ func (a Int64Attribute) GetType() attr.Type {
 	return types.Int64Type
 }
 
+// Int64DefaultValue returns the Default field value.
+func (a Int64Attribute) Int64DefaultValue() defaults.Int64 {
+	return a.Default
+}
+
 // Int64PlanModifiers returns the PlanModifiers field value.
 func (a Int64Attribute) Int64PlanModifiers() []planmodifier.Int64 {
 	return a.PlanModifiers
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -80,6 +83,40 @@ func TestInt64AttributeApplyTerraform5AttributePathStep(t *testing.T) {
 	}
 }
 
+func TestInt64AttributeGetAllowNullAfterApply(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Int64Attribute
+		expected  bool
+	}{
+		"no-allow-null-after-apply": {
+			attribute: schema.Int64Attribute{},
+			expected:  false,
+		},
+		"allow-null-after-apply": {
+			attribute: schema.Int64Attribute{
+				AllowNullAfterApply: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetAllowNullAfterApply()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestInt64AttributeGetDeprecationMessage(t *testing.T) {
 	t.Parallel()
 
@@ -183,6 +220,40 @@ func TestInt64AttributeGetDescription(t *testing.T) {
 	}
 }
 
+func TestInt64AttributeGetEventualConsistencyWindow(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Int64Attribute
+		expected  time.Duration
+	}{
+		"no-eventual-consistency-window": {
+			attribute: schema.Int64Attribute{},
+			expected:  0,
+		},
+		"eventual-consistency-window": {
+			attribute: schema.Int64Attribute{
+				EventualConsistencyWindow: 30 * time.Second,
+			},
+			expected: 30 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetEventualConsistencyWindow()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestInt64AttributeGetMarkdownDescription(t *testing.T) {
 	t.Parallel()
 
@@ -251,6 +322,40 @@ func TestInt64AttributeGetType(t *testing.T) {
 	}
 }
 
+func TestInt64AttributeInt64DefaultValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Int64Attribute
+		expected  defaults.Int64
+	}{
+		"no-default": {
+			attribute: schema.Int64Attribute{},
+			expected:  nil,
+		},
+		"default": {
+			attribute: schema.Int64Attribute{
+				Default: testdefaults.Int64{},
+			},
+			expected: testdefaults.Int64{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.Int64DefaultValue()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestInt64AttributeInt64PlanModifiers(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,2 @@
+// Package int64default provides default values for types.Int64 attributes.
+package int64default
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -80,6 +83,40 @@ func TestListAttributeApplyTerraform5AttributePathStep(t *testing.T) {
 	}
 }
 
+func TestListAttributeGetAllowNullAfterApply(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListAttribute
+		expected  bool
+	}{
+		"no-allow-null-after-apply": {
+			attribute: schema.ListAttribute{},
+			expected:  false,
+		},
+		"allow-null-after-apply": {
+			attribute: schema.ListAttribute{
+				AllowNullAfterApply: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetAllowNullAfterApply()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListAttributeGetDeprecationMessage(t *testing.T) {
 	t.Parallel()
 
@@ -188,6 +225,40 @@ func TestListAttributeGetDescription(t *testing.T) {
 	}
 }
 
+func TestListAttributeGetEventualConsistencyWindow(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListAttribute
+		expected  time.Duration
+	}{
+		"no-eventual-consistency-window": {
+			attribute: schema.ListAttribute{},
+			expected:  0,
+		},
+		"eventual-consistency-window": {
+			attribute: schema.ListAttribute{
+				EventualConsistencyWindow: 30 * time.Second,
+			},
+			expected: 30 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetEventualConsistencyWindow()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListAttributeGetMarkdownDescription(t *testing.T) {
 	t.Parallel()
 
@@ -392,6 +463,40 @@ func TestListAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestListAttributeListDefaultValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListAttribute
+		expected  defaults.List
+	}{
+		"no-default": {
+			attribute: schema.ListAttribute{},
+			expected:  nil,
+		},
+		"default": {
+			attribute: schema.ListAttribute{
+				Default: testdefaults.List{},
+			},
+			expected: testdefaults.List{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.ListDefaultValue()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListAttributeListPlanModifiers(t *testing.T) {
 	t.Parallel()
 
@@ -2,10 +2,12 @@ package schema
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,9 +17,12 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                          = ListNestedAttribute{}
-	_ fwxschema.AttributeWithListPlanModifiers = ListNestedAttribute{}
-	_ fwxschema.AttributeWithListValidators    = ListNestedAttribute{}
+	_ NestedAttribute                                  = ListNestedAttribute{}
+	_ fwxschema.AttributeWithAllowNullAfterApply       = ListNestedAttribute{}
+	_ fwxschema.AttributeWithEventualConsistencyWindow = ListNestedAttribute{}
+	_ fwschema.AttributeWithListDefaultValue           = ListNestedAttribute{}
+	_ fwxschema.AttributeWithListPlanModifiers         = ListNestedAttribute{}
+	_ fwxschema.AttributeWithListValidators            = ListNestedAttribute{}
 )
 
 // ListNestedAttribute represents an attribute that is a list of objects where
@@ -157,6 +162,33 @@ type ListNestedAttribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.List
+
+	// Default defines a proposed new state (plan) value for the attribute
+	// if the configuration value is null. Default prevents the framework
+	// from automatically marking the value as unknown during planning when
+	// other proposed new state changes are detected. If the attribute is
+	// computed and the value could be altered by other changes then a default
+	// should be avoided and a plan modifier should be used instead. Computed
+	// must be true or Terraform will return an error.
+	Default defaults.List
+
+	// EventualConsistencyWindow declares that the remote system may briefly
+	// return stale values for this attribute after the resource is created or
+	// updated. When greater than zero and until the window has passed after
+	// the last Create or Update, the framework keeps the prior state value if
+	// Read sets a different value, so stale values are not reported as drift,
+	// and logs a warning with the attribute path. Values set by Create or
+	// Update are not modified, so those methods should set the planned value.
+	// Changes made outside Terraform during the window are not detected until
+	// a later refresh.
+	EventualConsistencyWindow time.Duration
+
+	// AllowNullAfterApply declares that this computed attribute may be null
+	// after the resource is created or updated, such as when the remote
+	// system only returns the value in certain conditions. When the provider
+	// enables the null computed attribute check, the framework does not
+	// return a warning diagnostic if this attribute is null after apply.
+	AllowNullAfterApply bool
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -181,6 +213,11 @@ func (a ListNestedAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetAllowNullAfterApply returns the AllowNullAfterApply field value.
+func (a ListNestedAttribute) GetAllowNullAfterApply() bool {
+	return a.AllowNullAfterApply
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a ListNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -191,6 +228,12 @@ func (a ListNestedAttribute) GetDescription() string {
 	return a.Description
 }
 
+// GetEventualConsistencyWindow returns the EventualConsistencyWindow field
+// value.
+func (a ListNestedAttribute) GetEventualConsistencyWindow() time.Duration {
+	return a.EventualConsistencyWindow
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (a ListNestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -237,6 +280,11 @@ func (a ListNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// ListDefaultValue returns the Default field value.
+func (a ListNestedAttribute) ListDefaultValue() defaults.List {
+	return a.Default
+}
+
 // ListPlanModifiers returns the PlanModifiers field value.
 func (a ListNestedAttribute) ListPlanModifiers() []planmodifier.List {
 	return a.PlanModifiers
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -108,6 +111,40 @@ func TestListNestedAttributeApplyTerraform5AttributePathStep(t *testing.T) {
 	}
 }
 
+func TestListNestedAttributeGetAllowNullAfterApply(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListNestedAttribute
+		expected  bool
+	}{
+		"no-allow-null-after-apply": {
+			attribute: schema.ListNestedAttribute{},
+			expected:  false,
+		},
+		"allow-null-after-apply": {
+			attribute: schema.ListNestedAttribute{
+				AllowNullAfterApply: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetAllowNullAfterApply()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListNestedAttributeGetDeprecationMessage(t *testing.T) {
 	t.Parallel()
 
@@ -258,6 +295,40 @@ func TestListNestedAttributeGetDescription(t *testing.T) {
 	}
 }
 
+func TestListNestedAttributeGetEventualConsistencyWindow(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListNestedAttribute
+		expected  time.Duration
+	}{
+		"no-eventual-consistency-window": {
+			attribute: schema.ListNestedAttribute{},
+			expected:  0,
+		},
+		"eventual-consistency-window": {
+			attribute: schema.ListNestedAttribute{
+				EventualConsistencyWindow: 30 * time.Second,
+			},
+			expected: 30 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetEventualConsistencyWindow()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListNestedAttributeGetMarkdownDescription(t *testing.T) {
 	t.Parallel()
 
@@ -542,6 +613,40 @@ func TestListNestedAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestListNestedAttributeListDefaultValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListNestedAttribute
+		expected  defaults.List
+	}{
+		"no-default": {
+			attribute: schema.ListNestedAttribute{},
+			expected:  nil,
+		},
+		"default": {
+			attribute: schema.ListNestedAttribute{
+				Default: testdefaults.List{},
+			},
+			expected: testdefaults.List{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.ListDefaultValue()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListNestedAttributeListPlanModifiers(t *testing.T) {
 	t.Parallel()
 
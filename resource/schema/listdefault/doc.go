@@ -0,0 +1,2 @@
+// Package listdefault provides default values for types.List attributes.
+package listdefault
@@ -1,9 +1,12 @@
 package schema
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,9 +16,12 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                               = MapAttribute{}
-	_ fwxschema.AttributeWithMapPlanModifiers = MapAttribute{}
-	_ fwxschema.AttributeWithMapValidators    = MapAttribute{}
+	_ Attribute                                        = MapAttribute{}
+	_ fwxschema.AttributeWithAllowNullAfterApply       = MapAttribute{}
+	_ fwxschema.AttributeWithEventualConsistencyWindow = MapAttribute{}
+	_ fwschema.AttributeWithMapDefaultValue            = MapAttribute{}
+	_ fwxschema.AttributeWithMapPlanModifiers          = MapAttribute{}
+	_ fwxschema.AttributeWithMapValidators             = MapAttribute{}
 )
 
 // MapAttribute represents a schema attribute that is a list with a single
@@ -149,6 +155,33 @@ type MapAttribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Map
+
+	// Default defines a proposed new state (plan) value for the attribute
+	// if the configuration value is null. Default prevents the framework
+	// from automatically marking the value as unknown during planning when
+	// other proposed new state changes are detected. If the attribute is
+	// computed and the value could be altered by other changes then a default
+	// should be avoided and a plan modifier should be used instead. Computed
+	// must be true or Terraform will return an error.
+	Default defaults.Map
+
+	// EventualConsistencyWindow declares that the remote system may briefly
+	// return stale values for this attribute after the resource is created or
+	// updated. When greater than zero and until the window has passed after
+	// the last Create or Update, the framework keeps the prior state value if
+	// Read sets a different value, so stale values are not reported as drift,
+	// and logs a warning with the attribute path. Values set by Create or
+	// Update are not modified, so those methods should set the planned value.
+	// Changes made outside Terraform during the window are not detected until
+	// a later refresh.
+	EventualConsistencyWindow time.Duration
+
+	// AllowNullAfterApply declares that this computed attribute may be null
+	// after the resource is created or updated, such as when the remote
+	// system only returns the value in certain conditions. When the provider
+	// enables the null computed attribute check, the framework does not
+	// return a warning diagnostic if this attribute is null after apply.
+	AllowNullAfterApply bool
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a map
@@ -167,6 +200,11 @@ func (a MapAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetAllowNullAfterApply returns the AllowNullAfterApply field value.
+func (a MapAttribute) GetAllowNullAfterApply() bool {
+	return a.AllowNullAfterApply
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a MapAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -177,6 +215,12 @@ func (a MapAttribute) GetDescription() string {
 	return a.Description
 }
 
+// GetEventualConsistencyWindow returns the EventualConsistencyWindow field
+// value.
+func (a MapAttribute) GetEventualConsistencyWindow() time.Duration {
+	return a.EventualConsistencyWindow
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (a MapAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -213,6 +257,11 @@ func (a MapAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// MapDefaultValue returns the Default field value.
+func (a MapAttribute) MapDefaultValue() defaults.Map {
+	return a.Default
+}
+
 // MapPlanModifiers returns the PlanModifiers field value.
 func (a MapAttribute) MapPlanModifiers() []planmodifier.Map {
 	return a.PlanModifiers
@@ -2,12 +2,14 @@ package schema
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,9 +18,12 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                         = MapNestedAttribute{}
-	_ fwxschema.AttributeWithMapPlanModifiers = MapNestedAttribute{}
-	_ fwxschema.AttributeWithMapValidators    = MapNestedAttribute{}
+	_ NestedAttribute                                  = MapNestedAttribute{}
+	_ fwxschema.AttributeWithAllowNullAfterApply       = MapNestedAttribute{}
+	_ fwxschema.AttributeWithEventualConsistencyWindow = MapNestedAttribute{}
+	_ fwschema.AttributeWithMapDefaultValue            = MapNestedAttribute{}
+	_ fwxschema.AttributeWithMapPlanModifiers          = MapNestedAttribute{}
+	_ fwxschema.AttributeWithMapValidators             = MapNestedAttribute{}
 )
 
 // MapNestedAttribute represents an attribute that is a set of objects where
@@ -158,6 +163,33 @@ type MapNestedAttribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Map
+
+	// Default defines a proposed new state (plan) value for the attribute
+	// if the configuration value is null. Default prevents the framework
+	// from automatically marking the value as unknown during planning when
+	// other proposed new state changes are detected. If the attribute is
+	// computed and the value could be altered by other changes then a default
+	// should be avoided and a plan modifier should be used instead. Computed
+	// must be true or Terraform will return an error.
+	Default defaults.Map
+
+	// EventualConsistencyWindow declares that the remote system may briefly
+	// return stale values for this attribute after the resource is created or
+	// updated. When greater than zero and until the window has passed after
+	// the last Create or Update, the framework keeps the prior state value if
+	// Read sets a different value, so stale values are not reported as drift,
+	// and logs a warning with the attribute path. Values set by Create or
+	// Update are not modified, so those methods should set the planned value.
+	// Changes made outside Terraform during the window are not detected until
+	// a later refresh.
+	EventualConsistencyWindow time.Duration
+
+	// AllowNullAfterApply declares that this computed attribute may be null
+	// after the resource is created or updated, such as when the remote
+	// system only returns the value in certain conditions. When the provider
+	// enables the null computed attribute check, the framework does not
+	// return a warning diagnostic if this attribute is null after apply.
+	AllowNullAfterApply bool
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -182,6 +214,11 @@ func (a MapNestedAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetAllowNullAfterApply returns the AllowNullAfterApply field value.
+func (a MapNestedAttribute) GetAllowNullAfterApply() bool {
+	return a.AllowNullAfterApply
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a MapNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -192,6 +229,12 @@ func (a MapNestedAttribute) GetDescription() string {
 	return a.Description
 }
 
+// GetEventualConsistencyWindow returns the EventualConsistencyWindow field
+// value.
+func (a MapNestedAttribute) GetEventualConsistencyWindow() time.Duration {
+	return a.EventualConsistencyWindow
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (a MapNestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -238,6 +281,11 @@ func (a MapNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// MapDefaultValue returns the Default field value.
+func (a MapNestedAttribute) MapDefaultValue() defaults.Map {
+	return a.Default
+}
+
 // MapPlanModifiers returns the PlanModifiers field value.
 func (a MapNestedAttribute) MapPlanModifiers() []planmodifier.Map {
 	return a.PlanModifiers
@@ -0,0 +1,2 @@
+// Package mapdefault provides default values for types.Map attributes.
+package mapdefault
@@ -0,0 +1,39 @@
+package mapdefault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StaticValue returns a static map value default handler.
+//
+// Use StaticValue if a static default value for a map should be set.
+func StaticValue(defaultVal types.Map) defaults.Map {
+	return staticMapDefault{
+		defaultVal: defaultVal,
+	}
+}
+
+// staticMapDefault is static value default handler that
+// sets a value on a map attribute.
+type staticMapDefault struct {
+	defaultVal types.Map
+}
+
+// Description returns a human-readable description of the default value handler.
+func (d staticMapDefault) Description(_ context.Context) string {
+	return fmt.Sprintf("value defaults to %v", d.defaultVal)
+}
+
+// MarkdownDescription returns a markdown description of the default value handler.
+func (d staticMapDefault) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value defaults to `%v`", d.defaultVal)
+}
+
+// DefaultMap implements the static default value logic.
+func (d staticMapDefault) DefaultMap(_ context.Context, req defaults.MapRequest, resp *defaults.MapResponse) {
+	resp.PlanValue = d.defaultVal
+}
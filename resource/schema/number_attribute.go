@@ -1,9 +1,12 @@
 package schema
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,9 +16,12 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                                  = NumberAttribute{}
-	_ fwxschema.AttributeWithNumberPlanModifiers = NumberAttribute{}
-	_ fwxschema.AttributeWithNumberValidators    = NumberAttribute{}
+	_ Attribute                                        = NumberAttribute{}
+	_ fwxschema.AttributeWithAllowNullAfterApply       = NumberAttribute{}
+	_ fwxschema.AttributeWithEventualConsistencyWindow = NumberAttribute{}
+	_ fwschema.AttributeWithNumberDefaultValue         = NumberAttribute{}
+	_ fwxschema.AttributeWithNumberPlanModifiers       = NumberAttribute{}
+	_ fwxschema.AttributeWithNumberValidators          = NumberAttribute{}
 )
 
 // NumberAttribute represents a schema attribute that is a generic number with
@@ -139,6 +145,33 @@ type NumberAttribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Number
+
+	// Default defines a proposed new state (plan) value for the attribute
+	// if the configuration value is null. Default prevents the framework
+	// from automatically marking the value as unknown during planning when
+	// other proposed new state changes are detected. If the attribute is
+	// computed and the value could be altered by other changes then a default
+	// should be avoided and a plan modifier should be used instead. Computed
+	// must be true or Terraform will return an error.
+	Default defaults.Number
+
+	// EventualConsistencyWindow declares that the remote system may briefly
+	// return stale values for this attribute after the resource is created or
+	// updated. When greater than zero and until the window has passed after
+	// the last Create or Update, the framework keeps the prior state value if
+	// Read sets a different value, so stale values are not reported as drift,
+	// and logs a warning with the attribute path. Values set by Create or
+	// Update are not modified, so those methods should set the planned value.
+	// Changes made outside Terraform during the window are not detected until
+	// a later refresh.
+	EventualConsistencyWindow time.Duration
+
+	// AllowNullAfterApply declares that this computed attribute may be null
+	// after the resource is created or updated, such as when the remote
+	// system only returns the value in certain conditions. When the provider
+	// enables the null computed attribute check, the framework does not
+	// return a warning diagnostic if this attribute is null after apply.
+	AllowNullAfterApply bool
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -157,6 +190,11 @@ func (a NumberAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetAllowNullAfterApply returns the AllowNullAfterApply field value.
+func (a NumberAttribute) GetAllowNullAfterApply() bool {
+	return a.AllowNullAfterApply
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a NumberAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -167,6 +205,12 @@ func (a NumberAttribute) GetDescription() string {
 	return a.Description
 }
 
+// GetEventualConsistencyWindow returns the EventualConsistencyWindow field
+// value.
+func (a NumberAttribute) GetEventualConsistencyWindow() time.Duration {
+	return a.EventualConsistencyWindow
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (a NumberAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -201,6 +245,11 @@ func (a NumberAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// NumberDefaultValue returns the Default field value.
+func (a NumberAttribute) NumberDefaultValue() defaults.Number {
+	return a.Default
+}
+
 // NumberPlanModifiers returns the PlanModifiers field value.
 func (a NumberAttribute) NumberPlanModifiers() []planmodifier.Number {
 	return a.PlanModifiers
@@ -0,0 +1,2 @@
+// Package numberdefault provides default values for types.Number attributes.
+package numberdefault
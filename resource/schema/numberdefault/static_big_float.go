@@ -0,0 +1,40 @@
+package numberdefault
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StaticBigFloat returns a static number value default handler.
+//
+// Use StaticBigFloat if a static default value for a number should be set.
+func StaticBigFloat(defaultVal *big.Float) defaults.Number {
+	return staticNumberDefault{
+		defaultVal: defaultVal,
+	}
+}
+
+// staticNumberDefault is static value default handler that
+// sets a value on a number attribute.
+type staticNumberDefault struct {
+	defaultVal *big.Float
+}
+
+// Description returns a human-readable description of the default value handler.
+func (d staticNumberDefault) Description(_ context.Context) string {
+	return fmt.Sprintf("value defaults to %s", d.defaultVal.Text('g', -1))
+}
+
+// MarkdownDescription returns a markdown description of the default value handler.
+func (d staticNumberDefault) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value defaults to `%s`", d.defaultVal.Text('g', -1))
+}
+
+// DefaultNumber implements the static default value logic.
+func (d staticNumberDefault) DefaultNumber(_ context.Context, req defaults.NumberRequest, resp *defaults.NumberResponse) {
+	resp.PlanValue = types.NumberValue(d.defaultVal)
+}
@@ -0,0 +1,2 @@
+// Package objectdefault provides default values for types.Object attributes.
+package objectdefault
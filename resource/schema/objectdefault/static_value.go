@@ -0,0 +1,39 @@
+package objectdefault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StaticValue returns a static object value default handler.
+//
+// Use StaticValue if a static default value for a object should be set.
+func StaticValue(defaultVal types.Object) defaults.Object {
+	return staticObjectDefault{
+		defaultVal: defaultVal,
+	}
+}
+
+// staticObjectDefault is static value default handler that
+// sets a value on an object attribute.
+type staticObjectDefault struct {
+	defaultVal types.Object
+}
+
+// Description returns a human-readable description of the default value handler.
+func (d staticObjectDefault) Description(_ context.Context) string {
+	return fmt.Sprintf("value defaults to %v", d.defaultVal)
+}
+
+// MarkdownDescription returns a markdown description of the default value handler.
+func (d staticObjectDefault) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value defaults to `%v`", d.defaultVal)
+}
+
+// DefaultObject implements the static default value logic.
+func (d staticObjectDefault) DefaultObject(_ context.Context, req defaults.ObjectRequest, resp *defaults.ObjectResponse) {
+	resp.PlanValue = d.defaultVal
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -419,6 +420,125 @@ func TestSchemaAttributeAtTerraformPath(t *testing.T) {
 	}
 }
 
+func TestSchemaAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected path.Expressions
+	}{
+		"no-attributes-or-blocks": {
+			schema:   schema.Schema{},
+			expected: nil,
+		},
+		"attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr2": schema.ListAttribute{
+						ElementType: types.StringType,
+					},
+					"testattr1": schema.StringAttribute{},
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("testattr1"),
+				path.MatchRoot("testattr2"),
+			},
+		},
+		"nested-attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"testattr": schema.StringAttribute{},
+							},
+						},
+					},
+					"map": schema.MapNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"testattr": schema.StringAttribute{},
+							},
+						},
+					},
+					"set": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"testattr": schema.StringAttribute{},
+							},
+						},
+					},
+					"single": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"testattr": schema.StringAttribute{},
+						},
+					},
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("list"),
+				path.MatchRoot("list").AtAnyListIndex().AtName("testattr"),
+				path.MatchRoot("map"),
+				path.MatchRoot("map").AtAnyMapKey().AtName("testattr"),
+				path.MatchRoot("set"),
+				path.MatchRoot("set").AtAnySetValue().AtName("testattr"),
+				path.MatchRoot("single"),
+				path.MatchRoot("single").AtName("testattr"),
+			},
+		},
+		"blocks": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"list": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"testattr": schema.StringAttribute{},
+							},
+							Blocks: map[string]schema.Block{
+								"nested": schema.SingleNestedBlock{
+									Attributes: map[string]schema.Attribute{
+										"testattr": schema.StringAttribute{},
+									},
+								},
+							},
+						},
+					},
+					"set": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"testattr": schema.StringAttribute{},
+							},
+						},
+					},
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("list"),
+				path.MatchRoot("list").AtAnyListIndex().AtName("nested"),
+				path.MatchRoot("list").AtAnyListIndex().AtName("nested").AtName("testattr"),
+				path.MatchRoot("list").AtAnyListIndex().AtName("testattr"),
+				path.MatchRoot("set"),
+				path.MatchRoot("set").AtAnySetValue().AtName("testattr"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.AttributePaths()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSchemaGetAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -1016,10 +1136,152 @@ func TestSchemaValidate(t *testing.T) {
 		"empty-schema": {
 			schema: schema.Schema{},
 		},
+		"attribute-missing-required-optional-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Implementation",
+					`Attribute "test" must enable one of Required, Optional, or Computed. `+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"attribute-required-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Computed: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Implementation",
+					`Attribute "test" must not enable Required with Optional or Computed. `+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"attribute-default-not-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Default:  stringdefault.StaticString("test"),
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Implementation",
+					`Attribute "test" must enable Computed when using Default. `+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"attribute-missing-element-type": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.ListAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Implementation",
+					`Attribute "test" has an invalid type: missing element type. `+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"attribute-missing-nested-element-type": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.MapAttribute{
+						ElementType: types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"nested": types.SetType{},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Implementation",
+					`Attribute "test" has an invalid type: object attribute "nested": missing element type. `+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"nested-attribute-invalid-implementation": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested": schema.BoolAttribute{},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtName("nested"),
+					"Invalid Attribute Implementation",
+					`Attribute "test.nested" must enable one of Required, Optional, or Computed. `+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"block-attribute-invalid-implementation": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test": schema.SingleNestedBlock{
+						Blocks: map[string]schema.Block{
+							"nested": schema.ListNestedBlock{
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"nested_attr": schema.BoolAttribute{
+											Optional: true,
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtName("nested").AtName("nested_attr"),
+					"Invalid Attribute Implementation",
+					`Attribute "test.nested.nested_attr" must not enable Required with Optional or Computed. `+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
 		"attribute-using-reserved-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1048,8 +1310,11 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"depends_on": schema.BoolAttribute{},
+							"depends_on": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1060,7 +1325,9 @@ func TestSchemaValidate(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"connection": schema.BoolAttribute{},
+							"connection": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1070,9 +1337,12 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"list_nested_attribute": schema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"depends_on": schema.Int64Attribute{},
+								"depends_on": schema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1085,7 +1355,9 @@ func TestSchemaValidate(t *testing.T) {
 					"list_nested_block": schema.ListNestedBlock{
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
-								"connection": schema.BoolAttribute{},
+								"connection": schema.BoolAttribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1095,7 +1367,9 @@ func TestSchemaValidate(t *testing.T) {
 		"attribute-and-blocks-using-reserved-field-names": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 				Blocks: map[string]schema.Block{
 					"connection": schema.ListNestedBlock{},
@@ -1117,7 +1391,9 @@ func TestSchemaValidate(t *testing.T) {
 		"attribute-using-invalid-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"^": schema.StringAttribute{},
+					"^": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1146,8 +1422,11 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1165,7 +1444,9 @@ func TestSchemaValidate(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1182,8 +1463,11 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"$": schema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1206,7 +1490,9 @@ func TestSchemaValidate(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"$": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1231,7 +1517,9 @@ func TestSchemaValidate(t *testing.T) {
 						Blocks: map[string]schema.Block{
 							"^": schema.SingleNestedBlock{
 								Attributes: map[string]schema.Attribute{
-									"!": schema.BoolAttribute{},
+									"!": schema.BoolAttribute{
+										Optional: true,
+									},
 								},
 							},
 						},
@@ -1260,9 +1548,12 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"list_nested_attribute": schema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"^": schema.Int64Attribute{},
+								"^": schema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1282,7 +1573,9 @@ func TestSchemaValidate(t *testing.T) {
 					"list_nested_block": schema.ListNestedBlock{
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
-								"^": schema.Int64Attribute{},
+								"^": schema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1300,9 +1593,12 @@ func TestSchemaValidate(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"$": schema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"^": schema.Int64Attribute{},
+								"^": schema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1327,7 +1623,9 @@ func TestSchemaValidate(t *testing.T) {
 					"$": schema.ListNestedBlock{
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
-								"^": schema.Int64Attribute{},
+								"^": schema.Int64Attribute{
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -1354,7 +1652,9 @@ func TestSchemaValidate(t *testing.T) {
 							Blocks: map[string]schema.Block{
 								"^": schema.SingleNestedBlock{
 									Attributes: map[string]schema.Attribute{
-										"!": schema.BoolAttribute{},
+										"!": schema.BoolAttribute{
+											Optional: true,
+										},
 									},
 								},
 							},
@@ -0,0 +1,83 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// BoolOption configures an attribute created by Bool. AttributeOption,
+// DescriptionOption, and the WithBool options implement BoolOption.
+type BoolOption interface {
+	applyBool(*boolOptions)
+}
+
+// boolOption implements BoolOption for the WithBool options.
+type boolOption func(*boolOptions)
+
+func (f boolOption) applyBool(o *boolOptions) { f(o) }
+
+// boolOptions contains the configuration collected from all BoolOption.
+type boolOptions struct {
+	attributeOptions
+
+	customType    basetypes.BoolTypable
+	defaultValue  defaults.Bool
+	planModifiers []planmodifier.Bool
+	validators    []validator.Bool
+}
+
+// Bool returns a schema.BoolAttribute configured by the given options.
+func Bool(opts ...BoolOption) schema.BoolAttribute {
+	var o boolOptions
+
+	for _, opt := range opts {
+		opt.applyBool(&o)
+	}
+
+	return schema.BoolAttribute{
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
+
+// WithBoolCustomType sets the attribute CustomType field.
+func WithBoolCustomType(customType basetypes.BoolTypable) BoolOption {
+	return boolOption(func(o *boolOptions) {
+		o.customType = customType
+	})
+}
+
+// WithBoolDefault sets the attribute Default field.
+func WithBoolDefault(defaultValue defaults.Bool) BoolOption {
+	return boolOption(func(o *boolOptions) {
+		o.defaultValue = defaultValue
+	})
+}
+
+// WithBoolPlanModifiers appends to the attribute PlanModifiers field.
+func WithBoolPlanModifiers(planModifiers ...planmodifier.Bool) BoolOption {
+	return boolOption(func(o *boolOptions) {
+		o.planModifiers = append(o.planModifiers, planModifiers...)
+	})
+}
+
+// WithBoolValidators appends to the attribute Validators field.
+func WithBoolValidators(validators ...validator.Bool) BoolOption {
+	return boolOption(func(o *boolOptions) {
+		o.validators = append(o.validators, validators...)
+	})
+}
@@ -0,0 +1,79 @@
+package schemabuilder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestBool(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     []schemabuilder.BoolOption
+		expected schema.BoolAttribute
+	}{
+		"no-options": {
+			expected: schema.BoolAttribute{},
+		},
+		"required": {
+			opts: []schemabuilder.BoolOption{
+				schemabuilder.Required(),
+			},
+			expected: schema.BoolAttribute{
+				Required: true,
+			},
+		},
+		"all-options": {
+			opts: []schemabuilder.BoolOption{
+				schemabuilder.Computed(),
+				schemabuilder.Sensitive(),
+				schemabuilder.AllowNullAfterApply(),
+				schemabuilder.EventualConsistencyWindow(time.Minute),
+				schemabuilder.Description("test description"),
+				schemabuilder.MarkdownDescription("test markdown description"),
+				schemabuilder.DeprecationMessage("test deprecation message"),
+				schemabuilder.WithBoolCustomType(basetypes.BoolType{}),
+				schemabuilder.WithBoolDefault(booldefault.StaticBool(true)),
+				schemabuilder.WithBoolPlanModifiers(testplanmodifier.Bool{}),
+				schemabuilder.WithBoolValidators(testvalidator.Bool{}),
+			},
+			expected: schema.BoolAttribute{
+				AllowNullAfterApply:       true,
+				Computed:                  true,
+				CustomType:                basetypes.BoolType{},
+				Default:                   booldefault.StaticBool(true),
+				DeprecationMessage:        "test deprecation message",
+				Description:               "test description",
+				EventualConsistencyWindow: time.Minute,
+				MarkdownDescription:       "test markdown description",
+				PlanModifiers:             []planmodifier.Bool{testplanmodifier.Bool{}},
+				Sensitive:                 true,
+				Validators:                []validator.Bool{testvalidator.Bool{}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.Bool(testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Package schemabuilder contains functions for creating resource schema
+// attributes and blocks using functional options, as an alternative to struct
+// literals. This can reduce verbosity for providers with many attribute
+// definitions and simplifies generating schemas programmatically. For example:
+//
+//	schemabuilder.String(
+//		schemabuilder.Required(),
+//		schemabuilder.Description("Name of the thing."),
+//		schemabuilder.WithStringValidators(stringvalidator.LengthAtLeast(1)),
+//	)
+//
+// Options common to all attributes, such as Required, or to all attributes and
+// blocks, such as Description, can be passed to any builder function which
+// supports them. Type specific options, such as WithStringValidators, are
+// named after the attribute value type and are checked by the compiler.
+//
+// Each function returns the equivalent resource/schema package attribute or
+// block type, so builders and struct literals can be mixed within a schema.
+package schemabuilder
@@ -0,0 +1,83 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Float64Option configures an attribute created by Float64. AttributeOption,
+// DescriptionOption, and the WithFloat64 options implement Float64Option.
+type Float64Option interface {
+	applyFloat64(*float64Options)
+}
+
+// float64Option implements Float64Option for the WithFloat64 options.
+type float64Option func(*float64Options)
+
+func (f float64Option) applyFloat64(o *float64Options) { f(o) }
+
+// float64Options contains the configuration collected from all Float64Option.
+type float64Options struct {
+	attributeOptions
+
+	customType    basetypes.Float64Typable
+	defaultValue  defaults.Float64
+	planModifiers []planmodifier.Float64
+	validators    []validator.Float64
+}
+
+// Float64 returns a schema.Float64Attribute configured by the given options.
+func Float64(opts ...Float64Option) schema.Float64Attribute {
+	var o float64Options
+
+	for _, opt := range opts {
+		opt.applyFloat64(&o)
+	}
+
+	return schema.Float64Attribute{
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
+
+// WithFloat64CustomType sets the attribute CustomType field.
+func WithFloat64CustomType(customType basetypes.Float64Typable) Float64Option {
+	return float64Option(func(o *float64Options) {
+		o.customType = customType
+	})
+}
+
+// WithFloat64Default sets the attribute Default field.
+func WithFloat64Default(defaultValue defaults.Float64) Float64Option {
+	return float64Option(func(o *float64Options) {
+		o.defaultValue = defaultValue
+	})
+}
+
+// WithFloat64PlanModifiers appends to the attribute PlanModifiers field.
+func WithFloat64PlanModifiers(planModifiers ...planmodifier.Float64) Float64Option {
+	return float64Option(func(o *float64Options) {
+		o.planModifiers = append(o.planModifiers, planModifiers...)
+	})
+}
+
+// WithFloat64Validators appends to the attribute Validators field.
+func WithFloat64Validators(validators ...validator.Float64) Float64Option {
+	return float64Option(func(o *float64Options) {
+		o.validators = append(o.validators, validators...)
+	})
+}
@@ -0,0 +1,79 @@
+package schemabuilder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     []schemabuilder.Float64Option
+		expected schema.Float64Attribute
+	}{
+		"no-options": {
+			expected: schema.Float64Attribute{},
+		},
+		"required": {
+			opts: []schemabuilder.Float64Option{
+				schemabuilder.Required(),
+			},
+			expected: schema.Float64Attribute{
+				Required: true,
+			},
+		},
+		"all-options": {
+			opts: []schemabuilder.Float64Option{
+				schemabuilder.Computed(),
+				schemabuilder.Sensitive(),
+				schemabuilder.AllowNullAfterApply(),
+				schemabuilder.EventualConsistencyWindow(time.Minute),
+				schemabuilder.Description("test description"),
+				schemabuilder.MarkdownDescription("test markdown description"),
+				schemabuilder.DeprecationMessage("test deprecation message"),
+				schemabuilder.WithFloat64CustomType(basetypes.Float64Type{}),
+				schemabuilder.WithFloat64Default(float64default.StaticFloat64(1.5)),
+				schemabuilder.WithFloat64PlanModifiers(testplanmodifier.Float64{}),
+				schemabuilder.WithFloat64Validators(testvalidator.Float64{}),
+			},
+			expected: schema.Float64Attribute{
+				AllowNullAfterApply:       true,
+				Computed:                  true,
+				CustomType:                basetypes.Float64Type{},
+				Default:                   float64default.StaticFloat64(1.5),
+				DeprecationMessage:        "test deprecation message",
+				Description:               "test description",
+				EventualConsistencyWindow: time.Minute,
+				MarkdownDescription:       "test markdown description",
+				PlanModifiers:             []planmodifier.Float64{testplanmodifier.Float64{}},
+				Sensitive:                 true,
+				Validators:                []validator.Float64{testvalidator.Float64{}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.Float64(testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Int64Option configures an attribute created by Int64. AttributeOption,
+// DescriptionOption, and the WithInt64 options implement Int64Option.
+type Int64Option interface {
+	applyInt64(*int64Options)
+}
+
+// int64Option implements Int64Option for the WithInt64 options.
+type int64Option func(*int64Options)
+
+func (f int64Option) applyInt64(o *int64Options) { f(o) }
+
+// int64Options contains the configuration collected from all Int64Option.
+type int64Options struct {
+	attributeOptions
+
+	customType    basetypes.Int64Typable
+	defaultValue  defaults.Int64
+	planModifiers []planmodifier.Int64
+	validators    []validator.Int64
+}
+
+// Int64 returns a schema.Int64Attribute configured by the given options.
+func Int64(opts ...Int64Option) schema.Int64Attribute {
+	var o int64Options
+
+	for _, opt := range opts {
+		opt.applyInt64(&o)
+	}
+
+	return schema.Int64Attribute{
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
+
+// WithInt64CustomType sets the attribute CustomType field.
+func WithInt64CustomType(customType basetypes.Int64Typable) Int64Option {
+	return int64Option(func(o *int64Options) {
+		o.customType = customType
+	})
+}
+
+// WithInt64Default sets the attribute Default field.
+func WithInt64Default(defaultValue defaults.Int64) Int64Option {
+	return int64Option(func(o *int64Options) {
+		o.defaultValue = defaultValue
+	})
+}
+
+// WithInt64PlanModifiers appends to the attribute PlanModifiers field.
+func WithInt64PlanModifiers(planModifiers ...planmodifier.Int64) Int64Option {
+	return int64Option(func(o *int64Options) {
+		o.planModifiers = append(o.planModifiers, planModifiers...)
+	})
+}
+
+// WithInt64Validators appends to the attribute Validators field.
+func WithInt64Validators(validators ...validator.Int64) Int64Option {
+	return int64Option(func(o *int64Options) {
+		o.validators = append(o.validators, validators...)
+	})
+}
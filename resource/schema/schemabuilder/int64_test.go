@@ -0,0 +1,79 @@
+package schemabuilder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     []schemabuilder.Int64Option
+		expected schema.Int64Attribute
+	}{
+		"no-options": {
+			expected: schema.Int64Attribute{},
+		},
+		"required": {
+			opts: []schemabuilder.Int64Option{
+				schemabuilder.Required(),
+			},
+			expected: schema.Int64Attribute{
+				Required: true,
+			},
+		},
+		"all-options": {
+			opts: []schemabuilder.Int64Option{
+				schemabuilder.Computed(),
+				schemabuilder.Sensitive(),
+				schemabuilder.AllowNullAfterApply(),
+				schemabuilder.EventualConsistencyWindow(time.Minute),
+				schemabuilder.Description("test description"),
+				schemabuilder.MarkdownDescription("test markdown description"),
+				schemabuilder.DeprecationMessage("test deprecation message"),
+				schemabuilder.WithInt64CustomType(basetypes.Int64Type{}),
+				schemabuilder.WithInt64Default(int64default.StaticInt64(1)),
+				schemabuilder.WithInt64PlanModifiers(testplanmodifier.Int64{}),
+				schemabuilder.WithInt64Validators(testvalidator.Int64{}),
+			},
+			expected: schema.Int64Attribute{
+				AllowNullAfterApply:       true,
+				Computed:                  true,
+				CustomType:                basetypes.Int64Type{},
+				Default:                   int64default.StaticInt64(1),
+				DeprecationMessage:        "test deprecation message",
+				Description:               "test description",
+				EventualConsistencyWindow: time.Minute,
+				MarkdownDescription:       "test markdown description",
+				PlanModifiers:             []planmodifier.Int64{testplanmodifier.Int64{}},
+				Sensitive:                 true,
+				Validators:                []validator.Int64{testvalidator.Int64{}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.Int64(testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ListOption configures an attribute created by List or ListNested.
+// AttributeOption, DescriptionOption, ListTypeOption, and WithListDefault
+// implement ListOption.
+type ListOption interface {
+	applyList(*listOptions)
+}
+
+// ListBlockOption configures a block created by ListNestedBlock.
+// DescriptionOption and ListTypeOption implement ListBlockOption.
+type ListBlockOption interface {
+	applyListBlock(*listBlockOptions)
+}
+
+// ListTypeOption configures a field common to all list based attributes and
+// blocks, such as Validators.
+type ListTypeOption func(*listTypeOptions)
+
+func (f ListTypeOption) applyList(o *listOptions)           { f(&o.listTypeOptions) }
+func (f ListTypeOption) applyListBlock(o *listBlockOptions) { f(&o.listTypeOptions) }
+
+// listOption implements ListOption for attribute only options.
+type listOption func(*listOptions)
+
+func (f listOption) applyList(o *listOptions) { f(o) }
+
+// listTypeOptions contains the configuration collected from all ListTypeOption.
+type listTypeOptions struct {
+	customType    basetypes.ListTypable
+	planModifiers []planmodifier.List
+	validators    []validator.List
+}
+
+// listOptions contains the configuration collected from all ListOption.
+type listOptions struct {
+	attributeOptions
+	listTypeOptions
+
+	defaultValue defaults.List
+}
+
+// listBlockOptions contains the configuration collected from all
+// ListBlockOption.
+type listBlockOptions struct {
+	descriptionOptions
+	listTypeOptions
+}
+
+// List returns a schema.ListAttribute with the given element type, configured
+// by the given options.
+func List(elementType attr.Type, opts ...ListOption) schema.ListAttribute {
+	o := newListOptions(opts)
+
+	return schema.ListAttribute{
+		ElementType:               elementType,
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
+
+// WithListCustomType sets the attribute or block CustomType field.
+func WithListCustomType(customType basetypes.ListTypable) ListTypeOption {
+	return func(o *listTypeOptions) {
+		o.customType = customType
+	}
+}
+
+// WithListDefault sets the attribute Default field.
+func WithListDefault(defaultValue defaults.List) ListOption {
+	return listOption(func(o *listOptions) {
+		o.defaultValue = defaultValue
+	})
+}
+
+// WithListPlanModifiers appends to the attribute or block PlanModifiers field.
+func WithListPlanModifiers(planModifiers ...planmodifier.List) ListTypeOption {
+	return func(o *listTypeOptions) {
+		o.planModifiers = append(o.planModifiers, planModifiers...)
+	}
+}
+
+// WithListValidators appends to the attribute or block Validators field.
+func WithListValidators(validators ...validator.List) ListTypeOption {
+	return func(o *listTypeOptions) {
+		o.validators = append(o.validators, validators...)
+	}
+}
+
+// newListOptions returns the options after applying all given ListOption.
+func newListOptions(opts []ListOption) listOptions {
+	var o listOptions
+
+	for _, opt := range opts {
+		opt.applyList(&o)
+	}
+
+	return o
+}
+
+// newListBlockOptions returns the options after applying all given
+// ListBlockOption.
+func newListBlockOptions(opts []ListBlockOption) listBlockOptions {
+	var o listBlockOptions
+
+	for _, opt := range opts {
+		opt.applyListBlock(&o)
+	}
+
+	return o
+}
@@ -0,0 +1,28 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// ListNested returns a schema.ListNestedAttribute with the given nested object,
+// configured by the given options.
+func ListNested(nestedObject schema.NestedAttributeObject, opts ...ListOption) schema.ListNestedAttribute {
+	o := newListOptions(opts)
+
+	return schema.ListNestedAttribute{
+		NestedObject:              nestedObject,
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
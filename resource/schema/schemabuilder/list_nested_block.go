@@ -0,0 +1,21 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// ListNestedBlock returns a schema.ListNestedBlock with the given nested object, configured by
+// the given options.
+func ListNestedBlock(nestedObject schema.NestedBlockObject, opts ...ListBlockOption) schema.ListNestedBlock {
+	o := newListBlockOptions(opts)
+
+	return schema.ListNestedBlock{
+		NestedObject:        nestedObject,
+		CustomType:          o.customType,
+		DeprecationMessage:  o.deprecationMessage,
+		Description:         o.description,
+		MarkdownDescription: o.markdownDescription,
+		PlanModifiers:       o.planModifiers,
+		Validators:          o.validators,
+	}
+}
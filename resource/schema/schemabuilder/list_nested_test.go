@@ -0,0 +1,96 @@
+package schemabuilder_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListNested(t *testing.T) {
+	t.Parallel()
+
+	testNestedObject := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"test": schemabuilder.String(schemabuilder.Required()),
+		},
+	}
+
+	testDefault := listdefault.StaticValue(
+		types.ListValueMust(
+			types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"test": types.StringType,
+				},
+			},
+			nil,
+		),
+	)
+
+	testCases := map[string]struct {
+		nestedObject schema.NestedAttributeObject
+		opts         []schemabuilder.ListOption
+		expected     schema.ListNestedAttribute
+	}{
+		"nested-object": {
+			nestedObject: testNestedObject,
+			expected: schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+		"options": {
+			nestedObject: testNestedObject,
+			opts: []schemabuilder.ListOption{
+				schemabuilder.Optional(),
+				schemabuilder.Computed(),
+				schemabuilder.Description("test description"),
+				schemabuilder.WithListDefault(testDefault),
+				schemabuilder.WithListPlanModifiers(testplanmodifier.List{}),
+				schemabuilder.WithListValidators(testvalidator.List{}),
+			},
+			expected: schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				Computed:      true,
+				Default:       testDefault,
+				Description:   "test description",
+				Optional:      true,
+				PlanModifiers: []planmodifier.List{testplanmodifier.List{}},
+				Validators:    []validator.List{testvalidator.List{}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.ListNested(testCase.nestedObject, testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package schemabuilder_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType attr.Type
+		opts        []schemabuilder.ListOption
+		expected    schema.ListAttribute
+	}{
+		"element-type": {
+			elementType: types.StringType,
+			expected: schema.ListAttribute{
+				ElementType: types.StringType,
+			},
+		},
+		"options": {
+			elementType: types.Int64Type,
+			opts: []schemabuilder.ListOption{
+				schemabuilder.Optional(),
+				schemabuilder.WithListValidators(testvalidator.List{}),
+			},
+			expected: schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators:  []validator.List{testvalidator.List{}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.List(testCase.elementType, testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
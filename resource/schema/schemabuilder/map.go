@@ -0,0 +1,94 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// MapOption configures an attribute created by Map or MapNested.
+// AttributeOption, DescriptionOption, and the WithMap options implement
+// MapOption.
+type MapOption interface {
+	applyMap(*mapOptions)
+}
+
+// mapOption implements MapOption for the WithMap options.
+type mapOption func(*mapOptions)
+
+func (f mapOption) applyMap(o *mapOptions) { f(o) }
+
+// mapOptions contains the configuration collected from all MapOption.
+type mapOptions struct {
+	attributeOptions
+
+	customType    basetypes.MapTypable
+	defaultValue  defaults.Map
+	planModifiers []planmodifier.Map
+	validators    []validator.Map
+}
+
+// Map returns a schema.MapAttribute with the given element type, configured
+// by the given options.
+func Map(elementType attr.Type, opts ...MapOption) schema.MapAttribute {
+	o := newMapOptions(opts)
+
+	return schema.MapAttribute{
+		ElementType:               elementType,
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
+
+// WithMapCustomType sets the attribute CustomType field.
+func WithMapCustomType(customType basetypes.MapTypable) MapOption {
+	return mapOption(func(o *mapOptions) {
+		o.customType = customType
+	})
+}
+
+// WithMapDefault sets the attribute Default field.
+func WithMapDefault(defaultValue defaults.Map) MapOption {
+	return mapOption(func(o *mapOptions) {
+		o.defaultValue = defaultValue
+	})
+}
+
+// WithMapPlanModifiers appends to the attribute PlanModifiers field.
+func WithMapPlanModifiers(planModifiers ...planmodifier.Map) MapOption {
+	return mapOption(func(o *mapOptions) {
+		o.planModifiers = append(o.planModifiers, planModifiers...)
+	})
+}
+
+// WithMapValidators appends to the attribute Validators field.
+func WithMapValidators(validators ...validator.Map) MapOption {
+	return mapOption(func(o *mapOptions) {
+		o.validators = append(o.validators, validators...)
+	})
+}
+
+// newMapOptions returns the options after applying all given MapOption.
+func newMapOptions(opts []MapOption) mapOptions {
+	var o mapOptions
+
+	for _, opt := range opts {
+		opt.applyMap(&o)
+	}
+
+	return o
+}
@@ -0,0 +1,65 @@
+package schemabuilder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType attr.Type
+		opts        []schemabuilder.MapOption
+		expected    schema.MapAttribute
+	}{
+		"element-type": {
+			elementType: types.StringType,
+			expected: schema.MapAttribute{
+				ElementType: types.StringType,
+			},
+		},
+		"options": {
+			elementType: types.Int64Type,
+			opts: []schemabuilder.MapOption{
+				schemabuilder.Computed(),
+				schemabuilder.AllowNullAfterApply(),
+				schemabuilder.EventualConsistencyWindow(time.Minute),
+				schemabuilder.WithMapPlanModifiers(testplanmodifier.Map{}),
+				schemabuilder.WithMapValidators(testvalidator.Map{}),
+			},
+			expected: schema.MapAttribute{
+				AllowNullAfterApply:       true,
+				Computed:                  true,
+				ElementType:               types.Int64Type,
+				EventualConsistencyWindow: time.Minute,
+				PlanModifiers:             []planmodifier.Map{testplanmodifier.Map{}},
+				Validators:                []validator.Map{testvalidator.Map{}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.Map(testCase.elementType, testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// NumberOption configures an attribute created by Number. AttributeOption,
+// DescriptionOption, and the WithNumber options implement NumberOption.
+type NumberOption interface {
+	applyNumber(*numberOptions)
+}
+
+// numberOption implements NumberOption for the WithNumber options.
+type numberOption func(*numberOptions)
+
+func (f numberOption) applyNumber(o *numberOptions) { f(o) }
+
+// numberOptions contains the configuration collected from all NumberOption.
+type numberOptions struct {
+	attributeOptions
+
+	customType    basetypes.NumberTypable
+	defaultValue  defaults.Number
+	planModifiers []planmodifier.Number
+	validators    []validator.Number
+}
+
+// Number returns a schema.NumberAttribute configured by the given options.
+func Number(opts ...NumberOption) schema.NumberAttribute {
+	var o numberOptions
+
+	for _, opt := range opts {
+		opt.applyNumber(&o)
+	}
+
+	return schema.NumberAttribute{
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
+
+// WithNumberCustomType sets the attribute CustomType field.
+func WithNumberCustomType(customType basetypes.NumberTypable) NumberOption {
+	return numberOption(func(o *numberOptions) {
+		o.customType = customType
+	})
+}
+
+// WithNumberDefault sets the attribute Default field.
+func WithNumberDefault(defaultValue defaults.Number) NumberOption {
+	return numberOption(func(o *numberOptions) {
+		o.defaultValue = defaultValue
+	})
+}
+
+// WithNumberPlanModifiers appends to the attribute PlanModifiers field.
+func WithNumberPlanModifiers(planModifiers ...planmodifier.Number) NumberOption {
+	return numberOption(func(o *numberOptions) {
+		o.planModifiers = append(o.planModifiers, planModifiers...)
+	})
+}
+
+// WithNumberValidators appends to the attribute Validators field.
+func WithNumberValidators(validators ...validator.Number) NumberOption {
+	return numberOption(func(o *numberOptions) {
+		o.validators = append(o.validators, validators...)
+	})
+}
@@ -0,0 +1,76 @@
+package schemabuilder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     []schemabuilder.NumberOption
+		expected schema.NumberAttribute
+	}{
+		"no-options": {
+			expected: schema.NumberAttribute{},
+		},
+		"required": {
+			opts: []schemabuilder.NumberOption{
+				schemabuilder.Required(),
+			},
+			expected: schema.NumberAttribute{
+				Required: true,
+			},
+		},
+		"all-options": {
+			opts: []schemabuilder.NumberOption{
+				schemabuilder.Computed(),
+				schemabuilder.Sensitive(),
+				schemabuilder.AllowNullAfterApply(),
+				schemabuilder.EventualConsistencyWindow(time.Minute),
+				schemabuilder.Description("test description"),
+				schemabuilder.MarkdownDescription("test markdown description"),
+				schemabuilder.DeprecationMessage("test deprecation message"),
+				schemabuilder.WithNumberCustomType(basetypes.NumberType{}),
+				schemabuilder.WithNumberPlanModifiers(testplanmodifier.Number{}),
+				schemabuilder.WithNumberValidators(testvalidator.Number{}),
+			},
+			expected: schema.NumberAttribute{
+				AllowNullAfterApply:       true,
+				Computed:                  true,
+				CustomType:                basetypes.NumberType{},
+				DeprecationMessage:        "test deprecation message",
+				Description:               "test description",
+				EventualConsistencyWindow: time.Minute,
+				MarkdownDescription:       "test markdown description",
+				PlanModifiers:             []planmodifier.Number{testplanmodifier.Number{}},
+				Sensitive:                 true,
+				Validators:                []validator.Number{testvalidator.Number{}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.Number(testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
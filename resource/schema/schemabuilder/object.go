@@ -0,0 +1,135 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ObjectOption configures an attribute created by Object or SingleNested.
+// AttributeOption, DescriptionOption, ObjectTypeOption, and WithObjectDefault
+// implement ObjectOption.
+type ObjectOption interface {
+	SingleNestedOption
+
+	applyObject(*objectOptions)
+}
+
+// ObjectBlockOption configures a block created by SingleNestedBlock.
+// DescriptionOption and ObjectTypeOption implement ObjectBlockOption.
+type ObjectBlockOption interface {
+	applyObjectBlock(*objectBlockOptions)
+}
+
+// ObjectTypeOption configures a field common to all object based attributes and
+// blocks, such as Validators.
+type ObjectTypeOption func(*objectTypeOptions)
+
+func (f ObjectTypeOption) applyObject(o *objectOptions)             { f(&o.objectTypeOptions) }
+func (f ObjectTypeOption) applyObjectBlock(o *objectBlockOptions)   { f(&o.objectTypeOptions) }
+func (f ObjectTypeOption) applySingleNested(o *singleNestedOptions) { f(&o.objectTypeOptions) }
+
+// objectOption implements ObjectOption for attribute only options.
+type objectOption func(*objectOptions)
+
+func (f objectOption) applyObject(o *objectOptions)             { f(o) }
+func (f objectOption) applySingleNested(o *singleNestedOptions) { f(&o.objectOptions) }
+
+// objectTypeOptions contains the configuration collected from all ObjectTypeOption.
+type objectTypeOptions struct {
+	customType    basetypes.ObjectTypable
+	planModifiers []planmodifier.Object
+	validators    []validator.Object
+}
+
+// objectOptions contains the configuration collected from all ObjectOption.
+type objectOptions struct {
+	attributeOptions
+	objectTypeOptions
+
+	defaultValue defaults.Object
+}
+
+// objectBlockOptions contains the configuration collected from all
+// ObjectBlockOption.
+type objectBlockOptions struct {
+	descriptionOptions
+	objectTypeOptions
+}
+
+// Object returns a schema.ObjectAttribute with the given attribute types,
+// configured by the given options.
+func Object(attributeTypes map[string]attr.Type, opts ...ObjectOption) schema.ObjectAttribute {
+	o := newObjectOptions(opts)
+
+	return schema.ObjectAttribute{
+		AttributeTypes:            attributeTypes,
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
+
+// WithObjectCustomType sets the attribute or block CustomType field.
+func WithObjectCustomType(customType basetypes.ObjectTypable) ObjectTypeOption {
+	return func(o *objectTypeOptions) {
+		o.customType = customType
+	}
+}
+
+// WithObjectDefault sets the attribute Default field.
+func WithObjectDefault(defaultValue defaults.Object) ObjectOption {
+	return objectOption(func(o *objectOptions) {
+		o.defaultValue = defaultValue
+	})
+}
+
+// WithObjectPlanModifiers appends to the attribute or block PlanModifiers field.
+func WithObjectPlanModifiers(planModifiers ...planmodifier.Object) ObjectTypeOption {
+	return func(o *objectTypeOptions) {
+		o.planModifiers = append(o.planModifiers, planModifiers...)
+	}
+}
+
+// WithObjectValidators appends to the attribute or block Validators field.
+func WithObjectValidators(validators ...validator.Object) ObjectTypeOption {
+	return func(o *objectTypeOptions) {
+		o.validators = append(o.validators, validators...)
+	}
+}
+
+// newObjectOptions returns the options after applying all given ObjectOption.
+func newObjectOptions(opts []ObjectOption) objectOptions {
+	var o objectOptions
+
+	for _, opt := range opts {
+		opt.applyObject(&o)
+	}
+
+	return o
+}
+
+// newObjectBlockOptions returns the options after applying all given
+// ObjectBlockOption.
+func newObjectBlockOptions(opts []ObjectBlockOption) objectBlockOptions {
+	var o objectBlockOptions
+
+	for _, opt := range opts {
+		opt.applyObjectBlock(&o)
+	}
+
+	return o
+}
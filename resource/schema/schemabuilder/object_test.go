@@ -0,0 +1,62 @@
+package schemabuilder_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestObject(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributeTypes map[string]attr.Type
+		opts           []schemabuilder.ObjectOption
+		expected       schema.ObjectAttribute
+	}{
+		"attribute-types": {
+			attributeTypes: map[string]attr.Type{
+				"test": types.StringType,
+			},
+			expected: schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{
+					"test": types.StringType,
+				},
+			},
+		},
+		"options": {
+			attributeTypes: map[string]attr.Type{
+				"test": types.BoolType,
+			},
+			opts: []schemabuilder.ObjectOption{
+				schemabuilder.Computed(),
+				schemabuilder.Description("test description"),
+			},
+			expected: schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{
+					"test": types.BoolType,
+				},
+				Computed:    true,
+				Description: "test description",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.Object(testCase.attributeTypes, testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
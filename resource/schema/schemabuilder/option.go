@@ -0,0 +1,121 @@
+package schemabuilder
+
+import "time"
+
+// AttributeOption configures a field common to all attributes, such as
+// Required or Sensitive. It can be passed to any attribute builder function,
+// but not to block builder functions.
+type AttributeOption func(*attributeOptions)
+
+// DescriptionOption configures a field common to all attributes and blocks,
+// such as Description. It can be passed to any builder function.
+type DescriptionOption func(*descriptionOptions)
+
+// descriptionOptions contains the configuration common to all attributes and
+// blocks.
+type descriptionOptions struct {
+	deprecationMessage  string
+	description         string
+	markdownDescription string
+}
+
+// attributeOptions contains the configuration common to all attributes.
+type attributeOptions struct {
+	descriptionOptions
+
+	allowNullAfterApply       bool
+	computed                  bool
+	eventualConsistencyWindow time.Duration
+	optional                  bool
+	required                  bool
+	sensitive                 bool
+}
+
+// AllowNullAfterApply sets the attribute AllowNullAfterApply field to true.
+func AllowNullAfterApply() AttributeOption {
+	return func(o *attributeOptions) {
+		o.allowNullAfterApply = true
+	}
+}
+
+// Computed sets the attribute Computed field to true.
+func Computed() AttributeOption {
+	return func(o *attributeOptions) {
+		o.computed = true
+	}
+}
+
+// DeprecationMessage sets the attribute or block DeprecationMessage field.
+func DeprecationMessage(message string) DescriptionOption {
+	return func(o *descriptionOptions) {
+		o.deprecationMessage = message
+	}
+}
+
+// Description sets the attribute or block Description field.
+func Description(description string) DescriptionOption {
+	return func(o *descriptionOptions) {
+		o.description = description
+	}
+}
+
+// EventualConsistencyWindow sets the attribute EventualConsistencyWindow
+// field.
+func EventualConsistencyWindow(window time.Duration) AttributeOption {
+	return func(o *attributeOptions) {
+		o.eventualConsistencyWindow = window
+	}
+}
+
+// MarkdownDescription sets the attribute or block MarkdownDescription field.
+func MarkdownDescription(description string) DescriptionOption {
+	return func(o *descriptionOptions) {
+		o.markdownDescription = description
+	}
+}
+
+// Optional sets the attribute Optional field to true.
+func Optional() AttributeOption {
+	return func(o *attributeOptions) {
+		o.optional = true
+	}
+}
+
+// Required sets the attribute Required field to true.
+func Required() AttributeOption {
+	return func(o *attributeOptions) {
+		o.required = true
+	}
+}
+
+// Sensitive sets the attribute Sensitive field to true.
+func Sensitive() AttributeOption {
+	return func(o *attributeOptions) {
+		o.sensitive = true
+	}
+}
+
+func (f AttributeOption) applyBool(o *boolOptions)                 { f(&o.attributeOptions) }
+func (f AttributeOption) applyFloat64(o *float64Options)           { f(&o.attributeOptions) }
+func (f AttributeOption) applyInt64(o *int64Options)               { f(&o.attributeOptions) }
+func (f AttributeOption) applyList(o *listOptions)                 { f(&o.attributeOptions) }
+func (f AttributeOption) applyMap(o *mapOptions)                   { f(&o.attributeOptions) }
+func (f AttributeOption) applyNumber(o *numberOptions)             { f(&o.attributeOptions) }
+func (f AttributeOption) applyObject(o *objectOptions)             { f(&o.attributeOptions) }
+func (f AttributeOption) applySet(o *setOptions)                   { f(&o.attributeOptions) }
+func (f AttributeOption) applySingleNested(o *singleNestedOptions) { f(&o.attributeOptions) }
+func (f AttributeOption) applyString(o *stringOptions)             { f(&o.attributeOptions) }
+
+func (f DescriptionOption) applyBool(o *boolOptions)                 { f(&o.descriptionOptions) }
+func (f DescriptionOption) applyFloat64(o *float64Options)           { f(&o.descriptionOptions) }
+func (f DescriptionOption) applyInt64(o *int64Options)               { f(&o.descriptionOptions) }
+func (f DescriptionOption) applyList(o *listOptions)                 { f(&o.descriptionOptions) }
+func (f DescriptionOption) applyListBlock(o *listBlockOptions)       { f(&o.descriptionOptions) }
+func (f DescriptionOption) applyMap(o *mapOptions)                   { f(&o.descriptionOptions) }
+func (f DescriptionOption) applyNumber(o *numberOptions)             { f(&o.descriptionOptions) }
+func (f DescriptionOption) applyObject(o *objectOptions)             { f(&o.descriptionOptions) }
+func (f DescriptionOption) applyObjectBlock(o *objectBlockOptions)   { f(&o.descriptionOptions) }
+func (f DescriptionOption) applySet(o *setOptions)                   { f(&o.descriptionOptions) }
+func (f DescriptionOption) applySetBlock(o *setBlockOptions)         { f(&o.descriptionOptions) }
+func (f DescriptionOption) applySingleNested(o *singleNestedOptions) { f(&o.descriptionOptions) }
+func (f DescriptionOption) applyString(o *stringOptions)             { f(&o.descriptionOptions) }
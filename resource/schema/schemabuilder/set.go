@@ -0,0 +1,131 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// SetOption configures an attribute created by Set or SetNested.
+// AttributeOption, DescriptionOption, SetTypeOption, and WithSetDefault
+// implement SetOption.
+type SetOption interface {
+	applySet(*setOptions)
+}
+
+// SetBlockOption configures a block created by SetNestedBlock.
+// DescriptionOption and SetTypeOption implement SetBlockOption.
+type SetBlockOption interface {
+	applySetBlock(*setBlockOptions)
+}
+
+// SetTypeOption configures a field common to all set based attributes and
+// blocks, such as Validators.
+type SetTypeOption func(*setTypeOptions)
+
+func (f SetTypeOption) applySet(o *setOptions)           { f(&o.setTypeOptions) }
+func (f SetTypeOption) applySetBlock(o *setBlockOptions) { f(&o.setTypeOptions) }
+
+// setOption implements SetOption for attribute only options.
+type setOption func(*setOptions)
+
+func (f setOption) applySet(o *setOptions) { f(o) }
+
+// setTypeOptions contains the configuration collected from all SetTypeOption.
+type setTypeOptions struct {
+	customType    basetypes.SetTypable
+	planModifiers []planmodifier.Set
+	validators    []validator.Set
+}
+
+// setOptions contains the configuration collected from all SetOption.
+type setOptions struct {
+	attributeOptions
+	setTypeOptions
+
+	defaultValue defaults.Set
+}
+
+// setBlockOptions contains the configuration collected from all
+// SetBlockOption.
+type setBlockOptions struct {
+	descriptionOptions
+	setTypeOptions
+}
+
+// Set returns a schema.SetAttribute with the given element type, configured
+// by the given options.
+func Set(elementType attr.Type, opts ...SetOption) schema.SetAttribute {
+	o := newSetOptions(opts)
+
+	return schema.SetAttribute{
+		ElementType:               elementType,
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
+
+// WithSetCustomType sets the attribute or block CustomType field.
+func WithSetCustomType(customType basetypes.SetTypable) SetTypeOption {
+	return func(o *setTypeOptions) {
+		o.customType = customType
+	}
+}
+
+// WithSetDefault sets the attribute Default field.
+func WithSetDefault(defaultValue defaults.Set) SetOption {
+	return setOption(func(o *setOptions) {
+		o.defaultValue = defaultValue
+	})
+}
+
+// WithSetPlanModifiers appends to the attribute or block PlanModifiers field.
+func WithSetPlanModifiers(planModifiers ...planmodifier.Set) SetTypeOption {
+	return func(o *setTypeOptions) {
+		o.planModifiers = append(o.planModifiers, planModifiers...)
+	}
+}
+
+// WithSetValidators appends to the attribute or block Validators field.
+func WithSetValidators(validators ...validator.Set) SetTypeOption {
+	return func(o *setTypeOptions) {
+		o.validators = append(o.validators, validators...)
+	}
+}
+
+// newSetOptions returns the options after applying all given SetOption.
+func newSetOptions(opts []SetOption) setOptions {
+	var o setOptions
+
+	for _, opt := range opts {
+		opt.applySet(&o)
+	}
+
+	return o
+}
+
+// newSetBlockOptions returns the options after applying all given
+// SetBlockOption.
+func newSetBlockOptions(opts []SetBlockOption) setBlockOptions {
+	var o setBlockOptions
+
+	for _, opt := range opts {
+		opt.applySetBlock(&o)
+	}
+
+	return o
+}
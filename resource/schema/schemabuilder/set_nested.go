@@ -0,0 +1,28 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// SetNested returns a schema.SetNestedAttribute with the given nested object,
+// configured by the given options.
+func SetNested(nestedObject schema.NestedAttributeObject, opts ...SetOption) schema.SetNestedAttribute {
+	o := newSetOptions(opts)
+
+	return schema.SetNestedAttribute{
+		NestedObject:              nestedObject,
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
@@ -0,0 +1,21 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// SetNestedBlock returns a schema.SetNestedBlock with the given nested object, configured by
+// the given options.
+func SetNestedBlock(nestedObject schema.NestedBlockObject, opts ...SetBlockOption) schema.SetNestedBlock {
+	o := newSetBlockOptions(opts)
+
+	return schema.SetNestedBlock{
+		NestedObject:        nestedObject,
+		CustomType:          o.customType,
+		DeprecationMessage:  o.deprecationMessage,
+		Description:         o.description,
+		MarkdownDescription: o.markdownDescription,
+		PlanModifiers:       o.planModifiers,
+		Validators:          o.validators,
+	}
+}
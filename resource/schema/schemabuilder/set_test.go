@@ -0,0 +1,65 @@
+package schemabuilder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType attr.Type
+		opts        []schemabuilder.SetOption
+		expected    schema.SetAttribute
+	}{
+		"element-type": {
+			elementType: types.StringType,
+			expected: schema.SetAttribute{
+				ElementType: types.StringType,
+			},
+		},
+		"options": {
+			elementType: types.Int64Type,
+			opts: []schemabuilder.SetOption{
+				schemabuilder.Computed(),
+				schemabuilder.AllowNullAfterApply(),
+				schemabuilder.EventualConsistencyWindow(time.Minute),
+				schemabuilder.WithSetPlanModifiers(testplanmodifier.Set{}),
+				schemabuilder.WithSetValidators(testvalidator.Set{}),
+			},
+			expected: schema.SetAttribute{
+				AllowNullAfterApply:       true,
+				Computed:                  true,
+				ElementType:               types.Int64Type,
+				EventualConsistencyWindow: time.Minute,
+				PlanModifiers:             []planmodifier.Set{testplanmodifier.Set{}},
+				Validators:                []validator.Set{testvalidator.Set{}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.Set(testCase.elementType, testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
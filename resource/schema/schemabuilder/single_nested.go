@@ -0,0 +1,62 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// SingleNestedOption configures an attribute created by SingleNested.
+// ObjectOption and WithSingleNestedEmptyObjectPolicy implement
+// SingleNestedOption.
+type SingleNestedOption interface {
+	applySingleNested(*singleNestedOptions)
+}
+
+// singleNestedOption implements SingleNestedOption for SingleNested only
+// options.
+type singleNestedOption func(*singleNestedOptions)
+
+func (f singleNestedOption) applySingleNested(o *singleNestedOptions) { f(o) }
+
+// singleNestedOptions contains the configuration collected from all
+// SingleNestedOption.
+type singleNestedOptions struct {
+	objectOptions
+
+	emptyObjectPolicy schema.EmptyObjectPolicy
+}
+
+// SingleNested returns a schema.SingleNestedAttribute with the given
+// attributes, configured by the given options.
+func SingleNested(attributes map[string]schema.Attribute, opts ...SingleNestedOption) schema.SingleNestedAttribute {
+	var o singleNestedOptions
+
+	for _, opt := range opts {
+		opt.applySingleNested(&o)
+	}
+
+	return schema.SingleNestedAttribute{
+		Attributes:                attributes,
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EmptyObjectPolicy:         o.emptyObjectPolicy,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
+
+// WithSingleNestedEmptyObjectPolicy sets the attribute EmptyObjectPolicy
+// field.
+func WithSingleNestedEmptyObjectPolicy(policy schema.EmptyObjectPolicy) SingleNestedOption {
+	return singleNestedOption(func(o *singleNestedOptions) {
+		o.emptyObjectPolicy = policy
+	})
+}
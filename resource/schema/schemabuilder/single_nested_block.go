@@ -0,0 +1,22 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// SingleNestedBlock returns a schema.SingleNestedBlock with the given
+// attributes and blocks, configured by the given options.
+func SingleNestedBlock(attributes map[string]schema.Attribute, blocks map[string]schema.Block, opts ...ObjectBlockOption) schema.SingleNestedBlock {
+	o := newObjectBlockOptions(opts)
+
+	return schema.SingleNestedBlock{
+		Attributes:          attributes,
+		Blocks:              blocks,
+		CustomType:          o.customType,
+		DeprecationMessage:  o.deprecationMessage,
+		Description:         o.description,
+		MarkdownDescription: o.markdownDescription,
+		PlanModifiers:       o.planModifiers,
+		Validators:          o.validators,
+	}
+}
@@ -0,0 +1,67 @@
+package schemabuilder_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func TestSingleNestedBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes map[string]schema.Attribute
+		blocks     map[string]schema.Block
+		opts       []schemabuilder.ObjectBlockOption
+		expected   schema.SingleNestedBlock
+	}{
+		"attributes-blocks": {
+			attributes: map[string]schema.Attribute{
+				"test_attribute": schemabuilder.Bool(schemabuilder.Optional()),
+			},
+			blocks: map[string]schema.Block{
+				"test_block": schemabuilder.ListNestedBlock(schema.NestedBlockObject{}),
+			},
+			expected: schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"test_attribute": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"test_block": schema.ListNestedBlock{},
+				},
+			},
+		},
+		"options": {
+			opts: []schemabuilder.ObjectBlockOption{
+				schemabuilder.DeprecationMessage("test deprecation message"),
+				schemabuilder.MarkdownDescription("test markdown description"),
+				schemabuilder.WithObjectValidators(testvalidator.Object{}),
+			},
+			expected: schema.SingleNestedBlock{
+				DeprecationMessage:  "test deprecation message",
+				MarkdownDescription: "test markdown description",
+				Validators:          []validator.Object{testvalidator.Object{}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.SingleNestedBlock(testCase.attributes, testCase.blocks, testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
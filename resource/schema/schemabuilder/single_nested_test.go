@@ -0,0 +1,64 @@
+package schemabuilder_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+)
+
+func TestSingleNested(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes map[string]schema.Attribute
+		opts       []schemabuilder.SingleNestedOption
+		expected   schema.SingleNestedAttribute
+	}{
+		"attributes": {
+			attributes: map[string]schema.Attribute{
+				"test": schemabuilder.String(),
+			},
+			expected: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{},
+				},
+			},
+		},
+		"options": {
+			attributes: map[string]schema.Attribute{
+				"test": schemabuilder.String(schemabuilder.Computed()),
+			},
+			opts: []schemabuilder.SingleNestedOption{
+				schemabuilder.Optional(),
+				schemabuilder.Description("test description"),
+				schemabuilder.WithSingleNestedEmptyObjectPolicy(schema.EmptyObjectPolicyNull),
+			},
+			expected: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Description:       "test description",
+				EmptyObjectPolicy: schema.EmptyObjectPolicyNull,
+				Optional:          true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.SingleNested(testCase.attributes, testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// StringOption configures an attribute created by String. AttributeOption,
+// DescriptionOption, and the WithString options implement StringOption.
+type StringOption interface {
+	applyString(*stringOptions)
+}
+
+// stringOption implements StringOption for the WithString options.
+type stringOption func(*stringOptions)
+
+func (f stringOption) applyString(o *stringOptions) { f(o) }
+
+// stringOptions contains the configuration collected from all StringOption.
+type stringOptions struct {
+	attributeOptions
+
+	customType    basetypes.StringTypable
+	defaultValue  defaults.String
+	planModifiers []planmodifier.String
+	validators    []validator.String
+}
+
+// String returns a schema.StringAttribute configured by the given options.
+func String(opts ...StringOption) schema.StringAttribute {
+	var o stringOptions
+
+	for _, opt := range opts {
+		opt.applyString(&o)
+	}
+
+	return schema.StringAttribute{
+		AllowNullAfterApply:       o.allowNullAfterApply,
+		Computed:                  o.computed,
+		CustomType:                o.customType,
+		Default:                   o.defaultValue,
+		DeprecationMessage:        o.deprecationMessage,
+		Description:               o.description,
+		EventualConsistencyWindow: o.eventualConsistencyWindow,
+		MarkdownDescription:       o.markdownDescription,
+		Optional:                  o.optional,
+		PlanModifiers:             o.planModifiers,
+		Required:                  o.required,
+		Sensitive:                 o.sensitive,
+		Validators:                o.validators,
+	}
+}
+
+// WithStringCustomType sets the attribute CustomType field.
+func WithStringCustomType(customType basetypes.StringTypable) StringOption {
+	return stringOption(func(o *stringOptions) {
+		o.customType = customType
+	})
+}
+
+// WithStringDefault sets the attribute Default field.
+func WithStringDefault(defaultValue defaults.String) StringOption {
+	return stringOption(func(o *stringOptions) {
+		o.defaultValue = defaultValue
+	})
+}
+
+// WithStringPlanModifiers appends to the attribute PlanModifiers field.
+func WithStringPlanModifiers(planModifiers ...planmodifier.String) StringOption {
+	return stringOption(func(o *stringOptions) {
+		o.planModifiers = append(o.planModifiers, planModifiers...)
+	})
+}
+
+// WithStringValidators appends to the attribute Validators field.
+func WithStringValidators(validators ...validator.String) StringOption {
+	return stringOption(func(o *stringOptions) {
+		o.validators = append(o.validators, validators...)
+	})
+}
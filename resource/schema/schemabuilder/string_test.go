@@ -0,0 +1,97 @@
+package schemabuilder_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     []schemabuilder.StringOption
+		expected schema.StringAttribute
+	}{
+		"no-options": {
+			expected: schema.StringAttribute{},
+		},
+		"required": {
+			opts: []schemabuilder.StringOption{
+				schemabuilder.Required(),
+			},
+			expected: schema.StringAttribute{
+				Required: true,
+			},
+		},
+		"optional-computed": {
+			opts: []schemabuilder.StringOption{
+				schemabuilder.Optional(),
+				schemabuilder.Computed(),
+			},
+			expected: schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+			},
+		},
+		"all-options": {
+			opts: []schemabuilder.StringOption{
+				schemabuilder.Required(),
+				schemabuilder.Sensitive(),
+				schemabuilder.Description("test description"),
+				schemabuilder.MarkdownDescription("test markdown description"),
+				schemabuilder.DeprecationMessage("test deprecation message"),
+				schemabuilder.WithStringCustomType(timetypes.GoDurationType{}),
+				schemabuilder.WithStringDefault(stringdefault.StaticString("test-default")),
+				schemabuilder.WithStringPlanModifiers(testplanmodifier.String{}),
+				schemabuilder.WithStringValidators(testvalidator.String{}),
+			},
+			expected: schema.StringAttribute{
+				CustomType:          timetypes.GoDurationType{},
+				Default:             stringdefault.StaticString("test-default"),
+				DeprecationMessage:  "test deprecation message",
+				Description:         "test description",
+				MarkdownDescription: "test markdown description",
+				PlanModifiers:       []planmodifier.String{testplanmodifier.String{}},
+				Required:            true,
+				Sensitive:           true,
+				Validators:          []validator.String{testvalidator.String{}},
+			},
+		},
+		"multiple-validators": {
+			opts: []schemabuilder.StringOption{
+				schemabuilder.WithStringValidators(testvalidator.String{}),
+				schemabuilder.WithStringValidators(testvalidator.String{}, testvalidator.String{}),
+			},
+			expected: schema.StringAttribute{
+				Validators: []validator.String{
+					testvalidator.String{},
+					testvalidator.String{},
+					testvalidator.String{},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemabuilder.String(testCase.opts...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
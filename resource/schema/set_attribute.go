@@ -1,9 +1,12 @@
 package schema
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,9 +16,12 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                               = SetAttribute{}
-	_ fwxschema.AttributeWithSetPlanModifiers = SetAttribute{}
-	_ fwxschema.AttributeWithSetValidators    = SetAttribute{}
+	_ Attribute                                        = SetAttribute{}
+	_ fwxschema.AttributeWithAllowNullAfterApply       = SetAttribute{}
+	_ fwxschema.AttributeWithEventualConsistencyWindow = SetAttribute{}
+	_ fwschema.AttributeWithSetDefaultValue            = SetAttribute{}
+	_ fwxschema.AttributeWithSetPlanModifiers          = SetAttribute{}
+	_ fwxschema.AttributeWithSetValidators             = SetAttribute{}
 )
 
 // SetAttribute represents a schema attribute that is a set with a single
@@ -144,6 +150,33 @@ type SetAttribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Set
+
+	// Default defines a proposed new state (plan) value for the attribute
+	// if the configuration value is null. Default prevents the framework
+	// from automatically marking the value as unknown during planning when
+	// other proposed new state changes are detected. If the attribute is
+	// computed and the value could be altered by other changes then a default
+	// should be avoided and a plan modifier should be used instead. Computed
+	// must be true or Terraform will return an error.
+	Default defaults.Set
+
+	// EventualConsistencyWindow declares that the remote system may briefly
+	// return stale values for this attribute after the resource is created or
+	// updated. When greater than zero and until the window has passed after
+	// the last Create or Update, the framework keeps the prior state value if
+	// Read sets a different value, so stale values are not reported as drift,
+	// and logs a warning with the attribute path. Values set by Create or
+	// Update are not modified, so those methods should set the planned value.
+	// Changes made outside Terraform during the window are not detected until
+	// a later refresh.
+	EventualConsistencyWindow time.Duration
+
+	// AllowNullAfterApply declares that this computed attribute may be null
+	// after the resource is created or updated, such as when the remote
+	// system only returns the value in certain conditions. When the provider
+	// enables the null computed attribute check, the framework does not
+	// return a warning diagnostic if this attribute is null after apply.
+	AllowNullAfterApply bool
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a set
@@ -162,6 +195,11 @@ func (a SetAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetAllowNullAfterApply returns the AllowNullAfterApply field value.
+func (a SetAttribute) GetAllowNullAfterApply() bool {
+	return a.AllowNullAfterApply
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a SetAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -172,6 +210,12 @@ func (a SetAttribute) GetDescription() string {
 	return a.Description
 }
 
+// GetEventualConsistencyWindow returns the EventualConsistencyWindow field
+// value.
+func (a SetAttribute) GetEventualConsistencyWindow() time.Duration {
+	return a.EventualConsistencyWindow
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (a SetAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -208,6 +252,11 @@ func (a SetAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// SetDefaultValue returns the Default field value.
+func (a SetAttribute) SetDefaultValue() defaults.Set {
+	return a.Default
+}
+
 // SetPlanModifiers returns the PlanModifiers field value.
 func (a SetAttribute) SetPlanModifiers() []planmodifier.Set {
 	return a.PlanModifiers
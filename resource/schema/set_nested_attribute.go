@@ -2,12 +2,14 @@ package schema
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,9 +18,12 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                         = SetNestedAttribute{}
-	_ fwxschema.AttributeWithSetPlanModifiers = SetNestedAttribute{}
-	_ fwxschema.AttributeWithSetValidators    = SetNestedAttribute{}
+	_ NestedAttribute                                  = SetNestedAttribute{}
+	_ fwxschema.AttributeWithAllowNullAfterApply       = SetNestedAttribute{}
+	_ fwxschema.AttributeWithEventualConsistencyWindow = SetNestedAttribute{}
+	_ fwschema.AttributeWithSetDefaultValue            = SetNestedAttribute{}
+	_ fwxschema.AttributeWithSetPlanModifiers          = SetNestedAttribute{}
+	_ fwxschema.AttributeWithSetValidators             = SetNestedAttribute{}
 )
 
 // SetNestedAttribute represents an attribute that is a set of objects where
@@ -153,6 +158,33 @@ type SetNestedAttribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Set
+
+	// Default defines a proposed new state (plan) value for the attribute
+	// if the configuration value is null. Default prevents the framework
+	// from automatically marking the value as unknown during planning when
+	// other proposed new state changes are detected. If the attribute is
+	// computed and the value could be altered by other changes then a default
+	// should be avoided and a plan modifier should be used instead. Computed
+	// must be true or Terraform will return an error.
+	Default defaults.Set
+
+	// EventualConsistencyWindow declares that the remote system may briefly
+	// return stale values for this attribute after the resource is created or
+	// updated. When greater than zero and until the window has passed after
+	// the last Create or Update, the framework keeps the prior state value if
+	// Read sets a different value, so stale values are not reported as drift,
+	// and logs a warning with the attribute path. Values set by Create or
+	// Update are not modified, so those methods should set the planned value.
+	// Changes made outside Terraform during the window are not detected until
+	// a later refresh.
+	EventualConsistencyWindow time.Duration
+
+	// AllowNullAfterApply declares that this computed attribute may be null
+	// after the resource is created or updated, such as when the remote
+	// system only returns the value in certain conditions. When the provider
+	// enables the null computed attribute check, the framework does not
+	// return a warning diagnostic if this attribute is null after apply.
+	AllowNullAfterApply bool
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -177,6 +209,11 @@ func (a SetNestedAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetAllowNullAfterApply returns the AllowNullAfterApply field value.
+func (a SetNestedAttribute) GetAllowNullAfterApply() bool {
+	return a.AllowNullAfterApply
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a SetNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -187,6 +224,12 @@ func (a SetNestedAttribute) GetDescription() string {
 	return a.Description
 }
 
+// GetEventualConsistencyWindow returns the EventualConsistencyWindow field
+// value.
+func (a SetNestedAttribute) GetEventualConsistencyWindow() time.Duration {
+	return a.EventualConsistencyWindow
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (a SetNestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -233,6 +276,11 @@ func (a SetNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// SetDefaultValue returns the Default field value.
+func (a SetNestedAttribute) SetDefaultValue() defaults.Set {
+	return a.Default
+}
+
 // SetPlanModifiers returns the PlanModifiers field value.
 func (a SetNestedAttribute) SetPlanModifiers() []planmodifier.Set {
 	return a.PlanModifiers
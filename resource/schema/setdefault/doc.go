@@ -0,0 +1,2 @@
+// Package setdefault provides default values for types.Set attributes.
+package setdefault
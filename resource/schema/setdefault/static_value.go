@@ -0,0 +1,39 @@
+package setdefault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StaticValue returns a static set value default handler.
+//
+// Use StaticValue if a static default value for a set should be set.
+func StaticValue(defaultVal types.Set) defaults.Set {
+	return staticSetDefault{
+		defaultVal: defaultVal,
+	}
+}
+
+// staticSetDefault is static value default handler that
+// sets a value on a set attribute.
+type staticSetDefault struct {
+	defaultVal types.Set
+}
+
+// Description returns a human-readable description of the default value handler.
+func (d staticSetDefault) Description(_ context.Context) string {
+	return fmt.Sprintf("value defaults to %v", d.defaultVal)
+}
+
+// MarkdownDescription returns a markdown description of the default value handler.
+func (d staticSetDefault) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value defaults to `%v`", d.defaultVal)
+}
+
+// DefaultSet implements the static default value logic.
+func (d staticSetDefault) DefaultSet(_ context.Context, req defaults.SetRequest, resp *defaults.SetResponse) {
+	resp.PlanValue = d.defaultVal
+}
@@ -2,12 +2,14 @@ package schema
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,9 +18,13 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                            = SingleNestedAttribute{}
-	_ fwxschema.AttributeWithObjectPlanModifiers = SingleNestedAttribute{}
-	_ fwxschema.AttributeWithObjectValidators    = SingleNestedAttribute{}
+	_ NestedAttribute                                  = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithEmptyObjectPolicy         = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithAllowNullAfterApply       = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithEventualConsistencyWindow = SingleNestedAttribute{}
+	_ fwschema.AttributeWithObjectDefaultValue         = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithObjectPlanModifiers       = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithObjectValidators          = SingleNestedAttribute{}
 )
 
 // SingleNestedAttribute represents an attribute that is a single object where
@@ -152,6 +158,45 @@ type SingleNestedAttribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Object
+
+	// Default defines a proposed new state (plan) value for the attribute
+	// if the configuration value is null. Default prevents the framework
+	// from automatically marking the value as unknown during planning when
+	// other proposed new state changes are detected. If the attribute is
+	// computed and the value could be altered by other changes then a default
+	// should be avoided and a plan modifier should be used instead. Computed
+	// must be true or Terraform will return an error.
+	Default defaults.Object
+
+	// EmptyObjectPolicy controls whether the framework stores an object
+	// where all attributes are null as a null object, or a null object as an
+	// object where all attributes are null, after the resource Create, Read,
+	// or Update methods. This prevents perpetual differences when a remote
+	// system does not distinguish between the two. Values are only changed
+	// during Create and Update if the planned value is unknown or matches
+	// the changed value, so the state remains consistent with the plan.
+	//
+	// The default, EmptyObjectPolicyUnset, stores the value exactly as set
+	// by the provider.
+	EmptyObjectPolicy EmptyObjectPolicy
+
+	// EventualConsistencyWindow declares that the remote system may briefly
+	// return stale values for this attribute after the resource is created or
+	// updated. When greater than zero and until the window has passed after
+	// the last Create or Update, the framework keeps the prior state value if
+	// Read sets a different value, so stale values are not reported as drift,
+	// and logs a warning with the attribute path. Values set by Create or
+	// Update are not modified, so those methods should set the planned value.
+	// Changes made outside Terraform during the window are not detected until
+	// a later refresh.
+	EventualConsistencyWindow time.Duration
+
+	// AllowNullAfterApply declares that this computed attribute may be null
+	// after the resource is created or updated, such as when the remote
+	// system only returns the value in certain conditions. When the provider
+	// enables the null computed attribute check, the framework does not
+	// return a warning diagnostic if this attribute is null after apply.
+	AllowNullAfterApply bool
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -187,6 +232,11 @@ func (a SingleNestedAttribute) GetAttributes() fwschema.UnderlyingAttributes {
 	return schemaAttributes(a.Attributes)
 }
 
+// GetAllowNullAfterApply returns the AllowNullAfterApply field value.
+func (a SingleNestedAttribute) GetAllowNullAfterApply() bool {
+	return a.AllowNullAfterApply
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a SingleNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -197,6 +247,17 @@ func (a SingleNestedAttribute) GetDescription() string {
 	return a.Description
 }
 
+// GetEmptyObjectPolicy returns the EmptyObjectPolicy field value.
+func (a SingleNestedAttribute) GetEmptyObjectPolicy() fwschema.EmptyObjectPolicy {
+	return a.EmptyObjectPolicy
+}
+
+// GetEventualConsistencyWindow returns the EventualConsistencyWindow field
+// value.
+func (a SingleNestedAttribute) GetEventualConsistencyWindow() time.Duration {
+	return a.EventualConsistencyWindow
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (a SingleNestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -254,6 +315,11 @@ func (a SingleNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// ObjectDefaultValue returns the Default field value.
+func (a SingleNestedAttribute) ObjectDefaultValue() defaults.Object {
+	return a.Default
+}
+
 // ObjectPlanModifiers returns the PlanModifiers field value.
 func (a SingleNestedAttribute) ObjectPlanModifiers() []planmodifier.Object {
 	return a.PlanModifiers
@@ -0,0 +1,2 @@
+// Package stringdefault provides default values for types.String attributes.
+package stringdefault
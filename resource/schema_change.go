@@ -0,0 +1,19 @@
+package resource
+
+// SchemaChange describes a change to the schema of a resource, such as
+// "Added tags attribute", for registering schema version history with the
+// ResourceWithSchemaHistory interface.
+type SchemaChange struct {
+	// Version is the resource schema version which introduced the change,
+	// matching the Version field of the resource schema. Multiple changes may
+	// be registered for the same version.
+	Version int64
+
+	// ProviderVersion is the provider release which first included the
+	// change, such as "1.2.0". This field is optional, however setting it
+	// enables tooling to describe the changes between provider releases.
+	ProviderVersion string
+
+	// Summary is a human readable description of the change.
+	Summary string
+}
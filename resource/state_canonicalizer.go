@@ -0,0 +1,245 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Implementation handler for canonicalizing resource state values.
+//
+// This is used to encapsulate canonicalization logic for attribute values
+// when a Resource implements the ResourceWithStateCanonicalizers interface.
+type StateCanonicalizer struct {
+	// PathExpression matches the attributes to canonicalize, such as
+	// path.MatchRoot("tags") or path.MatchRoot("rule").AtAnyListIndex().AtName("ports").
+	PathExpression path.Expression
+
+	// Provider defined logic for converting an attribute value into its
+	// canonical form. It is only called with known, non-null values.
+	//
+	// The context.Context parameter contains framework-defined loggers and
+	// supports request cancellation.
+	//
+	// The CanonicalizeStateRequest parameter contains the attribute path and
+	// value. The CanonicalizeStateResponse parameter Value field is
+	// pre-populated with the request value and should contain the canonical
+	// value, which must be of the same type, and can be used to signal any
+	// logic warnings or errors.
+	Canonicalize func(context.Context, CanonicalizeStateRequest, *CanonicalizeStateResponse)
+}
+
+// CanonicalizeStateRequest represents a request for the provider to
+// canonicalize a resource state attribute value. An instance of this request
+// struct is supplied as an argument to the StateCanonicalizer type
+// Canonicalize function.
+type CanonicalizeStateRequest struct {
+	// Path is the path of the attribute being canonicalized.
+	Path path.Path
+
+	// Value is the known, non-null attribute value.
+	Value attr.Value
+}
+
+// CanonicalizeStateResponse represents a response to a
+// CanonicalizeStateRequest. An instance of this response struct is supplied
+// as an argument to the StateCanonicalizer type Canonicalize function.
+type CanonicalizeStateResponse struct {
+	// Diagnostics report errors or warnings related to canonicalizing the
+	// value. An empty slice indicates success, with no warnings or errors
+	// generated.
+	Diagnostics diag.Diagnostics
+
+	// Value is the canonical attribute value.
+	Value attr.Value
+}
+
+// SortedListStateCanonicalizer returns a StateCanonicalizer which sorts the
+// elements of list attributes with bool, number, or string elements. Bool
+// elements sort false before true, number elements sort numerically, and
+// string elements sort lexicographically by bytes. Lists containing null or
+// unknown elements are not modified.
+func SortedListStateCanonicalizer(expression path.Expression) StateCanonicalizer {
+	return StateCanonicalizer{
+		PathExpression: expression,
+		Canonicalize: func(ctx context.Context, req CanonicalizeStateRequest, resp *CanonicalizeStateResponse) {
+			tfValue, err := req.Value.ToTerraformValue(ctx)
+
+			if err != nil {
+				resp.Diagnostics.Append(stateCanonicalizerErrorDiag(req.Path, err))
+
+				return
+			}
+
+			listType, ok := tfValue.Type().(tftypes.List)
+
+			if !ok {
+				resp.Diagnostics.Append(stateCanonicalizerErrorDiag(req.Path, fmt.Errorf("expected list value, got: %s", tfValue.Type())))
+
+				return
+			}
+
+			var elements []tftypes.Value
+
+			if err := tfValue.As(&elements); err != nil {
+				resp.Diagnostics.Append(stateCanonicalizerErrorDiag(req.Path, err))
+
+				return
+			}
+
+			for _, element := range elements {
+				if element.IsNull() || !element.IsKnown() {
+					return
+				}
+			}
+
+			var less func(i, j int) bool
+
+			switch {
+			case listType.ElementType.Is(tftypes.Bool):
+				values := make([]bool, len(elements))
+
+				for index, element := range elements {
+					_ = element.As(&values[index])
+				}
+
+				less = func(i, j int) bool { return !values[i] && values[j] }
+			case listType.ElementType.Is(tftypes.Number):
+				values := make([]big.Float, len(elements))
+
+				for index, element := range elements {
+					_ = element.As(&values[index])
+				}
+
+				less = func(i, j int) bool { return values[i].Cmp(&values[j]) < 0 }
+			case listType.ElementType.Is(tftypes.String):
+				values := make([]string, len(elements))
+
+				for index, element := range elements {
+					_ = element.As(&values[index])
+				}
+
+				less = func(i, j int) bool { return values[i] < values[j] }
+			default:
+				resp.Diagnostics.Append(stateCanonicalizerErrorDiag(req.Path, fmt.Errorf("expected list of bool, number, or string elements, got: %s", tfValue.Type())))
+
+				return
+			}
+
+			indexes := make([]int, len(elements))
+
+			for index := range indexes {
+				indexes[index] = index
+			}
+
+			sort.SliceStable(indexes, func(i, j int) bool { return less(indexes[i], indexes[j]) })
+
+			sortedElements := make([]tftypes.Value, len(elements))
+
+			for index, elementIndex := range indexes {
+				sortedElements[index] = elements[elementIndex]
+			}
+
+			resp.Value, resp.Diagnostics = stateCanonicalizerValue(ctx, req, tftypes.NewValue(listType, sortedElements))
+		},
+	}
+}
+
+// JSONStringStateCanonicalizer returns a StateCanonicalizer which normalizes
+// JSON encoded string attributes by removing insignificant whitespace and
+// sorting object keys. Strings which are not valid JSON return an error
+// diagnostic.
+func JSONStringStateCanonicalizer(expression path.Expression) StateCanonicalizer {
+	return StateCanonicalizer{
+		PathExpression: expression,
+		Canonicalize: func(ctx context.Context, req CanonicalizeStateRequest, resp *CanonicalizeStateResponse) {
+			tfValue, err := req.Value.ToTerraformValue(ctx)
+
+			if err != nil {
+				resp.Diagnostics.Append(stateCanonicalizerErrorDiag(req.Path, err))
+
+				return
+			}
+
+			var value string
+
+			if err := tfValue.As(&value); err != nil {
+				resp.Diagnostics.Append(stateCanonicalizerErrorDiag(req.Path, err))
+
+				return
+			}
+
+			if !json.Valid([]byte(value)) {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid JSON String Value",
+					"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+						"Path: "+req.Path.String(),
+				)
+
+				return
+			}
+
+			var decoded any
+
+			decoder := json.NewDecoder(strings.NewReader(value))
+
+			// Preserve the number representations.
+			decoder.UseNumber()
+
+			if err := decoder.Decode(&decoded); err != nil {
+				resp.Diagnostics.Append(stateCanonicalizerErrorDiag(req.Path, err))
+
+				return
+			}
+
+			// encoding/json sorts map keys when encoding.
+			canonical, err := json.Marshal(decoded)
+
+			if err != nil {
+				resp.Diagnostics.Append(stateCanonicalizerErrorDiag(req.Path, err))
+
+				return
+			}
+
+			resp.Value, resp.Diagnostics = stateCanonicalizerValue(ctx, req, tftypes.NewValue(tftypes.String, string(canonical)))
+		},
+	}
+}
+
+// stateCanonicalizerValue returns the value of the request value type from
+// the given Terraform value, so custom types are preserved.
+func stateCanonicalizerValue(ctx context.Context, req CanonicalizeStateRequest, tfValue tftypes.Value) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, err := req.Value.Type(ctx).ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		diags.Append(stateCanonicalizerErrorDiag(req.Path, err))
+
+		return req.Value, diags
+	}
+
+	return value, diags
+}
+
+// stateCanonicalizerErrorDiag returns an error diagnostic for unexpected
+// errors while canonicalizing a value.
+func stateCanonicalizerErrorDiag(p path.Path, err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Unable to Canonicalize Resource State",
+		"An unexpected error was encountered while canonicalizing the resource state value. "+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"Path: "+p.String()+"\n"+
+			"Error: "+err.Error(),
+	)
+}
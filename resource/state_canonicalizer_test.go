@@ -0,0 +1,169 @@
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSortedListStateCanonicalizer(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         attr.Value
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"bool": {
+			value: types.ListValueMust(types.BoolType, []attr.Value{
+				types.BoolValue(true),
+				types.BoolValue(false),
+			}),
+			expected: types.ListValueMust(types.BoolType, []attr.Value{
+				types.BoolValue(false),
+				types.BoolValue(true),
+			}),
+		},
+		"int64": {
+			value: types.ListValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(10),
+				types.Int64Value(-1),
+				types.Int64Value(2),
+			}),
+			expected: types.ListValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(-1),
+				types.Int64Value(2),
+				types.Int64Value(10),
+			}),
+		},
+		"string": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("b"),
+				types.StringValue("a"),
+				types.StringValue("b"),
+			}),
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("a"),
+				types.StringValue("b"),
+				types.StringValue("b"),
+			}),
+		},
+		"string-unknown-element": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("b"),
+				types.StringUnknown(),
+			}),
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("b"),
+				types.StringUnknown(),
+			}),
+		},
+		"object": {
+			value: types.ListValueMust(types.ObjectType{}, []attr.Value{
+				types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}),
+			}),
+			expected: types.ListValueMust(types.ObjectType{}, []attr.Value{
+				types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Unable to Canonicalize Resource State",
+					"An unexpected error was encountered while canonicalizing the resource state value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Path: test\n"+
+						"Error: expected list of bool, number, or string elements, got: tftypes.List[tftypes.Object[]]",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.CanonicalizeStateRequest{
+				Path:  path.Root("test"),
+				Value: testCase.value,
+			}
+			resp := &resource.CanonicalizeStateResponse{
+				Value: testCase.value,
+			}
+
+			resource.SortedListStateCanonicalizer(path.MatchRoot("test")).Canonicalize(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.Value, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestJSONStringStateCanonicalizer(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         attr.Value
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"object": {
+			value:    types.StringValue(`{ "b": [1.50, {"d": true, "c": null}], "a": "x" }`),
+			expected: types.StringValue(`{"a":"x","b":[1.50,{"c":null,"d":true}]}`),
+		},
+		"canonical": {
+			value:    types.StringValue(`{"a":1}`),
+			expected: types.StringValue(`{"a":1}`),
+		},
+		"invalid": {
+			value:    types.StringValue(`{"a":`),
+			expected: types.StringValue(`{"a":`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid JSON String Value",
+					"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+						"Path: test",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.CanonicalizeStateRequest{
+				Path:  path.Root("test"),
+				Value: testCase.value,
+			}
+			resp := &resource.CanonicalizeStateResponse{
+				Value: testCase.value,
+			}
+
+			resource.JSONStringStateCanonicalizer(path.MatchRoot("test")).Canonicalize(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.Value, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
@@ -16,6 +16,10 @@ type StateUpgrader struct {
 	// field similar to other Resource data types. This allows for easier data
 	// handling such as calling Get() or GetAttribute().
 	//
+	// Prior state data in the flatmap format, written by Terraform CLI 0.11
+	// and earlier, is also converted using this schema, which is helpful for
+	// resources migrated from older SDK versions.
+	//
 	// If not set, prior state data is available in the
 	// UpgradeResourceStateRequest type RawState field.
 	PriorSchema *schema.Schema
@@ -0,0 +1,16 @@
+// Package timeouts contains the schema, attribute type, and value type for
+// configurable per-operation resource timeouts, such as:
+//
+//	resource "examplecloud_thing" "example" {
+//	  timeouts {
+//	    create = "60m"
+//	    delete = "10m"
+//	  }
+//	}
+//
+// Add the timeouts to the resource schema with the Block or Attributes
+// function, add a Value field to the resource model, and call the Value
+// methods, such as Create or CreateContext, within the resource CRUD methods.
+// Timeout values are Go duration strings, such as "30s" or "1h30m", as
+// defined by time.ParseDuration.
+package timeouts
@@ -0,0 +1,144 @@
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+const (
+	// attributeNameCreate is the attribute name of the create timeout.
+	attributeNameCreate = "create"
+
+	// attributeNameDelete is the attribute name of the delete timeout.
+	attributeNameDelete = "delete"
+
+	// attributeNameRead is the attribute name of the read timeout.
+	attributeNameRead = "read"
+
+	// attributeNameUpdate is the attribute name of the update timeout.
+	attributeNameUpdate = "update"
+)
+
+// Opts are the operations which can be configured with a timeout. Only the
+// enabled operations are included in the schema.
+type Opts struct {
+	// Create enables the create timeout.
+	Create bool
+
+	// Read enables the read timeout.
+	Read bool
+
+	// Update enables the update timeout.
+	Update bool
+
+	// Delete enables the delete timeout.
+	Delete bool
+}
+
+// attributeNames returns the names of the enabled operation attributes.
+func (o Opts) attributeNames() []string {
+	var names []string
+
+	if o.Create {
+		names = append(names, attributeNameCreate)
+	}
+
+	if o.Read {
+		names = append(names, attributeNameRead)
+	}
+
+	if o.Update {
+		names = append(names, attributeNameUpdate)
+	}
+
+	if o.Delete {
+		names = append(names, attributeNameDelete)
+	}
+
+	return names
+}
+
+// Block returns a schema.SingleNestedBlock containing an optional attribute
+// for each operation enabled in the opts. Add it to the resource schema
+// Blocks field with the "timeouts" name, which is consistent with other Terraform
+// providers.
+func Block(ctx context.Context, opts Opts) schema.Block {
+	return schema.SingleNestedBlock{
+		Attributes: attributes(opts),
+		CustomType: Type{
+			ObjectType: basetypes.ObjectType{
+				AttrTypes: attributeTypes(opts),
+			},
+		},
+	}
+}
+
+// BlockAll returns a schema.SingleNestedBlock containing an optional
+// attribute for the create, read, update, and delete timeouts.
+func BlockAll(ctx context.Context) schema.Block {
+	return Block(ctx, allOpts())
+}
+
+// Attributes returns an optional schema.SingleNestedAttribute containing an
+// optional attribute for each operation enabled in the opts. Add it to the
+// resource schema Attributes field with the "timeouts" name. Nested
+// attributes require protocol version 6.
+func Attributes(ctx context.Context, opts Opts) schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Attributes: attributes(opts),
+		CustomType: Type{
+			ObjectType: basetypes.ObjectType{
+				AttrTypes: attributeTypes(opts),
+			},
+		},
+		Optional: true,
+	}
+}
+
+// AttributesAll returns an optional schema.SingleNestedAttribute containing
+// an optional attribute for the create, read, update, and delete timeouts.
+func AttributesAll(ctx context.Context) schema.Attribute {
+	return Attributes(ctx, allOpts())
+}
+
+// allOpts returns the opts with every operation enabled.
+func allOpts() Opts {
+	return Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	}
+}
+
+// attributes returns the timeout attributes of the enabled operations.
+func attributes(opts Opts) map[string]schema.Attribute {
+	result := make(map[string]schema.Attribute)
+
+	for _, name := range opts.attributeNames() {
+		result[name] = schema.StringAttribute{
+			CustomType: timetypes.GoDurationType{},
+			Description: `A Go duration string, such as "30s" or "2h45m", of the ` + name + ` operation timeout. ` +
+				`Valid time units are "s" (seconds), "m" (minutes), and "h" (hours).`,
+			Optional: true,
+		}
+	}
+
+	return result
+}
+
+// attributeTypes returns the timeout attribute types of the enabled
+// operations.
+func attributeTypes(opts Opts) map[string]attr.Type {
+	result := make(map[string]attr.Type)
+
+	for _, name := range opts.attributeNames() {
+		result[name] = timetypes.GoDurationType{}
+	}
+
+	return result
+}
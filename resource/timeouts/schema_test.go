@@ -0,0 +1,144 @@
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     timeouts.Opts
+		expected []string
+	}{
+		"none": {
+			opts:     timeouts.Opts{},
+			expected: []string{},
+		},
+		"create-delete": {
+			opts: timeouts.Opts{
+				Create: true,
+				Delete: true,
+			},
+			expected: []string{"create", "delete"},
+		},
+		"all": {
+			opts: timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			},
+			expected: []string{"create", "delete", "read", "update"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := timeouts.Block(context.Background(), testCase.opts).(schema.SingleNestedBlock)
+
+			if !ok {
+				t.Fatalf("expected schema.SingleNestedBlock, got: %T", got)
+			}
+
+			expectedAttrTypes := make(map[string]attr.Type)
+
+			for _, attributeName := range testCase.expected {
+				attribute, ok := got.Attributes[attributeName].(schema.StringAttribute)
+
+				if !ok || !attribute.Optional || attribute.CustomType == nil {
+					t.Errorf("expected optional string attribute with custom type for %s, got: %#v", attributeName, got.Attributes[attributeName])
+				}
+
+				expectedAttrTypes[attributeName] = timetypes.GoDurationType{}
+			}
+
+			if len(got.Attributes) != len(testCase.expected) {
+				t.Errorf("expected %d attributes, got: %d", len(testCase.expected), len(got.Attributes))
+			}
+
+			expectedType := timeouts.Type{
+				ObjectType: basetypes.ObjectType{
+					AttrTypes: expectedAttrTypes,
+				},
+			}
+
+			if diff := cmp.Diff(got.CustomType, expectedType); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBlockAll(t *testing.T) {
+	t.Parallel()
+
+	got := timeouts.BlockAll(context.Background())
+	expected := timeouts.Block(context.Background(), timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+
+	if !got.Equal(expected) {
+		t.Errorf("expected %#v, got: %#v", expected, got)
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	t.Parallel()
+
+	got, ok := timeouts.Attributes(context.Background(), timeouts.Opts{Create: true}).(schema.SingleNestedAttribute)
+
+	if !ok {
+		t.Fatalf("expected schema.SingleNestedAttribute, got: %T", got)
+	}
+
+	if !got.Optional {
+		t.Errorf("expected optional attribute")
+	}
+
+	if _, ok := got.Attributes["create"]; !ok || len(got.Attributes) != 1 {
+		t.Errorf("expected only create attribute, got: %#v", got.Attributes)
+	}
+
+	expectedType := timeouts.Type{
+		ObjectType: basetypes.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"create": timetypes.GoDurationType{},
+			},
+		},
+	}
+
+	if !got.GetType().Equal(expectedType) {
+		t.Errorf("expected type %s, got: %s", expectedType, got.GetType())
+	}
+}
+
+func TestAttributesAll(t *testing.T) {
+	t.Parallel()
+
+	got, ok := timeouts.AttributesAll(context.Background()).(schema.SingleNestedAttribute)
+
+	if !ok {
+		t.Fatalf("expected schema.SingleNestedAttribute, got: %T", got)
+	}
+
+	if len(got.Attributes) != 4 {
+		t.Errorf("expected 4 attributes, got: %#v", got.Attributes)
+	}
+}
@@ -0,0 +1,220 @@
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.ObjectTypable  = Type{}
+	_ basetypes.ObjectValuable = Value{}
+)
+
+// Type is the attribute type of the timeouts block or attribute returned by
+// the Block and Attributes functions. Value is the associated value type.
+type Type struct {
+	basetypes.ObjectType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t Type) Equal(o attr.Type) bool {
+	other, ok := o.(Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+// String returns a human readable string of the type name.
+func (t Type) String() string {
+	return "timeouts.Type"
+}
+
+// ValueFromObject returns an ObjectValuable type given an ObjectValue.
+func (t Type) ValueFromObject(_ context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	return Value{
+		ObjectValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ObjectType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	objectValue, ok := attrValue.(basetypes.ObjectValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return Value{
+		ObjectValue: objectValue,
+	}, nil
+}
+
+// ValueType returns the Value type.
+func (t Type) ValueType(_ context.Context) attr.Value {
+	return Value{
+		ObjectValue: basetypes.NewObjectNull(t.AttrTypes),
+	}
+}
+
+// Value is the value of the timeouts block or attribute. Use it as the
+// resource model field type of the timeouts, such as:
+//
+//	type exampleResourceModel struct {
+//		Timeouts timeouts.Value `tfsdk:"timeouts"`
+//	}
+type Value struct {
+	basetypes.ObjectValue
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Value) Equal(o attr.Value) bool {
+	other, ok := o.(Value)
+
+	if !ok {
+		return false
+	}
+
+	return v.ObjectValue.Equal(other.ObjectValue)
+}
+
+// Type returns a Type with the same attribute types as the value.
+func (v Value) Type(ctx context.Context) attr.Type {
+	return Type{
+		ObjectType: basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+// Create returns the configured create timeout, or the default timeout if
+// the create timeout is not enabled or not configured. An error diagnostic is
+// returned if the configured value is not a valid Go duration string.
+func (v Value) Create(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return v.timeout(ctx, attributeNameCreate, defaultTimeout)
+}
+
+// Read returns the configured read timeout, or the default timeout if the
+// read timeout is not enabled or not configured. An error diagnostic is
+// returned if the configured value is not a valid Go duration string.
+func (v Value) Read(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return v.timeout(ctx, attributeNameRead, defaultTimeout)
+}
+
+// Update returns the configured update timeout, or the default timeout if
+// the update timeout is not enabled or not configured. An error diagnostic is
+// returned if the configured value is not a valid Go duration string.
+func (v Value) Update(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return v.timeout(ctx, attributeNameUpdate, defaultTimeout)
+}
+
+// Delete returns the configured delete timeout, or the default timeout if
+// the delete timeout is not enabled or not configured. An error diagnostic is
+// returned if the configured value is not a valid Go duration string.
+func (v Value) Delete(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return v.timeout(ctx, attributeNameDelete, defaultTimeout)
+}
+
+// CreateContext returns a copy of the context with a deadline of the create
+// timeout, as returned by the Create method. The cancel function must be
+// called once the operation is complete, such as with defer, and is always
+// returned, even with error diagnostics.
+func (v Value) CreateContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return v.timeoutContext(ctx, attributeNameCreate, defaultTimeout)
+}
+
+// ReadContext returns a copy of the context with a deadline of the read
+// timeout, as returned by the Read method. The cancel function must be
+// called once the operation is complete, such as with defer, and is always
+// returned, even with error diagnostics.
+func (v Value) ReadContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return v.timeoutContext(ctx, attributeNameRead, defaultTimeout)
+}
+
+// UpdateContext returns a copy of the context with a deadline of the update
+// timeout, as returned by the Update method. The cancel function must be
+// called once the operation is complete, such as with defer, and is always
+// returned, even with error diagnostics.
+func (v Value) UpdateContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return v.timeoutContext(ctx, attributeNameUpdate, defaultTimeout)
+}
+
+// DeleteContext returns a copy of the context with a deadline of the delete
+// timeout, as returned by the Delete method. The cancel function must be
+// called once the operation is complete, such as with defer, and is always
+// returned, even with error diagnostics.
+func (v Value) DeleteContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return v.timeoutContext(ctx, attributeNameDelete, defaultTimeout)
+}
+
+// timeout returns the timeout of the attribute name, or the default timeout
+// if the attribute is missing, null, or unknown.
+func (v Value) timeout(ctx context.Context, name string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, ok := v.Attributes()[name]
+
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return defaultTimeout, diags
+	}
+
+	stringValuable, ok := value.(basetypes.StringValuable)
+
+	if !ok {
+		diags.AddError(
+			"Timeout Cannot Be Parsed",
+			fmt.Sprintf("The %s timeout value is not a string. This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Value Type: %T", name, value),
+		)
+
+		return defaultTimeout, diags
+	}
+
+	stringValue, stringValueDiags := stringValuable.ToStringValue(ctx)
+
+	diags.Append(stringValueDiags...)
+
+	if diags.HasError() {
+		return defaultTimeout, diags
+	}
+
+	timeout, err := time.ParseDuration(stringValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Timeout Cannot Be Parsed",
+			fmt.Sprintf("The %s timeout value is not a valid Go duration string, such as \"30s\" or \"2h45m\".\n\n"+
+				"Given Value: %s\n"+
+				"Error: %s", name, stringValue.ValueString(), err),
+		)
+
+		return defaultTimeout, diags
+	}
+
+	return timeout, diags
+}
+
+// timeoutContext returns a copy of the context with a deadline of the
+// timeout of the attribute name. The default timeout is used with error
+// diagnostics.
+func (v Value) timeoutContext(ctx context.Context, name string, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	timeout, diags := v.timeout(ctx, name, defaultTimeout)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	return ctx, cancel, diags
+}
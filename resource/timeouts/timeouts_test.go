@@ -0,0 +1,312 @@
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestValueCreate(t *testing.T) {
+	t.Parallel()
+
+	testAttrTypes := map[string]attr.Type{
+		"create": timetypes.GoDurationType{},
+	}
+
+	testCases := map[string]struct {
+		value         timeouts.Value
+		expected      time.Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"zero": {
+			value:    timeouts.Value{},
+			expected: 20 * time.Minute,
+		},
+		"null": {
+			value: timeouts.Value{
+				ObjectValue: basetypes.NewObjectNull(testAttrTypes),
+			},
+			expected: 20 * time.Minute,
+		},
+		"unknown": {
+			value: timeouts.Value{
+				ObjectValue: basetypes.NewObjectUnknown(testAttrTypes),
+			},
+			expected: 20 * time.Minute,
+		},
+		"create-null": {
+			value: timeouts.Value{
+				ObjectValue: basetypes.NewObjectValueMust(testAttrTypes, map[string]attr.Value{
+					"create": timetypes.NewGoDurationNull(),
+				}),
+			},
+			expected: 20 * time.Minute,
+		},
+		"create-unknown": {
+			value: timeouts.Value{
+				ObjectValue: basetypes.NewObjectValueMust(testAttrTypes, map[string]attr.Value{
+					"create": timetypes.NewGoDurationUnknown(),
+				}),
+			},
+			expected: 20 * time.Minute,
+		},
+		"create-value": {
+			value: timeouts.Value{
+				ObjectValue: basetypes.NewObjectValueMust(testAttrTypes, map[string]attr.Value{
+					"create": timetypes.NewGoDurationValueMust("1h30m"),
+				}),
+			},
+			expected: 90 * time.Minute,
+		},
+		"create-string-value": {
+			value: timeouts.Value{
+				ObjectValue: basetypes.NewObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("45s"),
+					},
+				),
+			},
+			expected: 45 * time.Second,
+		},
+		"create-invalid": {
+			value: timeouts.Value{
+				ObjectValue: basetypes.NewObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("invalid"),
+					},
+				),
+			},
+			expected: 20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					"The create timeout value is not a valid Go duration string, such as \"30s\" or \"2h45m\".\n\n"+
+						"Given Value: invalid\n"+
+						"Error: time: invalid duration \"invalid\"",
+				),
+			},
+		},
+		"create-not-string": {
+			value: timeouts.Value{
+				ObjectValue: basetypes.NewObjectValueMust(
+					map[string]attr.Type{
+						"create": types.Int64Type,
+					},
+					map[string]attr.Value{
+						"create": types.Int64Value(60),
+					},
+				),
+			},
+			expected: 20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					"The create timeout value is not a string. This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Value Type: basetypes.Int64Value",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.Create(context.Background(), 20*time.Minute)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestValueOperations(t *testing.T) {
+	t.Parallel()
+
+	value := timeouts.Value{
+		ObjectValue: basetypes.NewObjectValueMust(
+			map[string]attr.Type{
+				"create": timetypes.GoDurationType{},
+				"read":   timetypes.GoDurationType{},
+				"update": timetypes.GoDurationType{},
+				"delete": timetypes.GoDurationType{},
+			},
+			map[string]attr.Value{
+				"create": timetypes.NewGoDurationValueMust("1m"),
+				"read":   timetypes.NewGoDurationValueMust("2m"),
+				"update": timetypes.NewGoDurationValueMust("3m"),
+				"delete": timetypes.NewGoDurationValueMust("4m"),
+			},
+		),
+	}
+
+	testCases := map[string]struct {
+		method   func(context.Context, time.Duration) (time.Duration, diag.Diagnostics)
+		expected time.Duration
+	}{
+		"create": {
+			method:   value.Create,
+			expected: time.Minute,
+		},
+		"read": {
+			method:   value.Read,
+			expected: 2 * time.Minute,
+		},
+		"update": {
+			method:   value.Update,
+			expected: 3 * time.Minute,
+		},
+		"delete": {
+			method:   value.Delete,
+			expected: 4 * time.Minute,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.method(context.Background(), time.Hour)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %s", diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestValueCreateContext(t *testing.T) {
+	t.Parallel()
+
+	value := timeouts.Value{
+		ObjectValue: basetypes.NewObjectValueMust(
+			map[string]attr.Type{
+				"create": timetypes.GoDurationType{},
+			},
+			map[string]attr.Value{
+				"create": timetypes.NewGoDurationValueMust("1h"),
+			},
+		),
+	}
+
+	before := time.Now()
+
+	ctx, cancel, diags := value.CreateContext(context.Background(), time.Minute)
+
+	defer cancel()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	deadline, ok := ctx.Deadline()
+
+	if !ok {
+		t.Fatal("expected context deadline")
+	}
+
+	if deadline.Before(before.Add(time.Hour)) || deadline.After(time.Now().Add(time.Hour)) {
+		t.Errorf("expected deadline in one hour, got: %s", deadline)
+	}
+
+	cancel()
+
+	if ctx.Err() == nil {
+		t.Error("expected cancelled context")
+	}
+}
+
+func TestValueFromConfig(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(context.Background(), timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"timeouts": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"create": tftypes.String,
+					"delete": tftypes.String,
+				},
+			},
+		},
+	}
+
+	config := tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"timeouts": tftypes.NewValue(testType.AttributeTypes["timeouts"], map[string]tftypes.Value{
+				"create": tftypes.NewValue(tftypes.String, "10m"),
+				"delete": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+		Schema: testSchema,
+	}
+
+	var model struct {
+		Timeouts timeouts.Value `tfsdk:"timeouts"`
+	}
+
+	diags := config.Get(context.Background(), &model)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	createTimeout, diags := model.Timeouts.Create(context.Background(), time.Minute)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if createTimeout != 10*time.Minute {
+		t.Errorf("expected create timeout of 10m, got: %s", createTimeout)
+	}
+
+	deleteTimeout, diags := model.Timeouts.Delete(context.Background(), time.Minute)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if deleteTimeout != time.Minute {
+		t.Errorf("expected default delete timeout of 1m, got: %s", deleteTimeout)
+	}
+}
@@ -1,8 +1,12 @@
 package resource
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -36,6 +40,28 @@ type UpdateRequest struct {
 	Private *privatestate.ProviderData
 }
 
+// AttributeChange returns the prior state value and planned value of the
+// attribute at the given path, and whether the planned value differs from the
+// prior state value. This is equivalent to calling GetAttribute on both the
+// State and Plan fields with an attr.Value target, then comparing the values
+// with the Equal method. Planned values which are unknown are reported as
+// changed.
+//
+// If any error diagnostics are returned, the values should not be used.
+func (r UpdateRequest) AttributeChange(ctx context.Context, p path.Path) (attr.Value, attr.Value, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var priorValue, plannedValue attr.Value
+
+	diags.Append(r.State.GetAttribute(ctx, p, &priorValue)...)
+	diags.Append(r.Plan.GetAttribute(ctx, p, &plannedValue)...)
+
+	if diags.HasError() {
+		return priorValue, plannedValue, false, diags
+	}
+
+	return priorValue, plannedValue, !plannedValue.Equal(priorValue), diags
+}
+
 // UpdateResponse represents a response to an UpdateRequest. An
 // instance of this response struct is supplied as
 // an argument to the resource's Update function, in which the provider
@@ -0,0 +1,137 @@
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpdateRequestAttributeChange(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testValue := func(id interface{}, name interface{}) tftypes.Value {
+		return tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":   tftypes.String,
+					"name": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, id),
+				"name": tftypes.NewValue(tftypes.String, name),
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		state           tftypes.Value
+		plan            tftypes.Value
+		path            path.Path
+		expectedPrior   attr.Value
+		expectedPlanned attr.Value
+		expectedChanged bool
+		expectedDiags   diag.Diagnostics
+	}{
+		"changed": {
+			state:           testValue("test-id", "old"),
+			plan:            testValue("test-id", "new"),
+			path:            path.Root("name"),
+			expectedPrior:   types.StringValue("old"),
+			expectedPlanned: types.StringValue("new"),
+			expectedChanged: true,
+		},
+		"unchanged": {
+			state:           testValue("test-id", "old"),
+			plan:            testValue("test-id", "new"),
+			path:            path.Root("id"),
+			expectedPrior:   types.StringValue("test-id"),
+			expectedPlanned: types.StringValue("test-id"),
+			expectedChanged: false,
+		},
+		"unknown": {
+			state:           testValue("test-id", "old"),
+			plan:            testValue(tftypes.UnknownValue, "old"),
+			path:            path.Root("id"),
+			expectedPrior:   types.StringValue("test-id"),
+			expectedPlanned: types.StringUnknown(),
+			expectedChanged: true,
+		},
+		"invalid-path": {
+			state: testValue("test-id", "old"),
+			plan:  testValue("test-id", "new"),
+			path:  path.Root("missing"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"State Read Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"Plan Read Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.UpdateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    testCase.plan,
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testCase.state,
+					Schema: testSchema,
+				},
+			}
+
+			gotPrior, gotPlanned, gotChanged, diags := req.AttributeChange(context.Background(), testCase.path)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotPrior, testCase.expectedPrior); diff != "" {
+				t.Errorf("unexpected prior value difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotPlanned, testCase.expectedPlanned); diff != "" {
+				t.Errorf("unexpected planned value difference: %s", diff)
+			}
+
+			if gotChanged != testCase.expectedChanged {
+				t.Errorf("expected changed %t, got %t", testCase.expectedChanged, gotChanged)
+			}
+		})
+	}
+}
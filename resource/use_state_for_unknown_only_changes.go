@@ -0,0 +1,87 @@
+package resource
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// errUnknownWithoutPriorState is returned while transforming the plan when an
+// unknown value has no prior state value at the same path.
+var errUnknownWithoutPriorState = errors.New("unknown value without prior state value")
+
+// UseStateForUnknownOnlyChanges is an opt-in plan modification helper, which
+// can be called from the ModifyPlan method of a resource, that replaces the
+// planned new state with the prior state when the only differences between
+// them are unknown values. This prevents noisy plans which show the resource
+// will be updated in-place when no known value has changed, such as when
+// computed attributes are marked unknown.
+//
+// This is only appropriate for resources where unknown values can only ever
+// resolve to their prior state values when no other change is planned. The
+// helper does nothing during resource creation or destruction, or if any
+// known value is planned to change. A log entry explains when the plan is
+// replaced.
+func UseStateForUnknownOnlyChanges(ctx context.Context, req ModifyPlanRequest, resp *ModifyPlanResponse) {
+	// Resource creation or destruction
+	if req.State.Raw.IsNull() || resp.Plan.Raw.IsNull() {
+		return
+	}
+
+	if resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var unknownPaths []string
+
+	planWithPriorState, err := tftypes.Transform(resp.Plan.Raw, func(tfTypePath *tftypes.AttributePath, planValue tftypes.Value) (tftypes.Value, error) {
+		if planValue.IsKnown() {
+			return planValue, nil
+		}
+
+		priorStateValue, _, err := tftypes.WalkAttributePath(req.State.Raw, tfTypePath)
+
+		if err != nil {
+			return planValue, errUnknownWithoutPriorState
+		}
+
+		priorStateTfValue, ok := priorStateValue.(tftypes.Value)
+
+		if !ok {
+			return planValue, errUnknownWithoutPriorState
+		}
+
+		fwPath, diags := fromtftypes.AttributePath(ctx, tfTypePath, req.State.Schema)
+
+		if diags.HasError() {
+			unknownPaths = append(unknownPaths, tfTypePath.String())
+		} else {
+			unknownPaths = append(unknownPaths, fwPath.String())
+		}
+
+		return priorStateTfValue, nil
+	})
+
+	// Unknown values without prior state values represent a change, such as
+	// a new list element.
+	if err != nil {
+		return
+	}
+
+	if !planWithPriorState.Equal(req.State.Raw) {
+		return
+	}
+
+	logging.FrameworkDebug(
+		ctx,
+		"Replacing planned new state with prior state, as the only planned changes were unknown values",
+		map[string]interface{}{
+			logging.KeyAttributePaths: unknownPaths,
+		},
+	)
+
+	resp.Plan.Raw = req.State.Raw
+}
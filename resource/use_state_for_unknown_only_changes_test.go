@@ -0,0 +1,130 @@
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUseStateForUnknownOnlyChanges(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+			"tags": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testValue := func(id, name any, tags any) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, id),
+			"name": tftypes.NewValue(tftypes.String, name),
+			"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tags),
+		})
+	}
+
+	testTags := []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "one"),
+	}
+
+	testCases := map[string]struct {
+		state    tftypes.Value
+		plan     tftypes.Value
+		expected tftypes.Value
+	}{
+		"create": {
+			state:    tftypes.NewValue(testType, nil),
+			plan:     testValue(tftypes.UnknownValue, "test", tftypes.UnknownValue),
+			expected: testValue(tftypes.UnknownValue, "test", tftypes.UnknownValue),
+		},
+		"destroy": {
+			state:    testValue("test-id", "test", testTags),
+			plan:     tftypes.NewValue(testType, nil),
+			expected: tftypes.NewValue(testType, nil),
+		},
+		"no-changes": {
+			state:    testValue("test-id", "test", testTags),
+			plan:     testValue("test-id", "test", testTags),
+			expected: testValue("test-id", "test", testTags),
+		},
+		"unknown-only-changes": {
+			state:    testValue("test-id", "test", testTags),
+			plan:     testValue(tftypes.UnknownValue, "test", tftypes.UnknownValue),
+			expected: testValue("test-id", "test", testTags),
+		},
+		"unknown-element-only-changes": {
+			state: testValue("test-id", "test", testTags),
+			plan: testValue("test-id", "test", []tftypes.Value{
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: testValue("test-id", "test", testTags),
+		},
+		"unknown-element-without-prior-state": {
+			state: testValue("test-id", "test", testTags),
+			plan: testValue("test-id", "test", []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: testValue("test-id", "test", []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"known-changes": {
+			state:    testValue("test-id", "test", testTags),
+			plan:     testValue(tftypes.UnknownValue, "changed", tftypes.UnknownValue),
+			expected: testValue(tftypes.UnknownValue, "changed", tftypes.UnknownValue),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{
+					Raw:    testCase.state,
+					Schema: testSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testCase.plan,
+					Schema: testSchema,
+				},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: req.Plan,
+			}
+
+			resource.UseStateForUnknownOnlyChanges(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Plan.Raw, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
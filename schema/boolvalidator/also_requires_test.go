@@ -0,0 +1,90 @@
+package boolvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAlsoRequiresValidatorValidateBool(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configValue types.Bool
+		other       any
+		expected    diag.Diagnostics
+	}{
+		"null": {
+			configValue: types.BoolNull(),
+			other:       nil,
+			expected:    nil,
+		},
+		"other-value": {
+			configValue: types.BoolValue(true),
+			other:       "other-value",
+			expected:    nil,
+		},
+		"other-null": {
+			configValue: types.BoolValue(true),
+			other:       nil,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Combination",
+					`Attribute "other" must be specified when "test" is specified`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.BoolRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other": tftypes.String,
+							},
+						},
+						map[string]tftypes.Value{
+							"other": tftypes.NewValue(tftypes.String, testCase.other),
+						},
+					),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"other": testschema.Attribute{
+								Optional: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+				},
+				ConfigValue:    testCase.configValue,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.BoolResponse{}
+
+			boolvalidator.AlsoRequires(path.MatchRoot("other")).ValidateBool(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package boolvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AnyWithAllWarnings returns a validator which ensures that the configured
+// value passes at least one of the given validators. This validator returns
+// all warnings, including failed validators.
+//
+// Use Any() to return warnings only from the passing validator.
+func AnyWithAllWarnings(validators ...validator.Bool) validator.Bool {
+	return anyWithAllWarningsValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.Bool = anyWithAllWarningsValidator{}
+
+// anyWithAllWarningsValidator implements the validator.
+type anyWithAllWarningsValidator struct {
+	validators []validator.Bool
+}
+
+// Description describes the validation in plain text formatting.
+func (v anyWithAllWarningsValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("value must satisfy at least one of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v anyWithAllWarningsValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("value must satisfy at least one of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateBool performs the validation.
+func (v anyWithAllWarningsValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	anyValid := false
+
+	for _, subValidator := range v.validators {
+		validateResp := &validator.BoolResponse{}
+
+		subValidator.ValidateBool(ctx, req, validateResp)
+
+		if !validateResp.Diagnostics.HasError() {
+			anyValid = true
+		}
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+
+	if anyValid {
+		resp.Diagnostics = resp.Diagnostics.Warnings()
+	}
+}
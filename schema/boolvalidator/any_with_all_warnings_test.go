@@ -0,0 +1,124 @@
+package boolvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAnyWithAllWarningsValidatorValidateBool(t *testing.T) {
+	t.Parallel()
+
+	errorValidator := testvalidator.Bool{
+		ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Error Summary", "Error detail.")
+		},
+	}
+	otherErrorValidator := testvalidator.Bool{
+		ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Other Error Summary", "Other error detail.")
+		},
+	}
+	warningValidator := testvalidator.Bool{
+		ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			resp.Diagnostics.AddAttributeWarning(req.Path, "Warning Summary", "Warning detail.")
+		},
+	}
+	passingValidator := testvalidator.Bool{}
+
+	testCases := map[string]struct {
+		validators []validator.Bool
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {
+			validators: []validator.Bool{},
+			expected:   nil,
+		},
+		"passing": {
+			validators: []validator.Bool{
+				passingValidator,
+			},
+			expected: nil,
+		},
+		"errors": {
+			validators: []validator.Bool{
+				errorValidator,
+				otherErrorValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"Error detail.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Other Error Summary",
+					"Other error detail.",
+				),
+			},
+		},
+		"error-then-passing": {
+			validators: []validator.Bool{
+				errorValidator,
+				passingValidator,
+			},
+			expected: nil,
+		},
+		"warning-error-passing": {
+			validators: []validator.Bool{
+				warningValidator,
+				errorValidator,
+				passingValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Warning Summary",
+					"Warning detail.",
+				),
+			},
+		},
+		"warning-error": {
+			validators: []validator.Bool{
+				warningValidator,
+				errorValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Warning Summary",
+					"Warning detail.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.BoolRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.BoolValue(true),
+			}
+			resp := &validator.BoolResponse{}
+
+			boolvalidator.AnyWithAllWarnings(testCase.validators...).ValidateBool(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
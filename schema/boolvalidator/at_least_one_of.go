@@ -0,0 +1,50 @@
+package boolvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AtLeastOneOf returns a validator which ensures that at least one of the
+// current attribute and the attributes matching the given path expressions is
+// not null. The path expressions can be relative to the current attribute or
+// absolute from the root of the schema.
+func AtLeastOneOf(expressions ...path.Expression) validator.Bool {
+	return atLeastOneOfValidator{
+		expressions: expressions,
+	}
+}
+
+var _ validator.Bool = atLeastOneOfValidator{}
+
+// atLeastOneOfValidator implements the validator.
+type atLeastOneOfValidator struct {
+	expressions path.Expressions
+}
+
+// Description describes the validation in plain text formatting.
+func (v atLeastOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("at least one of these attributes must be configured: %s", v.expressions)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atLeastOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateBool performs the validation.
+func (v atLeastOneOfValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	combinationReq := schemavalidator.AttributeCombinationRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Expressions:    v.expressions,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	resp.Diagnostics.Append(schemavalidator.AtLeastOneOf(ctx, combinationReq)...)
+}
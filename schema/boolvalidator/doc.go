@@ -0,0 +1,2 @@
+// Package boolvalidator provides validators for types.Bool attributes.
+package boolvalidator
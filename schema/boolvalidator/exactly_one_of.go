@@ -0,0 +1,51 @@
+package boolvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ExactlyOneOf returns a validator which ensures that exactly one of the
+// current attribute and the attributes matching the given path expressions is
+// not null. The path expressions can be relative to the current attribute or
+// absolute from the root of the schema. Validation is skipped while any of
+// the values are unknown.
+func ExactlyOneOf(expressions ...path.Expression) validator.Bool {
+	return exactlyOneOfValidator{
+		expressions: expressions,
+	}
+}
+
+var _ validator.Bool = exactlyOneOfValidator{}
+
+// exactlyOneOfValidator implements the validator.
+type exactlyOneOfValidator struct {
+	expressions path.Expressions
+}
+
+// Description describes the validation in plain text formatting.
+func (v exactlyOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("exactly one of these attributes must be configured: %s", v.expressions)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v exactlyOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateBool performs the validation.
+func (v exactlyOneOfValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	combinationReq := schemavalidator.AttributeCombinationRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Expressions:    v.expressions,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	resp.Diagnostics.Append(schemavalidator.ExactlyOneOf(ctx, combinationReq)...)
+}
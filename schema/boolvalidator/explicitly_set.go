@@ -0,0 +1,50 @@
+package boolvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ExplicitlySet returns a validator which ensures that the value is
+// configured as either true or false. This prevents an optional attribute
+// without a configuration value from being silently treated as false, such
+// as when the null value is read with the Bool type ValueBool method.
+// Unknown values are not validated, since they become known later.
+//
+// Use the ValueBoolPointer method, which returns nil for null values, when
+// an optional attribute should instead distinguish between false and no
+// configured value.
+func ExplicitlySet() validator.Bool {
+	return explicitlySetValidator{}
+}
+
+var _ validator.Bool = explicitlySetValidator{}
+
+// explicitlySetValidator implements the validator.
+type explicitlySetValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v explicitlySetValidator) Description(_ context.Context) string {
+	return "value must be explicitly set to true or false"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v explicitlySetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateBool performs the validation.
+func (v explicitlySetValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+		req.Path,
+		"Missing Attribute Value",
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
@@ -0,0 +1,66 @@
+package boolvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExplicitlySetValidatorValidateBool(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.Bool
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value: types.BoolNull(),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Missing Attribute Value",
+					"Attribute test value must be explicitly set to true or false, got: <null>",
+				),
+			},
+		},
+		"unknown": {
+			value:    types.BoolUnknown(),
+			expected: nil,
+		},
+		"false": {
+			value:    types.BoolValue(false),
+			expected: nil,
+		},
+		"true": {
+			value:    types.BoolValue(true),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.BoolRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    testCase.value,
+			}
+			resp := &validator.BoolResponse{}
+
+			boolvalidator.ExplicitlySet().ValidateBool(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
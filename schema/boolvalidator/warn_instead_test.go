@@ -0,0 +1,98 @@
+package boolvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWarnInsteadValidatorValidateBool(t *testing.T) {
+	t.Parallel()
+
+	errorValidator := testvalidator.Bool{
+		ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Error Summary", "Error detail.")
+		},
+	}
+	warningValidator := testvalidator.Bool{
+		ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			resp.Diagnostics.AddAttributeWarning(req.Path, "Warning Summary", "Warning detail.")
+		},
+	}
+	passingValidator := testvalidator.Bool{}
+
+	testCases := map[string]struct {
+		validators []validator.Bool
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {
+			validators: nil,
+			expected:   nil,
+		},
+		"passing": {
+			validators: []validator.Bool{
+				passingValidator,
+			},
+			expected: nil,
+		},
+		"error": {
+			validators: []validator.Bool{
+				errorValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"Error detail.",
+				),
+			},
+		},
+		"error-and-warning": {
+			validators: []validator.Bool{
+				errorValidator,
+				passingValidator,
+				warningValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"Error detail.",
+				),
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Warning Summary",
+					"Warning detail.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.BoolRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.BoolValue(true),
+			}
+			resp := &validator.BoolResponse{}
+
+			boolvalidator.WarnInstead(testCase.validators...).ValidateBool(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package boolvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// WhenAttributeEquals returns a validator which runs the given validators
+// only when the configuration value at the given path expression is equal to
+// the given value. The path expression may be relative to the current
+// attribute or absolute from the root of the schema. If the expression
+// matches multiple paths, the validators are run when any of the matched
+// values is equal.
+//
+// The given value must be the same value type as the attribute at the path
+// expression, such as types.String for a types.StringType attribute. Unknown
+// configuration values are never considered to be equal, so the validators
+// are not run until the value is known.
+func WhenAttributeEquals(expression path.Expression, value attr.Value, validators ...validator.Bool) validator.Bool {
+	return whenAttributeEqualsValidator{
+		expression: expression,
+		validators: validators,
+		value:      value,
+	}
+}
+
+var _ validator.Bool = whenAttributeEqualsValidator{}
+
+// whenAttributeEqualsValidator implements the validator.
+type whenAttributeEqualsValidator struct {
+	expression path.Expression
+	validators []validator.Bool
+	value      attr.Value
+}
+
+// Description describes the validation in plain text formatting.
+func (v whenAttributeEqualsValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("when %s is %s, value must satisfy all of the validators: %s", v.expression, v.value, strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v whenAttributeEqualsValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("when `%s` is `%s`, value must satisfy all of the validators: %s", v.expression, v.value, strings.Join(descriptions, " + "))
+}
+
+// ValidateBool performs the validation.
+func (v whenAttributeEqualsValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	equal, diags := schemavalidator.ConfigValueEquals(ctx, req.Config, req.PathExpression, v.expression, v.value)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !equal {
+		return
+	}
+
+	for _, subValidator := range v.validators {
+		validateResp := &validator.BoolResponse{}
+
+		subValidator.ValidateBool(ctx, req, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
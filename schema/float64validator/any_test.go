@@ -0,0 +1,135 @@
+package float64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAnyValidatorValidateFloat64(t *testing.T) {
+	t.Parallel()
+
+	errorValidator := testvalidator.Float64{
+		ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Error Summary", "Error detail.")
+		},
+	}
+	otherErrorValidator := testvalidator.Float64{
+		ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Other Error Summary", "Other error detail.")
+		},
+	}
+	warningValidator := testvalidator.Float64{
+		ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+			resp.Diagnostics.AddAttributeWarning(req.Path, "Warning Summary", "Warning detail.")
+		},
+	}
+	passingValidator := testvalidator.Float64{}
+
+	testCases := map[string]struct {
+		validators []validator.Float64
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {
+			validators: []validator.Float64{},
+			expected:   nil,
+		},
+		"passing": {
+			validators: []validator.Float64{
+				passingValidator,
+			},
+			expected: nil,
+		},
+		"error": {
+			validators: []validator.Float64{
+				errorValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"Error detail.",
+				),
+			},
+		},
+		"errors": {
+			validators: []validator.Float64{
+				errorValidator,
+				otherErrorValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"Error detail.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Other Error Summary",
+					"Other error detail.",
+				),
+			},
+		},
+		"error-then-passing": {
+			validators: []validator.Float64{
+				errorValidator,
+				passingValidator,
+			},
+			expected: nil,
+		},
+		"error-then-warning": {
+			validators: []validator.Float64{
+				errorValidator,
+				warningValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Warning Summary",
+					"Warning detail.",
+				),
+			},
+		},
+		"warning-then-error": {
+			validators: []validator.Float64{
+				warningValidator,
+				errorValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Warning Summary",
+					"Warning detail.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Float64Request{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.Float64Value(1.2),
+			}
+			resp := &validator.Float64Response{}
+
+			float64validator.Any(testCase.validators...).ValidateFloat64(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
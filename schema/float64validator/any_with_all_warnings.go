@@ -0,0 +1,70 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AnyWithAllWarnings returns a validator which ensures that the configured
+// value passes at least one of the given validators. This validator returns
+// all warnings, including failed validators.
+//
+// Use Any() to return warnings only from the passing validator.
+func AnyWithAllWarnings(validators ...validator.Float64) validator.Float64 {
+	return anyWithAllWarningsValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.Float64 = anyWithAllWarningsValidator{}
+
+// anyWithAllWarningsValidator implements the validator.
+type anyWithAllWarningsValidator struct {
+	validators []validator.Float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v anyWithAllWarningsValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("value must satisfy at least one of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v anyWithAllWarningsValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("value must satisfy at least one of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateFloat64 performs the validation.
+func (v anyWithAllWarningsValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	anyValid := false
+
+	for _, subValidator := range v.validators {
+		validateResp := &validator.Float64Response{}
+
+		subValidator.ValidateFloat64(ctx, req, validateResp)
+
+		if !validateResp.Diagnostics.HasError() {
+			anyValid = true
+		}
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+
+	if anyValid {
+		resp.Diagnostics = resp.Diagnostics.Warnings()
+	}
+}
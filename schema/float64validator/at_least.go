@@ -0,0 +1,54 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AtLeast returns a validator which ensures that the configured value is
+// greater than or equal to the given minimum. Null and unknown values are not
+// validated.
+func AtLeast(minValue float64) validator.Float64 {
+	return atLeastValidator{
+		minValue: minValue,
+	}
+}
+
+var _ validator.Float64 = atLeastValidator{}
+
+// atLeastValidator implements the validator.
+type atLeastValidator struct {
+	minValue float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v atLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %s", strconv.FormatFloat(v.minValue, 'f', -1, 64))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation.
+func (v atLeastValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if value < v.minValue {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.FormatFloat(value, 'f', -1, 64),
+		))
+	}
+}
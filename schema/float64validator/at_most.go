@@ -0,0 +1,54 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AtMost returns a validator which ensures that the configured value is less
+// than or equal to the given maximum. Null and unknown values are not
+// validated.
+func AtMost(maxValue float64) validator.Float64 {
+	return atMostValidator{
+		maxValue: maxValue,
+	}
+}
+
+var _ validator.Float64 = atMostValidator{}
+
+// atMostValidator implements the validator.
+type atMostValidator struct {
+	maxValue float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %s", strconv.FormatFloat(v.maxValue, 'f', -1, 64))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation.
+func (v atMostValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if value > v.maxValue {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.FormatFloat(value, 'f', -1, 64),
+		))
+	}
+}
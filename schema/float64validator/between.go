@@ -0,0 +1,56 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Between returns a validator which ensures that the configured value is
+// greater than or equal to the given minimum and less than or equal to the
+// given maximum. Null and unknown values are not validated.
+func Between(minValue float64, maxValue float64) validator.Float64 {
+	return betweenValidator{
+		maxValue: maxValue,
+		minValue: minValue,
+	}
+}
+
+var _ validator.Float64 = betweenValidator{}
+
+// betweenValidator implements the validator.
+type betweenValidator struct {
+	maxValue float64
+	minValue float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %s and %s", strconv.FormatFloat(v.minValue, 'f', -1, 64), strconv.FormatFloat(v.maxValue, 'f', -1, 64))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation.
+func (v betweenValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if value < v.minValue || value > v.maxValue {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.FormatFloat(value, 'f', -1, 64),
+		))
+	}
+}
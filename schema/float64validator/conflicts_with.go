@@ -0,0 +1,51 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ConflictsWith returns a validator which ensures that the configured value
+// is null when any attribute matching the given path expressions is not null.
+// The path expressions can be relative to the current attribute or absolute
+// from the root of the schema. Unknown values of the other attributes are
+// skipped, since they may become null.
+func ConflictsWith(expressions ...path.Expression) validator.Float64 {
+	return conflictsWithValidator{
+		expressions: expressions,
+	}
+}
+
+var _ validator.Float64 = conflictsWithValidator{}
+
+// conflictsWithValidator implements the validator.
+type conflictsWithValidator struct {
+	expressions path.Expressions
+}
+
+// Description describes the validation in plain text formatting.
+func (v conflictsWithValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("attribute cannot be configured with: %s", v.expressions)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v conflictsWithValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation.
+func (v conflictsWithValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	combinationReq := schemavalidator.AttributeCombinationRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Expressions:    v.expressions,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	resp.Diagnostics.Append(schemavalidator.ConflictsWith(ctx, combinationReq)...)
+}
@@ -0,0 +1,56 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// MultipleOf returns a validator which ensures that the configured value is a
+// multiple of the given value, within a small tolerance for floating point
+// representation so decimal values such as 0.3 are multiples of 0.1. Only zero
+// is a multiple of zero. Null and unknown values are not validated.
+func MultipleOf(multiple float64) validator.Float64 {
+	return multipleOfValidator{
+		multiple: multiple,
+	}
+}
+
+var _ validator.Float64 = multipleOfValidator{}
+
+// multipleOfValidator implements the validator.
+type multipleOfValidator struct {
+	multiple float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v multipleOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a multiple of %s", strconv.FormatFloat(v.multiple, 'f', -1, 64))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v multipleOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation.
+func (v multipleOfValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if !schemavalidator.IsMultipleOf(big.NewFloat(value), big.NewFloat(v.multiple)) {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.FormatFloat(value, 'f', -1, 64),
+		))
+	}
+}
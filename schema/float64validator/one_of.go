@@ -0,0 +1,64 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// OneOf returns a validator which ensures that the configured value is equal
+// to one of the given values. Null and unknown values are not validated.
+func OneOf(values ...float64) validator.Float64 {
+	return oneOfValidator{
+		values: values,
+	}
+}
+
+var _ validator.Float64 = oneOfValidator{}
+
+// oneOfValidator implements the validator.
+type oneOfValidator struct {
+	values []float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	values := make([]string, 0, len(v.values))
+
+	for _, value := range v.values {
+		values = append(values, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+
+	return fmt.Sprintf("value must be one of: [%s]", strings.Join(values, " "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation.
+func (v oneOfValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	for _, allowed := range v.values {
+		if value == allowed {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+		req.Path,
+		"Invalid Attribute Value",
+		v.Description(ctx),
+		strconv.FormatFloat(value, 'f', -1, 64),
+	))
+}
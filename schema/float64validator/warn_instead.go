@@ -0,0 +1,63 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// WarnInstead returns a validator which runs all of the given validators and
+// converts any error diagnostics they return into warning diagnostics. The
+// summary, detail, and path of each diagnostic are preserved.
+//
+// This is intended for gradually introducing new validation rules, such as
+// during a deprecation period, without immediately breaking existing
+// configurations that do not yet satisfy the rules.
+func WarnInstead(validators ...validator.Float64) validator.Float64 {
+	return warnInsteadValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.Float64 = warnInsteadValidator{}
+
+// warnInsteadValidator implements the validator.
+type warnInsteadValidator struct {
+	validators []validator.Float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v warnInsteadValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("value should satisfy all of the validators, otherwise a warning is returned: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v warnInsteadValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("value should satisfy all of the validators, otherwise a warning is returned: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateFloat64 performs the validation.
+func (v warnInsteadValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	for _, subValidator := range v.validators {
+		validateResp := &validator.Float64Response{}
+
+		subValidator.ValidateFloat64(ctx, req, validateResp)
+
+		resp.Diagnostics.Append(validatordiag.ErrorsToWarnings(validateResp.Diagnostics)...)
+	}
+}
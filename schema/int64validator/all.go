@@ -0,0 +1,62 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// All returns a validator which ensures that the configured value passes all
+// of the given validators. All diagnostics from the given validators are
+// returned.
+//
+// Validators in a schema Validators field are already run together, so this
+// validator is intended for composing with other combinators, such as
+// Any(All(...), ...).
+func All(validators ...validator.Int64) validator.Int64 {
+	return allValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.Int64 = allValidator{}
+
+// allValidator implements the validator.
+type allValidator struct {
+	validators []validator.Int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v allValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("value must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v allValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("value must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateInt64 performs the validation.
+func (v allValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	for _, subValidator := range v.validators {
+		validateResp := &validator.Int64Response{}
+
+		subValidator.ValidateInt64(ctx, req, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
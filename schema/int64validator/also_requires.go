@@ -0,0 +1,50 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AlsoRequires returns a validator which ensures that all attributes matching
+// the given path expressions are not null when the configured value is not
+// null. The path expressions can be relative to the current attribute or
+// absolute from the root of the schema.
+func AlsoRequires(expressions ...path.Expression) validator.Int64 {
+	return alsoRequiresValidator{
+		expressions: expressions,
+	}
+}
+
+var _ validator.Int64 = alsoRequiresValidator{}
+
+// alsoRequiresValidator implements the validator.
+type alsoRequiresValidator struct {
+	expressions path.Expressions
+}
+
+// Description describes the validation in plain text formatting.
+func (v alsoRequiresValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("attribute also requires: %s", v.expressions)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v alsoRequiresValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs the validation.
+func (v alsoRequiresValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	combinationReq := schemavalidator.AttributeCombinationRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Expressions:    v.expressions,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	resp.Diagnostics.Append(schemavalidator.AlsoRequires(ctx, combinationReq)...)
+}
@@ -0,0 +1,54 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AtLeast returns a validator which ensures that the configured value is
+// greater than or equal to the given minimum. Null and unknown values are not
+// validated.
+func AtLeast(minValue int64) validator.Int64 {
+	return atLeastValidator{
+		minValue: minValue,
+	}
+}
+
+var _ validator.Int64 = atLeastValidator{}
+
+// atLeastValidator implements the validator.
+type atLeastValidator struct {
+	minValue int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v atLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %s", strconv.FormatInt(v.minValue, 10))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs the validation.
+func (v atLeastValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if value < v.minValue {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.FormatInt(value, 10),
+		))
+	}
+}
@@ -0,0 +1,54 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AtMost returns a validator which ensures that the configured value is less
+// than or equal to the given maximum. Null and unknown values are not
+// validated.
+func AtMost(maxValue int64) validator.Int64 {
+	return atMostValidator{
+		maxValue: maxValue,
+	}
+}
+
+var _ validator.Int64 = atMostValidator{}
+
+// atMostValidator implements the validator.
+type atMostValidator struct {
+	maxValue int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %s", strconv.FormatInt(v.maxValue, 10))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs the validation.
+func (v atMostValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if value > v.maxValue {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.FormatInt(value, 10),
+		))
+	}
+}
@@ -0,0 +1,56 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Between returns a validator which ensures that the configured value is
+// greater than or equal to the given minimum and less than or equal to the
+// given maximum. Null and unknown values are not validated.
+func Between(minValue int64, maxValue int64) validator.Int64 {
+	return betweenValidator{
+		maxValue: maxValue,
+		minValue: minValue,
+	}
+}
+
+var _ validator.Int64 = betweenValidator{}
+
+// betweenValidator implements the validator.
+type betweenValidator struct {
+	maxValue int64
+	minValue int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %s and %s", strconv.FormatInt(v.minValue, 10), strconv.FormatInt(v.maxValue, 10))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs the validation.
+func (v betweenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if value < v.minValue || value > v.maxValue {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.FormatInt(value, 10),
+		))
+	}
+}
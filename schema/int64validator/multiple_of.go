@@ -0,0 +1,60 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// MultipleOf returns a validator which ensures that the configured value is a
+// multiple of the given value. Only zero is a multiple of zero. Null and
+// unknown values are not validated.
+func MultipleOf(multiple int64) validator.Int64 {
+	return multipleOfValidator{
+		multiple: multiple,
+	}
+}
+
+var _ validator.Int64 = multipleOfValidator{}
+
+// multipleOfValidator implements the validator.
+type multipleOfValidator struct {
+	multiple int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v multipleOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a multiple of %s", strconv.FormatInt(v.multiple, 10))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v multipleOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs the validation.
+func (v multipleOfValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if v.multiple == 0 && value == 0 {
+		return
+	}
+
+	if v.multiple != 0 && value%v.multiple == 0 {
+		return
+	}
+
+	resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+		req.Path,
+		"Invalid Attribute Value",
+		v.Description(ctx),
+		strconv.FormatInt(value, 10),
+	))
+}
@@ -0,0 +1,106 @@
+package int64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWhenAttributeEqualsValidatorValidateInt64(t *testing.T) {
+	t.Parallel()
+
+	testConfig := func(condition tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"condition": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"condition": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"condition": condition,
+				},
+			),
+		}
+	}
+
+	errorValidator := testvalidator.Int64{
+		ValidateInt64Method: func(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Error Summary", "Error detail.")
+		},
+	}
+
+	testCases := map[string]struct {
+		config     tfsdk.Config
+		expression path.Expression
+		value      attr.Value
+		expected   diag.Diagnostics
+	}{
+		"equal": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, "expected")),
+			expression: path.MatchRoot("condition"),
+			value:      types.StringValue("expected"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"Error detail.",
+				),
+			},
+		},
+		"not-equal": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, "other")),
+			expression: path.MatchRoot("condition"),
+			value:      types.StringValue("expected"),
+			expected:   nil,
+		},
+		"unknown": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			expression: path.MatchRoot("condition"),
+			value:      types.StringValue("expected"),
+			expected:   nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Int64Request{
+				Config:         testCase.config,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.Int64Value(1),
+			}
+			resp := &validator.Int64Response{}
+
+			int64validator.WhenAttributeEquals(testCase.expression, testCase.value, errorValidator).ValidateInt64(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
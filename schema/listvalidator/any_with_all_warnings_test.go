@@ -0,0 +1,125 @@
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAnyWithAllWarningsValidatorValidateList(t *testing.T) {
+	t.Parallel()
+
+	errorValidator := testvalidator.List{
+		ValidateListMethod: func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Error Summary", "Error detail.")
+		},
+	}
+	otherErrorValidator := testvalidator.List{
+		ValidateListMethod: func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Other Error Summary", "Other error detail.")
+		},
+	}
+	warningValidator := testvalidator.List{
+		ValidateListMethod: func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+			resp.Diagnostics.AddAttributeWarning(req.Path, "Warning Summary", "Warning detail.")
+		},
+	}
+	passingValidator := testvalidator.List{}
+
+	testCases := map[string]struct {
+		validators []validator.List
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {
+			validators: []validator.List{},
+			expected:   nil,
+		},
+		"passing": {
+			validators: []validator.List{
+				passingValidator,
+			},
+			expected: nil,
+		},
+		"errors": {
+			validators: []validator.List{
+				errorValidator,
+				otherErrorValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"Error detail.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Other Error Summary",
+					"Other error detail.",
+				),
+			},
+		},
+		"error-then-passing": {
+			validators: []validator.List{
+				errorValidator,
+				passingValidator,
+			},
+			expected: nil,
+		},
+		"warning-error-passing": {
+			validators: []validator.List{
+				warningValidator,
+				errorValidator,
+				passingValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Warning Summary",
+					"Warning detail.",
+				),
+			},
+		},
+		"warning-error": {
+			validators: []validator.List{
+				warningValidator,
+				errorValidator,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Warning Summary",
+					"Warning detail.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			}
+			resp := &validator.ListResponse{}
+
+			listvalidator.AnyWithAllWarnings(testCase.validators...).ValidateList(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// SizeAtMost returns a validator which ensures that the number of elements
+// in the configured list is less than or equal to the given maximum. Null and
+// unknown lists are not validated.
+func SizeAtMost(maxSize int) validator.List {
+	return sizeAtMostValidator{
+		maxSize: maxSize,
+	}
+}
+
+var _ validator.List = sizeAtMostValidator{}
+
+// sizeAtMostValidator implements the validator.
+type sizeAtMostValidator struct {
+	maxSize int
+}
+
+// Description describes the validation in plain text formatting.
+func (v sizeAtMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("list must contain at most %d elements", v.maxSize)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sizeAtMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateList performs the validation.
+func (v sizeAtMostValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := len(req.ConfigValue.Elements())
+
+	if elements > v.maxSize {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.Itoa(elements),
+		))
+	}
+}
@@ -0,0 +1,63 @@
+package listvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// UniqueValues returns a validator which ensures that the configured list
+// does not contain duplicate element values. An error diagnostic is returned
+// at the path of each element which is equal to an earlier element. Unknown
+// element values are not compared. Null and unknown lists are not validated.
+//
+// Use a set type attribute instead if element ordering is not important.
+func UniqueValues() validator.List {
+	return uniqueValuesValidator{}
+}
+
+var _ validator.List = uniqueValuesValidator{}
+
+// uniqueValuesValidator implements the validator.
+type uniqueValuesValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v uniqueValuesValidator) Description(_ context.Context) string {
+	return "all list values must be unique"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v uniqueValuesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateList performs the validation.
+func (v uniqueValuesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+
+	for index, element := range elements {
+		if element.IsUnknown() {
+			continue
+		}
+
+		for _, otherElement := range elements[:index] {
+			if !element.Equal(otherElement) {
+				continue
+			}
+
+			resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+				req.Path.AtListIndex(index),
+				"Invalid Attribute Value",
+				v.Description(ctx),
+				element.String(),
+			))
+
+			break
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValueBoolsAre returns a validator which runs the given validators against
+// every element of the configured list, using the element path in
+// diagnostics. The element type must be types.BoolType or a custom Bool
+// type. Null and unknown lists are not validated.
+func ValueBoolsAre(validators ...validator.Bool) validator.List {
+	return valueBoolsAreValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.List = valueBoolsAreValidator{}
+
+// valueBoolsAreValidator implements the validator.
+type valueBoolsAreValidator struct {
+	validators []validator.Bool
+}
+
+// Description describes the validation in plain text formatting.
+func (v valueBoolsAreValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.validators {
+		descriptions = append(descriptions, elementValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v valueBoolsAreValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.validators {
+		descriptions = append(descriptions, elementValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateList performs the validation.
+func (v valueBoolsAreValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for index, element := range req.ConfigValue.Elements() {
+		elementPath := req.Path.AtListIndex(index)
+		elementPathExpression := req.PathExpression.AtListIndex(index)
+
+		elementValuable, ok := element.(basetypes.BoolValuable)
+
+		if !ok {
+			resp.Diagnostics.Append(schemavalidator.InvalidElementTypeDiagnostic(req.Path, "Bool", element))
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToBoolValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		elementReq := validator.BoolRequest{
+			Path:           elementPath,
+			PathExpression: elementPathExpression,
+			Config:         req.Config,
+			ConfigValue:    elementValue,
+		}
+
+		for _, elementValidator := range v.validators {
+			elementResp := &validator.BoolResponse{}
+
+			elementValidator.ValidateBool(ctx, elementReq, elementResp)
+
+			resp.Diagnostics.Append(elementResp.Diagnostics...)
+		}
+	}
+}
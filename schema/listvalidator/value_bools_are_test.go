@@ -0,0 +1,99 @@
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueBoolsAreValidatorValidateList(t *testing.T) {
+	t.Parallel()
+
+	errorValidator := testvalidator.Bool{
+		ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Error Summary", "Error detail.")
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.List
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.ListNull(types.StringType),
+			expected: nil,
+		},
+		"unknown": {
+			value:    types.ListUnknown(types.StringType),
+			expected: nil,
+		},
+		"no-elements": {
+			value:    types.ListValueMust(types.BoolType, []attr.Value{}),
+			expected: nil,
+		},
+		"elements": {
+			value: types.ListValueMust(types.BoolType, []attr.Value{
+				types.BoolValue(true),
+				types.BoolValue(false),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0),
+					"Error Summary",
+					"Error detail.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(1),
+					"Error Summary",
+					"Error detail.",
+				),
+			},
+		},
+		"invalid-element-type": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("a"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Validator for Element Type",
+					"While performing schema-based validation, an unexpected error occurred. "+
+						"The attribute declares a Bool values validator, however its values do not implement the Bool value type. "+
+						"Use the appropriate values validator that matches the element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Path: test\n"+
+						"Element Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    testCase.value,
+			}
+			resp := &validator.ListResponse{}
+
+			listvalidator.ValueBoolsAre(errorValidator).ValidateList(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
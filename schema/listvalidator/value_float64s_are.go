@@ -0,0 +1,93 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValueFloat64sAre returns a validator which runs the given validators against
+// every element of the configured list, using the element path in
+// diagnostics. The element type must be types.Float64Type or a custom Float64
+// type. Null and unknown lists are not validated.
+func ValueFloat64sAre(validators ...validator.Float64) validator.List {
+	return valueFloat64sAreValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.List = valueFloat64sAreValidator{}
+
+// valueFloat64sAreValidator implements the validator.
+type valueFloat64sAreValidator struct {
+	validators []validator.Float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v valueFloat64sAreValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.validators {
+		descriptions = append(descriptions, elementValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v valueFloat64sAreValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.validators {
+		descriptions = append(descriptions, elementValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateList performs the validation.
+func (v valueFloat64sAreValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for index, element := range req.ConfigValue.Elements() {
+		elementPath := req.Path.AtListIndex(index)
+		elementPathExpression := req.PathExpression.AtListIndex(index)
+
+		elementValuable, ok := element.(basetypes.Float64Valuable)
+
+		if !ok {
+			resp.Diagnostics.Append(schemavalidator.InvalidElementTypeDiagnostic(req.Path, "Float64", element))
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToFloat64Value(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		elementReq := validator.Float64Request{
+			Path:           elementPath,
+			PathExpression: elementPathExpression,
+			Config:         req.Config,
+			ConfigValue:    elementValue,
+		}
+
+		for _, elementValidator := range v.validators {
+			elementResp := &validator.Float64Response{}
+
+			elementValidator.ValidateFloat64(ctx, elementReq, elementResp)
+
+			resp.Diagnostics.Append(elementResp.Diagnostics...)
+		}
+	}
+}
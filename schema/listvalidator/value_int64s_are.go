@@ -0,0 +1,93 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValueInt64sAre returns a validator which runs the given validators against
+// every element of the configured list, using the element path in
+// diagnostics. The element type must be types.Int64Type or an custom Int64
+// type. Null and unknown lists are not validated.
+func ValueInt64sAre(validators ...validator.Int64) validator.List {
+	return valueInt64sAreValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.List = valueInt64sAreValidator{}
+
+// valueInt64sAreValidator implements the validator.
+type valueInt64sAreValidator struct {
+	validators []validator.Int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v valueInt64sAreValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.validators {
+		descriptions = append(descriptions, elementValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v valueInt64sAreValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.validators {
+		descriptions = append(descriptions, elementValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateList performs the validation.
+func (v valueInt64sAreValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for index, element := range req.ConfigValue.Elements() {
+		elementPath := req.Path.AtListIndex(index)
+		elementPathExpression := req.PathExpression.AtListIndex(index)
+
+		elementValuable, ok := element.(basetypes.Int64Valuable)
+
+		if !ok {
+			resp.Diagnostics.Append(schemavalidator.InvalidElementTypeDiagnostic(req.Path, "Int64", element))
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToInt64Value(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		elementReq := validator.Int64Request{
+			Path:           elementPath,
+			PathExpression: elementPathExpression,
+			Config:         req.Config,
+			ConfigValue:    elementValue,
+		}
+
+		for _, elementValidator := range v.validators {
+			elementResp := &validator.Int64Response{}
+
+			elementValidator.ValidateInt64(ctx, elementReq, elementResp)
+
+			resp.Diagnostics.Append(elementResp.Diagnostics...)
+		}
+	}
+}
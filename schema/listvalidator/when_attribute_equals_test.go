@@ -0,0 +1,106 @@
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWhenAttributeEqualsValidatorValidateList(t *testing.T) {
+	t.Parallel()
+
+	testConfig := func(condition tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"condition": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"condition": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"condition": condition,
+				},
+			),
+		}
+	}
+
+	errorValidator := testvalidator.List{
+		ValidateListMethod: func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Error Summary", "Error detail.")
+		},
+	}
+
+	testCases := map[string]struct {
+		config     tfsdk.Config
+		expression path.Expression
+		value      attr.Value
+		expected   diag.Diagnostics
+	}{
+		"equal": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, "expected")),
+			expression: path.MatchRoot("condition"),
+			value:      types.StringValue("expected"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"Error detail.",
+				),
+			},
+		},
+		"not-equal": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, "other")),
+			expression: path.MatchRoot("condition"),
+			value:      types.StringValue("expected"),
+			expected:   nil,
+		},
+		"unknown": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			expression: path.MatchRoot("condition"),
+			value:      types.StringValue("expected"),
+			expected:   nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ListRequest{
+				Config:         testCase.config,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			}
+			resp := &validator.ListResponse{}
+
+			listvalidator.WhenAttributeEquals(testCase.expression, testCase.value, errorValidator).ValidateList(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
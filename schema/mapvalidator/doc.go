@@ -0,0 +1,2 @@
+// Package mapvalidator provides validators for types.Map attributes.
+package mapvalidator
@@ -0,0 +1,54 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// SizeAtLeast returns a validator which ensures that the number of elements
+// in the configured map is greater than or equal to the given minimum. Null
+// and unknown maps are not validated.
+func SizeAtLeast(minSize int) validator.Map {
+	return sizeAtLeastValidator{
+		minSize: minSize,
+	}
+}
+
+var _ validator.Map = sizeAtLeastValidator{}
+
+// sizeAtLeastValidator implements the validator.
+type sizeAtLeastValidator struct {
+	minSize int
+}
+
+// Description describes the validation in plain text formatting.
+func (v sizeAtLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("map must contain at least %d elements", v.minSize)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sizeAtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateMap performs the validation.
+func (v sizeAtLeastValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := len(req.ConfigValue.Elements())
+
+	if elements < v.minSize {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.Itoa(elements),
+		))
+	}
+}
@@ -0,0 +1,54 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// SizeAtMost returns a validator which ensures that the number of elements
+// in the configured map is less than or equal to the given maximum. Null and
+// unknown maps are not validated.
+func SizeAtMost(maxSize int) validator.Map {
+	return sizeAtMostValidator{
+		maxSize: maxSize,
+	}
+}
+
+var _ validator.Map = sizeAtMostValidator{}
+
+// sizeAtMostValidator implements the validator.
+type sizeAtMostValidator struct {
+	maxSize int
+}
+
+// Description describes the validation in plain text formatting.
+func (v sizeAtMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("map must contain at most %d elements", v.maxSize)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sizeAtMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateMap performs the validation.
+func (v sizeAtMostValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := len(req.ConfigValue.Elements())
+
+	if elements > v.maxSize {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.Itoa(elements),
+		))
+	}
+}
@@ -0,0 +1,57 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// SizeBetween returns a validator which ensures that the number of elements
+// in the configured map is greater than or equal to the given minimum and
+// less than or equal to the given maximum. Null and unknown maps are not
+// validated.
+func SizeBetween(minSize int, maxSize int) validator.Map {
+	return sizeBetweenValidator{
+		maxSize: maxSize,
+		minSize: minSize,
+	}
+}
+
+var _ validator.Map = sizeBetweenValidator{}
+
+// sizeBetweenValidator implements the validator.
+type sizeBetweenValidator struct {
+	maxSize int
+	minSize int
+}
+
+// Description describes the validation in plain text formatting.
+func (v sizeBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("map must contain at least %d elements and at most %d elements", v.minSize, v.maxSize)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sizeBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateMap performs the validation.
+func (v sizeBetweenValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := len(req.ConfigValue.Elements())
+
+	if elements < v.minSize || elements > v.maxSize {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.Itoa(elements),
+		))
+	}
+}
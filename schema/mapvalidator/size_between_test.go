@@ -0,0 +1,95 @@
+package mapvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSizeBetweenValidatorValidateMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.Map
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.MapNull(types.StringType),
+			expected: nil,
+		},
+		"unknown": {
+			value:    types.MapUnknown(types.StringType),
+			expected: nil,
+		},
+		"minimum": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"a": types.StringValue("a"),
+				"b": types.StringValue("b"),
+			}),
+			expected: nil,
+		},
+		"maximum": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"a": types.StringValue("a"),
+				"b": types.StringValue("b"),
+				"c": types.StringValue("c"),
+			}),
+			expected: nil,
+		},
+		"too-few": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"a": types.StringValue("a"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test map must contain at least 2 elements and at most 3 elements, got: 1",
+				),
+			},
+		},
+		"too-many": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"a": types.StringValue("a"),
+				"b": types.StringValue("b"),
+				"c": types.StringValue("c"),
+				"d": types.StringValue("d"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test map must contain at least 2 elements and at most 3 elements, got: 4",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    testCase.value,
+			}
+			resp := &validator.MapResponse{}
+
+			mapvalidator.SizeBetween(2, 3).ValidateMap(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
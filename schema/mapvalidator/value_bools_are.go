@@ -0,0 +1,105 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValueBoolsAre returns a validator which runs the given validators against
+// every element of the configured map, using the element path in
+// diagnostics. The element type must be types.BoolType or a custom Bool
+// type. Null and unknown maps are not validated.
+func ValueBoolsAre(validators ...validator.Bool) validator.Map {
+	return valueBoolsAreValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.Map = valueBoolsAreValidator{}
+
+// valueBoolsAreValidator implements the validator.
+type valueBoolsAreValidator struct {
+	validators []validator.Bool
+}
+
+// Description describes the validation in plain text formatting.
+func (v valueBoolsAreValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.validators {
+		descriptions = append(descriptions, elementValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v valueBoolsAreValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.validators {
+		descriptions = append(descriptions, elementValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateMap performs the validation.
+func (v valueBoolsAreValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	keys := make([]string, 0, len(elements))
+
+	for key := range elements {
+		keys = append(keys, key)
+	}
+
+	// Sort the keys so diagnostics are returned in a consistent order.
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		element := elements[key]
+		elementPath := req.Path.AtMapKey(key)
+		elementPathExpression := req.PathExpression.AtMapKey(key)
+
+		elementValuable, ok := element.(basetypes.BoolValuable)
+
+		if !ok {
+			resp.Diagnostics.Append(schemavalidator.InvalidElementTypeDiagnostic(req.Path, "Bool", element))
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToBoolValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		elementReq := validator.BoolRequest{
+			Path:           elementPath,
+			PathExpression: elementPathExpression,
+			Config:         req.Config,
+			ConfigValue:    elementValue,
+		}
+
+		for _, elementValidator := range v.validators {
+			elementResp := &validator.BoolResponse{}
+
+			elementValidator.ValidateBool(ctx, elementReq, elementResp)
+
+			resp.Diagnostics.Append(elementResp.Diagnostics...)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValueFloat64sAre returns a validator which runs the given validators against
+// every element of the configured map, using the element path in
+// diagnostics. The element type must be types.Float64Type or a custom Float64
+// type. Null and unknown maps are not validated.
+func ValueFloat64sAre(validators ...validator.Float64) validator.Map {
+	return valueFloat64sAreValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.Map = valueFloat64sAreValidator{}
+
+// valueFloat64sAreValidator implements the validator.
+type valueFloat64sAreValidator struct {
+	validators []validator.Float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v valueFloat64sAreValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.validators {
+		descriptions = append(descriptions, elementValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v valueFloat64sAreValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.validators {
+		descriptions = append(descriptions, elementValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of the validators: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateMap performs the validation.
+func (v valueFloat64sAreValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	keys := make([]string, 0, len(elements))
+
+	for key := range elements {
+		keys = append(keys, key)
+	}
+
+	// Sort the keys so diagnostics are returned in a consistent order.
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		element := elements[key]
+		elementPath := req.Path.AtMapKey(key)
+		elementPathExpression := req.PathExpression.AtMapKey(key)
+
+		elementValuable, ok := element.(basetypes.Float64Valuable)
+
+		if !ok {
+			resp.Diagnostics.Append(schemavalidator.InvalidElementTypeDiagnostic(req.Path, "Float64", element))
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToFloat64Value(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		elementReq := validator.Float64Request{
+			Path:           elementPath,
+			PathExpression: elementPathExpression,
+			Config:         req.Config,
+			ConfigValue:    elementValue,
+		}
+
+		for _, elementValidator := range v.validators {
+			elementResp := &validator.Float64Response{}
+
+			elementValidator.ValidateFloat64(ctx, elementReq, elementResp)
+
+			resp.Diagnostics.Append(elementResp.Diagnostics...)
+		}
+	}
+}
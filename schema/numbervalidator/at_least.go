@@ -0,0 +1,54 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AtLeast returns a validator which ensures that the configured value is
+// greater than or equal to the given minimum. Null and unknown values are not
+// validated.
+func AtLeast(minValue *big.Float) validator.Number {
+	return atLeastValidator{
+		minValue: minValue,
+	}
+}
+
+var _ validator.Number = atLeastValidator{}
+
+// atLeastValidator implements the validator.
+type atLeastValidator struct {
+	minValue *big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v atLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %s", v.minValue.Text('f', -1))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation.
+func (v atLeastValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if value.Cmp(v.minValue) < 0 {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			value.Text('f', -1),
+		))
+	}
+}
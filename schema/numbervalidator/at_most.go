@@ -0,0 +1,54 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AtMost returns a validator which ensures that the configured value is less
+// than or equal to the given maximum. Null and unknown values are not
+// validated.
+func AtMost(maxValue *big.Float) validator.Number {
+	return atMostValidator{
+		maxValue: maxValue,
+	}
+}
+
+var _ validator.Number = atMostValidator{}
+
+// atMostValidator implements the validator.
+type atMostValidator struct {
+	maxValue *big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %s", v.maxValue.Text('f', -1))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation.
+func (v atMostValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if value.Cmp(v.maxValue) > 0 {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			value.Text('f', -1),
+		))
+	}
+}
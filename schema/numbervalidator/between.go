@@ -0,0 +1,56 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Between returns a validator which ensures that the configured value is
+// greater than or equal to the given minimum and less than or equal to the
+// given maximum. Null and unknown values are not validated.
+func Between(minValue *big.Float, maxValue *big.Float) validator.Number {
+	return betweenValidator{
+		maxValue: maxValue,
+		minValue: minValue,
+	}
+}
+
+var _ validator.Number = betweenValidator{}
+
+// betweenValidator implements the validator.
+type betweenValidator struct {
+	maxValue *big.Float
+	minValue *big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %s and %s", v.minValue.Text('f', -1), v.maxValue.Text('f', -1))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation.
+func (v betweenValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if value.Cmp(v.minValue) < 0 || value.Cmp(v.maxValue) > 0 {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			value.Text('f', -1),
+		))
+	}
+}
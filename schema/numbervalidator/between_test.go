@@ -0,0 +1,91 @@
+package numbervalidator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBetweenValidatorValidateNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.Number
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.NumberNull(),
+			expected: nil,
+		},
+		"unknown": {
+			value:    types.NumberUnknown(),
+			expected: nil,
+		},
+		"minimum": {
+			value:    types.NumberValue(big.NewFloat(2)),
+			expected: nil,
+		},
+		"maximum": {
+			value:    types.NumberValue(big.NewFloat(4)),
+			expected: nil,
+		},
+		"too-low": {
+			value: types.NumberValue(big.NewFloat(1)),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be between 2 and 4, got: 1",
+				),
+			},
+		},
+		"too-high": {
+			value: types.NumberValue(big.NewFloat(5)),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be between 2 and 4, got: 5",
+				),
+			},
+		},
+		"fraction": {
+			value: types.NumberValue(big.NewFloat(4.5)),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be between 2 and 4, got: 4.5",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.NumberRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    testCase.value,
+			}
+			resp := &validator.NumberResponse{}
+
+			numbervalidator.Between(big.NewFloat(2), big.NewFloat(4)).ValidateNumber(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
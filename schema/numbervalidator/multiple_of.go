@@ -0,0 +1,55 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// MultipleOf returns a validator which ensures that the configured value is a
+// multiple of the given value, within a small tolerance for floating point
+// representation so decimal values such as 0.3 are multiples of 0.1. Only zero
+// is a multiple of zero. Null and unknown values are not validated.
+func MultipleOf(multiple *big.Float) validator.Number {
+	return multipleOfValidator{
+		multiple: multiple,
+	}
+}
+
+var _ validator.Number = multipleOfValidator{}
+
+// multipleOfValidator implements the validator.
+type multipleOfValidator struct {
+	multiple *big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v multipleOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a multiple of %s", v.multiple.Text('f', -1))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v multipleOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation.
+func (v multipleOfValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if !schemavalidator.IsMultipleOf(value, v.multiple) {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			value.Text('f', -1),
+		))
+	}
+}
@@ -0,0 +1,64 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// OneOf returns a validator which ensures that the configured value is equal
+// to one of the given values. Null and unknown values are not validated.
+func OneOf(values ...*big.Float) validator.Number {
+	return oneOfValidator{
+		values: values,
+	}
+}
+
+var _ validator.Number = oneOfValidator{}
+
+// oneOfValidator implements the validator.
+type oneOfValidator struct {
+	values []*big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	values := make([]string, 0, len(v.values))
+
+	for _, value := range v.values {
+		values = append(values, value.Text('f', -1))
+	}
+
+	return fmt.Sprintf("value must be one of: [%s]", strings.Join(values, " "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation.
+func (v oneOfValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	for _, allowed := range v.values {
+		if value.Cmp(allowed) == 0 {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+		req.Path,
+		"Invalid Attribute Value",
+		v.Description(ctx),
+		value.Text('f', -1),
+	))
+}
@@ -0,0 +1,2 @@
+// Package objectvalidator provides validators for types.Object attributes.
+package objectvalidator
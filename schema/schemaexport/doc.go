@@ -0,0 +1,8 @@
+// Package schemaexport contains the Exporter interface for rendering
+// framework schemas to alternative interface definition languages, along
+// with built-in exporters for JSON Schema and Protocol Buffers descriptors.
+// This enables validating Terraform configurations against the same contract
+// used by other systems, such as internal APIs.
+//
+// Exporters accept data source, provider, and resource schemas.
+package schemaexport
@@ -0,0 +1,64 @@
+package schemaexport
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Exporter renders a framework schema to an alternative interface definition
+// language. Implement this interface for custom formats.
+type Exporter interface {
+	// Export should render the schema into the response Data field or
+	// return error diagnostics if the schema cannot be represented.
+	Export(context.Context, ExportRequest, *ExportResponse)
+}
+
+// ExportRequest is the request for an Exporter.
+type ExportRequest struct {
+	// TypeName is the name of the schema, such as the resource type name
+	// "examplecloud_thing". Exporters use this to name the rendered schema.
+	TypeName string
+
+	// Schema is the data source, provider, or resource schema to export,
+	// such as a resource/schema.Schema.
+	Schema fwschema.Schema
+}
+
+// ExportResponse is the response for an Exporter.
+type ExportResponse struct {
+	// Data is the rendered schema in the exporter defined format.
+	Data []byte
+
+	// Diagnostics report errors or warnings related to exporting the
+	// schema. An empty slice indicates success, with no warnings or errors
+	// generated.
+	Diagnostics diag.Diagnostics
+}
+
+// Export is a helper function which calls the Exporter with the type name and
+// schema, returning the rendered data and any diagnostics.
+func Export(ctx context.Context, exporter Exporter, typeName string, s fwschema.Schema) ([]byte, diag.Diagnostics) {
+	req := ExportRequest{
+		TypeName: typeName,
+		Schema:   s,
+	}
+	resp := &ExportResponse{}
+
+	exporter.Export(ctx, req, resp)
+
+	return resp.Data, resp.Diagnostics
+}
+
+// unsupportedTypeDiag returns an error diagnostic for schema types which the
+// exporter cannot represent.
+func unsupportedTypeDiag(p path.Path, format string, typeDescription string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Unsupported Schema Type",
+		"The schema contains a type which cannot be exported to "+format+".\n\n"+
+			"Type: "+typeDescription,
+	)
+}
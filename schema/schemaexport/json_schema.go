@@ -0,0 +1,300 @@
+package schemaexport
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// JSONSchemaDialect is the JSON Schema dialect of documents rendered by the
+// JSONSchema exporter.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchemaFormat is the format name used in diagnostics.
+const jsonSchemaFormat = "JSON Schema"
+
+// JSONSchema returns an Exporter which renders the schema as an indented JSON
+// Schema document, which describes the JSON object of the schema data, such
+// as a resource configuration. Attributes and blocks are mapped to
+// properties as follows:
+//
+//   - Bool attributes are boolean, string attributes are string, and number
+//     attributes are number or integer for int64 types.
+//   - List and set attributes are arrays, where sets have unique items. Map
+//     attributes are objects where every property has the element type.
+//   - Object attributes and nested attributes are objects without additional
+//     properties.
+//   - List and set blocks are arrays of objects and single blocks are
+//     objects.
+//
+// Required attributes are required properties. All other attributes may also
+// be null. Computed attributes which are not optional are read-only and
+// deprecated attributes and blocks are deprecated. Descriptions are copied
+// from the schema.
+func JSONSchema() Exporter {
+	return jsonSchemaExporter{}
+}
+
+var _ Exporter = jsonSchemaExporter{}
+
+// jsonSchemaExporter is the Exporter returned by JSONSchema.
+type jsonSchemaExporter struct{}
+
+// jsonSchema is a JSON Schema document. The fields are ordered for readable
+// output.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 any                    `json:"type,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties any                    `json:"additionalProperties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	UniqueItems          bool                   `json:"uniqueItems,omitempty"`
+	ReadOnly             bool                   `json:"readOnly,omitempty"`
+	Deprecated           bool                   `json:"deprecated,omitempty"`
+}
+
+// Export satisfies the Exporter interface.
+func (e jsonSchemaExporter) Export(ctx context.Context, req ExportRequest, resp *ExportResponse) {
+	if req.Schema == nil {
+		return
+	}
+
+	document, diags := jsonSchemaNestedObject(ctx, path.Empty(), req.Schema.GetAttributes(), req.Schema.GetBlocks())
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document.Schema = JSONSchemaDialect
+	document.Title = req.TypeName
+	document.Description = req.Schema.GetDescription()
+	document.Deprecated = req.Schema.GetDeprecationMessage() != ""
+
+	data, err := json.MarshalIndent(document, "", "  ")
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Export Schema",
+			"An unexpected error was encountered while encoding the JSON Schema document: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Data = append(data, '\n')
+}
+
+// jsonSchemaNestedObject returns the JSON Schema of an object with the
+// attributes and blocks.
+func jsonSchemaNestedObject(ctx context.Context, p path.Path, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) (*jsonSchema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := &jsonSchema{
+		Type:                 "object",
+		Properties:           make(map[string]*jsonSchema, len(attributes)+len(blocks)),
+		AdditionalProperties: false,
+	}
+
+	for name, attribute := range attributes {
+		property, propertyDiags := jsonSchemaAttribute(ctx, p.AtName(name), attribute)
+
+		diags.Append(propertyDiags...)
+
+		if propertyDiags.HasError() {
+			continue
+		}
+
+		result.Properties[name] = property
+
+		if attribute.IsRequired() {
+			result.Required = append(result.Required, name)
+		}
+	}
+
+	for name, block := range blocks {
+		property, propertyDiags := jsonSchemaBlock(ctx, p.AtName(name), block)
+
+		diags.Append(propertyDiags...)
+
+		if propertyDiags.HasError() {
+			continue
+		}
+
+		result.Properties[name] = property
+	}
+
+	sort.Strings(result.Required)
+
+	return result, diags
+}
+
+// jsonSchemaAttribute returns the JSON Schema of an attribute.
+func jsonSchemaAttribute(ctx context.Context, p path.Path, attribute fwschema.Attribute) (*jsonSchema, diag.Diagnostics) {
+	var result *jsonSchema
+	var diags diag.Diagnostics
+
+	if nestedAttribute, ok := attribute.(fwschema.NestedAttribute); ok {
+		object, objectDiags := jsonSchemaNestedObject(ctx, p, nestedAttribute.GetNestedObject().GetAttributes(), nil)
+
+		diags.Append(objectDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		switch nestedAttribute.GetNestingMode() {
+		case fwschema.NestingModeList:
+			result = &jsonSchema{Type: "array", Items: object}
+		case fwschema.NestingModeMap:
+			result = &jsonSchema{Type: "object", AdditionalProperties: object}
+		case fwschema.NestingModeSet:
+			result = &jsonSchema{Type: "array", Items: object, UniqueItems: true}
+		case fwschema.NestingModeSingle:
+			result = object
+		default:
+			diags.Append(unsupportedTypeDiag(p, jsonSchemaFormat, "nested attribute with unknown nesting mode"))
+
+			return nil, diags
+		}
+	} else {
+		result, diags = jsonSchemaType(ctx, p, attribute.GetType())
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if !attribute.IsRequired() {
+		result.Type = []string{result.Type.(string), "null"}
+	}
+
+	result.Description = attribute.GetDescription()
+	result.ReadOnly = attribute.IsComputed() && !attribute.IsOptional()
+	result.Deprecated = attribute.GetDeprecationMessage() != ""
+
+	return result, diags
+}
+
+// jsonSchemaBlock returns the JSON Schema of a block.
+func jsonSchemaBlock(ctx context.Context, p path.Path, block fwschema.Block) (*jsonSchema, diag.Diagnostics) {
+	var result *jsonSchema
+
+	object, diags := jsonSchemaNestedObject(ctx, p, block.GetNestedObject().GetAttributes(), block.GetNestedObject().GetBlocks())
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch block.GetNestingMode() {
+	case fwschema.BlockNestingModeList:
+		result = &jsonSchema{Type: "array", Items: object}
+	case fwschema.BlockNestingModeSet:
+		result = &jsonSchema{Type: "array", Items: object, UniqueItems: true}
+	case fwschema.BlockNestingModeSingle:
+		result = object
+	default:
+		diags.Append(unsupportedTypeDiag(p, jsonSchemaFormat, "block with unknown nesting mode"))
+
+		return nil, diags
+	}
+
+	result.Description = block.GetDescription()
+	result.Deprecated = block.GetDeprecationMessage() != ""
+
+	return result, diags
+}
+
+// jsonSchemaType returns the JSON Schema of a value type. Object attributes
+// are always required and may be null.
+func jsonSchemaType(ctx context.Context, p path.Path, typ attr.Type) (*jsonSchema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Int64 types are numbers in Terraform, but integers in JSON Schema.
+	if _, ok := typ.(basetypes.Int64Typable); ok {
+		return &jsonSchema{Type: "integer"}, diags
+	}
+
+	switch tfType := typ.TerraformType(ctx).(type) {
+	case tftypes.List, tftypes.Map, tftypes.Set:
+		typeWithElementType, ok := typ.(attr.TypeWithElementType)
+
+		if !ok {
+			break
+		}
+
+		element, elementDiags := jsonSchemaType(ctx, p, typeWithElementType.ElementType())
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		switch tfType.(type) {
+		case tftypes.List:
+			return &jsonSchema{Type: "array", Items: element}, diags
+		case tftypes.Map:
+			return &jsonSchema{Type: "object", AdditionalProperties: element}, diags
+		default:
+			return &jsonSchema{Type: "array", Items: element, UniqueItems: true}, diags
+		}
+	case tftypes.Object:
+		typeWithAttributeTypes, ok := typ.(attr.TypeWithAttributeTypes)
+
+		if !ok {
+			break
+		}
+
+		attributeTypes := typeWithAttributeTypes.AttributeTypes()
+
+		result := &jsonSchema{
+			Type:                 "object",
+			Properties:           make(map[string]*jsonSchema, len(attributeTypes)),
+			AdditionalProperties: false,
+		}
+
+		for name, attributeType := range attributeTypes {
+			property, propertyDiags := jsonSchemaType(ctx, p.AtName(name), attributeType)
+
+			diags.Append(propertyDiags...)
+
+			if propertyDiags.HasError() {
+				continue
+			}
+
+			property.Type = []string{property.Type.(string), "null"}
+
+			result.Properties[name] = property
+			result.Required = append(result.Required, name)
+		}
+
+		sort.Strings(result.Required)
+
+		return result, diags
+	default:
+		switch {
+		case tfType.Is(tftypes.Bool):
+			return &jsonSchema{Type: "boolean"}, diags
+		case tfType.Is(tftypes.Number):
+			return &jsonSchema{Type: "number"}, diags
+		case tfType.Is(tftypes.String):
+			return &jsonSchema{Type: "string"}, diags
+		}
+	}
+
+	diags.Append(unsupportedTypeDiag(p, jsonSchemaFormat, typ.String()))
+
+	return nil, diags
+}
@@ -0,0 +1,207 @@
+package schemaexport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schemaexport"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        schema.Schema
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"attributes": {
+			schema: schema.Schema{
+				Description: "Manages a thing.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:           true,
+						DeprecationMessage: "Use state instead.",
+					},
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "Thing identifier.",
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"ports": schema.SetAttribute{
+						ElementType: types.Int64Type,
+						Optional:    true,
+					},
+					"settings": schema.ObjectAttribute{
+						AttributeTypes: map[string]attr.Type{
+							"ratio": types.Float64Type,
+						},
+						Optional: true,
+					},
+					"tags": schema.MapAttribute{
+						ElementType: types.ListType{ElemType: types.StringType},
+						Required:    true,
+					},
+				},
+			},
+			expected: `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "examplecloud_thing",
+  "description": "Manages a thing.",
+  "type": "object",
+  "properties": {
+    "enabled": {
+      "type": [
+        "boolean",
+        "null"
+      ],
+      "deprecated": true
+    },
+    "id": {
+      "description": "Thing identifier.",
+      "type": [
+        "string",
+        "null"
+      ],
+      "readOnly": true
+    },
+    "name": {
+      "type": "string"
+    },
+    "ports": {
+      "type": [
+        "array",
+        "null"
+      ],
+      "items": {
+        "type": "integer"
+      },
+      "uniqueItems": true
+    },
+    "settings": {
+      "type": [
+        "object",
+        "null"
+      ],
+      "properties": {
+        "ratio": {
+          "type": [
+            "number",
+            "null"
+          ]
+        }
+      },
+      "required": [
+        "ratio"
+      ],
+      "additionalProperties": false
+    },
+    "tags": {
+      "type": "object",
+      "additionalProperties": {
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "required": [
+    "name",
+    "tags"
+  ],
+  "additionalProperties": false
+}
+`,
+		},
+		"nested-attributes-and-blocks": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"rules": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"port": schema.Int64Attribute{
+									Required: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"timeouts": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							"create": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			expected: `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "examplecloud_thing",
+  "type": "object",
+  "properties": {
+    "rules": {
+      "type": [
+        "array",
+        "null"
+      ],
+      "items": {
+        "type": "object",
+        "properties": {
+          "port": {
+            "type": "integer"
+          }
+        },
+        "required": [
+          "port"
+        ],
+        "additionalProperties": false
+      }
+    },
+    "timeouts": {
+      "type": "object",
+      "properties": {
+        "create": {
+          "type": [
+            "string",
+            "null"
+          ]
+        }
+      },
+      "additionalProperties": false
+    }
+  },
+  "additionalProperties": false
+}
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := schemaexport.Export(context.Background(), schemaexport.JSONSchema(), "examplecloud_thing", testCase.schema)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,554 @@
+package schemaexport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// protoDescriptorFormat is the format name used in diagnostics.
+const protoDescriptorFormat = "Protocol Buffers"
+
+// ProtoDescriptor returns an Exporter which renders the schema as a binary
+// encoded Protocol Buffers FileDescriptorSet, such as used by the protoc
+// --descriptor_set_in flag. The set contains a single proto3 file, named
+// after the type name with a .proto suffix, in the given package, which may
+// be empty. The file contains a message named after the type name in
+// upper camel case, such as ExamplecloudThing for examplecloud_thing.
+// Attributes and blocks are mapped to fields as follows:
+//
+//   - Bool attributes are bool, string attributes are string, int64
+//     attributes are int64, and other number attributes are double.
+//   - List and set attributes and blocks are repeated fields. Map attributes
+//     are map fields with string keys.
+//   - Object attributes, nested attributes, and blocks are nested messages
+//     named after the attribute or block in upper camel case.
+//   - Collections of collections are repeated fields of a nested message,
+//     with the Element suffix, which contains the inner collection in a field
+//     named "value".
+//
+// Singular scalar fields for attributes which are not required use proto3
+// optional field presence, so null values can be distinguished from zero
+// values. Fields are numbered in attribute and block name order starting at
+// 1, so field numbers change when attributes or blocks are added or removed.
+// Deprecated attributes and blocks set the deprecated field option.
+func ProtoDescriptor(packageName string) Exporter {
+	return protoDescriptorExporter{
+		packageName: packageName,
+	}
+}
+
+var _ Exporter = protoDescriptorExporter{}
+
+// protoDescriptorExporter is the Exporter returned by ProtoDescriptor.
+type protoDescriptorExporter struct {
+	packageName string
+}
+
+// Export satisfies the Exporter interface.
+func (e protoDescriptorExporter) Export(ctx context.Context, req ExportRequest, resp *ExportResponse) {
+	if req.Schema == nil {
+		return
+	}
+
+	messageName := protoCamelCase(req.TypeName)
+
+	if messageName == "" {
+		resp.Diagnostics.AddError(
+			"Unable to Export Schema",
+			"The Protocol Buffers exporter requires a type name containing at least one letter, such as examplecloud_thing.",
+		)
+
+		return
+	}
+
+	fullName := "." + messageName
+
+	if e.packageName != "" {
+		fullName = "." + e.packageName + fullName
+	}
+
+	message, diags := protoMessage(ctx, path.Empty(), messageName, fullName, req.Schema.GetAttributes(), req.Schema.GetBlocks())
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Schema.GetDeprecationMessage() != "" {
+		message.Options = &descriptorpb.MessageOptions{
+			Deprecated: proto.Bool(true),
+		}
+	}
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:        proto.String(req.TypeName + ".proto"),
+		MessageType: []*descriptorpb.DescriptorProto{message},
+		Syntax:      proto.String("proto3"),
+	}
+
+	if e.packageName != "" {
+		file.Package = proto.String(e.packageName)
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(&descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{file},
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Export Schema",
+			"An unexpected error was encountered while encoding the Protocol Buffers descriptor: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Data = data
+}
+
+// protoMessage returns the message of an object with the attributes and
+// blocks. The full name is the fully qualified message name, used to
+// reference nested messages.
+func protoMessage(ctx context.Context, p path.Path, name string, fullName string, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) (*descriptorpb.DescriptorProto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	message := &descriptorpb.DescriptorProto{
+		Name: proto.String(name),
+	}
+
+	names := make([]string, 0, len(attributes)+len(blocks))
+
+	for attributeName := range attributes {
+		names = append(names, attributeName)
+	}
+
+	for blockName := range blocks {
+		names = append(names, blockName)
+	}
+
+	sort.Strings(names)
+
+	for index, fieldName := range names {
+		b := &protoFieldBuilder{
+			ctx:             ctx,
+			message:         message,
+			messageFullName: fullName,
+		}
+
+		var field *descriptorpb.FieldDescriptorProto
+		var fieldDiags diag.Diagnostics
+		var deprecated bool
+
+		if attribute, ok := attributes[fieldName]; ok {
+			field, fieldDiags = b.attribute(p.AtName(fieldName), fieldName, attribute)
+			deprecated = attribute.GetDeprecationMessage() != ""
+		} else {
+			field, fieldDiags = b.block(p.AtName(fieldName), fieldName, blocks[fieldName])
+			deprecated = blocks[fieldName].GetDeprecationMessage() != ""
+		}
+
+		diags.Append(fieldDiags...)
+
+		if fieldDiags.HasError() {
+			continue
+		}
+
+		field.Number = proto.Int32(int32(index + 1))
+
+		if deprecated {
+			field.Options = &descriptorpb.FieldOptions{
+				Deprecated: proto.Bool(true),
+			}
+		}
+
+		message.Field = append(message.Field, field)
+	}
+
+	protoSyntheticOneofs(message)
+
+	return message, diags
+}
+
+// protoFieldBuilder creates the fields of a message, adding any nested
+// messages to the message.
+type protoFieldBuilder struct {
+	ctx             context.Context
+	message         *descriptorpb.DescriptorProto
+	messageFullName string
+}
+
+// attribute returns the field of an attribute.
+func (b *protoFieldBuilder) attribute(p path.Path, name string, attribute fwschema.Attribute) (*descriptorpb.FieldDescriptorProto, diag.Diagnostics) {
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok {
+		return b.typ(p, name, attribute.GetType(), !attribute.IsRequired())
+	}
+
+	var diags diag.Diagnostics
+
+	nestedMessageName, nestedDiags := b.nestedMessage(p, name, nestedAttribute.GetNestedObject().GetAttributes(), nil)
+
+	diags.Append(nestedDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	field := protoMessageField(name, nestedMessageName)
+
+	switch nestedAttribute.GetNestingMode() {
+	case fwschema.NestingModeList, fwschema.NestingModeSet:
+		field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	case fwschema.NestingModeMap:
+		return b.mapField(p, name, field)
+	case fwschema.NestingModeSingle:
+	default:
+		diags.Append(unsupportedTypeDiag(p, protoDescriptorFormat, "nested attribute with unknown nesting mode"))
+
+		return nil, diags
+	}
+
+	return field, diags
+}
+
+// block returns the field of a block.
+func (b *protoFieldBuilder) block(p path.Path, name string, block fwschema.Block) (*descriptorpb.FieldDescriptorProto, diag.Diagnostics) {
+	nestedMessageName, diags := b.nestedMessage(p, name, block.GetNestedObject().GetAttributes(), block.GetNestedObject().GetBlocks())
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	field := protoMessageField(name, nestedMessageName)
+
+	switch block.GetNestingMode() {
+	case fwschema.BlockNestingModeList, fwschema.BlockNestingModeSet:
+		field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	case fwschema.BlockNestingModeSingle:
+	default:
+		diags.Append(unsupportedTypeDiag(p, protoDescriptorFormat, "block with unknown nesting mode"))
+
+		return nil, diags
+	}
+
+	return field, diags
+}
+
+// typ returns the field of a value type. Nullable singular scalar fields use
+// proto3 optional field presence.
+func (b *protoFieldBuilder) typ(p path.Path, name string, typ attr.Type, nullable bool) (*descriptorpb.FieldDescriptorProto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scalarType, ok := protoScalarType(b.ctx, typ)
+
+	if ok {
+		field := &descriptorpb.FieldDescriptorProto{
+			Name:  proto.String(name),
+			Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:  scalarType.Enum(),
+		}
+
+		if nullable {
+			field.Proto3Optional = proto.Bool(true)
+		}
+
+		return field, diags
+	}
+
+	switch typ.TerraformType(b.ctx).(type) {
+	case tftypes.List, tftypes.Map, tftypes.Set:
+		typeWithElementType, ok := typ.(attr.TypeWithElementType)
+
+		if !ok {
+			break
+		}
+
+		field, fieldDiags := b.element(p, name, typeWithElementType.ElementType())
+
+		diags.Append(fieldDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if _, ok := typ.TerraformType(b.ctx).(tftypes.Map); ok {
+			return b.mapField(p, name, field)
+		}
+
+		field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+
+		return field, diags
+	case tftypes.Object:
+		typeWithAttributeTypes, ok := typ.(attr.TypeWithAttributeTypes)
+
+		if !ok {
+			break
+		}
+
+		attributeTypes := typeWithAttributeTypes.AttributeTypes()
+		nestedMessageName := protoCamelCase(name)
+		nestedMessage := &descriptorpb.DescriptorProto{
+			Name: proto.String(nestedMessageName),
+		}
+		nestedBuilder := &protoFieldBuilder{
+			ctx:             b.ctx,
+			message:         nestedMessage,
+			messageFullName: b.messageFullName + "." + nestedMessageName,
+		}
+
+		attributeNames := make([]string, 0, len(attributeTypes))
+
+		for attributeName := range attributeTypes {
+			attributeNames = append(attributeNames, attributeName)
+		}
+
+		sort.Strings(attributeNames)
+
+		for index, attributeName := range attributeNames {
+			field, fieldDiags := nestedBuilder.typ(p.AtName(attributeName), attributeName, attributeTypes[attributeName], true)
+
+			diags.Append(fieldDiags...)
+
+			if fieldDiags.HasError() {
+				continue
+			}
+
+			field.Number = proto.Int32(int32(index + 1))
+
+			nestedMessage.Field = append(nestedMessage.Field, field)
+		}
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		protoSyntheticOneofs(nestedMessage)
+
+		fullName, addDiags := b.addNestedMessage(p, nestedMessage)
+
+		diags.Append(addDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return protoMessageField(name, fullName), diags
+	}
+
+	diags.Append(unsupportedTypeDiag(p, protoDescriptorFormat, typ.String()))
+
+	return nil, diags
+}
+
+// element returns the singular field of a collection element type. Element
+// types which are collections are wrapped in a nested message, since
+// repeated and map fields cannot be directly nested.
+func (b *protoFieldBuilder) element(p path.Path, name string, elementType attr.Type) (*descriptorpb.FieldDescriptorProto, diag.Diagnostics) {
+	switch elementType.TerraformType(b.ctx).(type) {
+	case tftypes.List, tftypes.Map, tftypes.Set:
+	default:
+		return b.typ(p, name, elementType, false)
+	}
+
+	var diags diag.Diagnostics
+
+	wrapperName := protoCamelCase(name) + "Element"
+	wrapper := &descriptorpb.DescriptorProto{
+		Name: proto.String(wrapperName),
+	}
+	wrapperBuilder := &protoFieldBuilder{
+		ctx:             b.ctx,
+		message:         wrapper,
+		messageFullName: b.messageFullName + "." + wrapperName,
+	}
+
+	field, fieldDiags := wrapperBuilder.typ(p, "value", elementType, false)
+
+	diags.Append(fieldDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	field.Number = proto.Int32(1)
+	wrapper.Field = append(wrapper.Field, field)
+
+	fullName, addDiags := b.addNestedMessage(p, wrapper)
+
+	diags.Append(addDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return protoMessageField(name, fullName), diags
+}
+
+// mapField returns a map field with string keys and the singular value
+// field, adding the map entry message.
+func (b *protoFieldBuilder) mapField(p path.Path, name string, value *descriptorpb.FieldDescriptorProto) (*descriptorpb.FieldDescriptorProto, diag.Diagnostics) {
+	value.Name = proto.String("value")
+	value.Number = proto.Int32(2)
+	value.Proto3Optional = nil
+
+	entry := &descriptorpb.DescriptorProto{
+		Name: proto.String(protoCamelCase(name) + "Entry"),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:   proto.String("key"),
+				Number: proto.Int32(1),
+				Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			},
+			value,
+		},
+		Options: &descriptorpb.MessageOptions{
+			MapEntry: proto.Bool(true),
+		},
+	}
+
+	fullName, diags := b.addNestedMessage(p, entry)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	field := protoMessageField(name, fullName)
+	field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+
+	return field, diags
+}
+
+// nestedMessage adds a nested message for the attributes and blocks,
+// returning the fully qualified message name.
+func (b *protoFieldBuilder) nestedMessage(p path.Path, name string, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) (string, diag.Diagnostics) {
+	nestedMessageName := protoCamelCase(name)
+
+	nestedMessage, diags := protoMessage(b.ctx, p, nestedMessageName, b.messageFullName+"."+nestedMessageName, attributes, blocks)
+
+	if diags.HasError() {
+		return "", diags
+	}
+
+	fullName, addDiags := b.addNestedMessage(p, nestedMessage)
+
+	diags.Append(addDiags...)
+
+	return fullName, diags
+}
+
+// addNestedMessage adds the nested message, returning the fully qualified
+// message name or an error diagnostic if the name is already in use.
+func (b *protoFieldBuilder) addNestedMessage(p path.Path, nestedMessage *descriptorpb.DescriptorProto) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, existing := range b.message.NestedType {
+		if existing.GetName() == nestedMessage.GetName() {
+			diags.AddAttributeError(
+				p,
+				"Unable to Export Schema",
+				fmt.Sprintf("The Protocol Buffers nested message name %q is already in use by another attribute or block.", nestedMessage.GetName()),
+			)
+
+			return "", diags
+		}
+	}
+
+	b.message.NestedType = append(b.message.NestedType, nestedMessage)
+
+	return b.messageFullName + "." + nestedMessage.GetName(), diags
+}
+
+// protoMessageField returns a singular field with the message type.
+func protoMessageField(name string, typeName string) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+		TypeName: proto.String(typeName),
+	}
+}
+
+// protoSyntheticOneofs declares the synthetic oneof of each proto3 optional
+// field. Synthetic oneofs must be declared after all other oneofs, so this is
+// called once all fields of the message are known.
+func protoSyntheticOneofs(message *descriptorpb.DescriptorProto) {
+	for _, field := range message.Field {
+		if !field.GetProto3Optional() {
+			continue
+		}
+
+		field.OneofIndex = proto.Int32(int32(len(message.OneofDecl)))
+
+		message.OneofDecl = append(message.OneofDecl, &descriptorpb.OneofDescriptorProto{
+			Name: proto.String("_" + field.GetName()),
+		})
+	}
+}
+
+// protoScalarType returns the scalar field type of a primitive value type.
+func protoScalarType(ctx context.Context, typ attr.Type) (descriptorpb.FieldDescriptorProto_Type, bool) {
+	// Int64 types are numbers in Terraform, but have a dedicated field type.
+	if _, ok := typ.(basetypes.Int64Typable); ok {
+		return descriptorpb.FieldDescriptorProto_TYPE_INT64, true
+	}
+
+	tfType := typ.TerraformType(ctx)
+
+	switch {
+	case tfType.Is(tftypes.Bool):
+		return descriptorpb.FieldDescriptorProto_TYPE_BOOL, true
+	case tfType.Is(tftypes.Number):
+		return descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, true
+	case tfType.Is(tftypes.String):
+		return descriptorpb.FieldDescriptorProto_TYPE_STRING, true
+	default:
+		return 0, false
+	}
+}
+
+// protoCamelCase returns the name in upper camel case, such as ThingRule for
+// thing_rule. Characters which are not ASCII letters or digits are removed.
+func protoCamelCase(name string) string {
+	var b strings.Builder
+
+	upper := true
+
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z':
+			if upper {
+				r -= 'a' - 'A'
+			}
+		case r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9':
+			if b.Len() == 0 {
+				continue
+			}
+		default:
+			upper = true
+
+			continue
+		}
+
+		b.WriteRune(r)
+
+		upper = false
+	}
+
+	return b.String()
+}
@@ -0,0 +1,232 @@
+package schemaexport_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schemaexport"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProtoDescriptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		packageName   string
+		typeName      string
+		schema        schema.Schema
+		expected      []string
+		expectedDiags diag.Diagnostics
+	}{
+		"attributes": {
+			packageName: "examplecloud.v1",
+			typeName:    "examplecloud_thing",
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:           true,
+						DeprecationMessage: "Use state instead.",
+					},
+					"matrix": schema.ListAttribute{
+						ElementType: types.ListType{ElemType: types.Float64Type},
+						Optional:    true,
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"ports": schema.SetAttribute{
+						ElementType: types.Int64Type,
+						Optional:    true,
+					},
+					"settings": schema.ObjectAttribute{
+						AttributeTypes: map[string]attr.Type{
+							"ratio": types.NumberType,
+						},
+						Optional: true,
+					},
+					"tags": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			expected: []string{
+				"examplecloud.v1.ExamplecloudThing.enabled = 1: optional bool (deprecated)",
+				"examplecloud.v1.ExamplecloudThing.matrix = 2: repeated examplecloud.v1.ExamplecloudThing.MatrixElement",
+				"examplecloud.v1.ExamplecloudThing.MatrixElement.value = 1: repeated double",
+				"examplecloud.v1.ExamplecloudThing.name = 3: string",
+				"examplecloud.v1.ExamplecloudThing.ports = 4: repeated int64",
+				"examplecloud.v1.ExamplecloudThing.settings = 5: examplecloud.v1.ExamplecloudThing.Settings",
+				"examplecloud.v1.ExamplecloudThing.Settings.ratio = 1: optional double",
+				"examplecloud.v1.ExamplecloudThing.tags = 6: map<string, string>",
+			},
+		},
+		"nested-attributes-and-blocks": {
+			typeName: "examplecloud_thing",
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"endpoints": schema.MapNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"url": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"rules": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"port": schema.Int64Attribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"network": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Blocks: map[string]schema.Block{
+								"timeouts": schema.SingleNestedBlock{
+									Attributes: map[string]schema.Attribute{
+										"create": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"ExamplecloudThing.endpoints = 1: map<string, ExamplecloudThing.Endpoints>",
+				"ExamplecloudThing.Endpoints.url = 1: string",
+				"ExamplecloudThing.network = 2: repeated ExamplecloudThing.Network",
+				"ExamplecloudThing.Network.timeouts = 1: ExamplecloudThing.Network.Timeouts",
+				"ExamplecloudThing.Network.Timeouts.create = 1: optional string",
+				"ExamplecloudThing.rules = 3: repeated ExamplecloudThing.Rules",
+				"ExamplecloudThing.Rules.port = 1: optional int64",
+			},
+		},
+		"missing-type-name": {
+			schema: schema.Schema{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Export Schema",
+					"The Protocol Buffers exporter requires a type name containing at least one letter, such as examplecloud_thing.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, diags := schemaexport.Export(context.Background(), schemaexport.ProtoDescriptor(testCase.packageName), testCase.typeName, testCase.schema)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			var fileDescriptorSet descriptorpb.FileDescriptorSet
+
+			if err := proto.Unmarshal(data, &fileDescriptorSet); err != nil {
+				t.Fatalf("unexpected unmarshal error: %s", err)
+			}
+
+			files, err := protodesc.NewFiles(&fileDescriptorSet)
+
+			if err != nil {
+				t.Fatalf("unexpected descriptor error: %s", err)
+			}
+
+			file, err := files.FindFileByPath(testCase.typeName + ".proto")
+
+			if err != nil {
+				t.Fatalf("unexpected file error: %s", err)
+			}
+
+			var got []string
+
+			for i := 0; i < file.Messages().Len(); i++ {
+				got = append(got, testProtoFields(file.Messages().Get(i))...)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+// testProtoFields returns a line for each field of the message and its
+// nested messages, except map entries which are shown as map fields.
+func testProtoFields(message protoreflect.MessageDescriptor) []string {
+	var result []string
+
+	for i := 0; i < message.Fields().Len(); i++ {
+		field := message.Fields().Get(i)
+
+		var b strings.Builder
+
+		fmt.Fprintf(&b, "%s = %d: ", field.FullName(), field.Number())
+
+		switch {
+		case field.IsMap():
+			fmt.Fprintf(&b, "map<%s, %s>", testProtoFieldType(field.MapKey()), testProtoFieldType(field.MapValue()))
+		case field.IsList():
+			fmt.Fprintf(&b, "repeated %s", testProtoFieldType(field))
+		case field.HasOptionalKeyword():
+			fmt.Fprintf(&b, "optional %s", testProtoFieldType(field))
+		default:
+			b.WriteString(testProtoFieldType(field))
+		}
+
+		if options, ok := field.Options().(*descriptorpb.FieldOptions); ok && options.GetDeprecated() {
+			b.WriteString(" (deprecated)")
+		}
+
+		result = append(result, b.String())
+
+		if field.Message() != nil && !field.IsMap() && field.Message().Parent() == message {
+			result = append(result, testProtoFields(field.Message())...)
+		}
+
+		if field.IsMap() && field.MapValue().Message() != nil && field.MapValue().Message().Parent() == message {
+			result = append(result, testProtoFields(field.MapValue().Message())...)
+		}
+	}
+
+	return result
+}
+
+// testProtoFieldType returns the scalar kind or message name of the field.
+func testProtoFieldType(field protoreflect.FieldDescriptor) string {
+	if field.Message() != nil {
+		return string(field.Message().FullName())
+	}
+
+	return field.Kind().String()
+}
@@ -0,0 +1,57 @@
+package setvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// SizeBetween returns a validator which ensures that the number of elements
+// in the configured set is greater than or equal to the given minimum and
+// less than or equal to the given maximum. Null and unknown sets are not
+// validated.
+func SizeBetween(minSize int, maxSize int) validator.Set {
+	return sizeBetweenValidator{
+		maxSize: maxSize,
+		minSize: minSize,
+	}
+}
+
+var _ validator.Set = sizeBetweenValidator{}
+
+// sizeBetweenValidator implements the validator.
+type sizeBetweenValidator struct {
+	maxSize int
+	minSize int
+}
+
+// Description describes the validation in plain text formatting.
+func (v sizeBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("set must contain at least %d elements and at most %d elements", v.minSize, v.maxSize)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sizeBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateSet performs the validation.
+func (v sizeBetweenValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := len(req.ConfigValue.Elements())
+
+	if elements < v.minSize || elements > v.maxSize {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value",
+			v.Description(ctx),
+			strconv.Itoa(elements),
+		))
+	}
+}
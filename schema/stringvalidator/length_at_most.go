@@ -0,0 +1,54 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// LengthAtMost returns a validator which ensures that the configured value
+// byte length is less than or equal to the given maximum. Null and unknown
+// values are not validated.
+func LengthAtMost(maxLength int) validator.String {
+	return lengthAtMostValidator{
+		maxLength: maxLength,
+	}
+}
+
+var _ validator.String = lengthAtMostValidator{}
+
+// lengthAtMostValidator implements the validator.
+type lengthAtMostValidator struct {
+	maxLength int
+}
+
+// Description describes the validation in plain text formatting.
+func (v lengthAtMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string length must be at most %d", v.maxLength)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v lengthAtMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v lengthAtMostValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	length := len(req.ConfigValue.ValueString())
+
+	if length > v.maxLength {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value Length",
+			v.Description(ctx),
+			strconv.Itoa(length),
+		))
+	}
+}
@@ -0,0 +1,57 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// LengthBetween returns a validator which ensures that the configured value
+// byte length is greater than or equal to the given minimum and less than or
+// equal to the given maximum. Null and unknown values are not validated. Use
+// UTF8LengthBetween to validate the number of characters instead.
+func LengthBetween(minLength int, maxLength int) validator.String {
+	return lengthBetweenValidator{
+		maxLength: maxLength,
+		minLength: minLength,
+	}
+}
+
+var _ validator.String = lengthBetweenValidator{}
+
+// lengthBetweenValidator implements the validator.
+type lengthBetweenValidator struct {
+	maxLength int
+	minLength int
+}
+
+// Description describes the validation in plain text formatting.
+func (v lengthBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string length must be between %d and %d", v.minLength, v.maxLength)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v lengthBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v lengthBetweenValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	length := len(req.ConfigValue.ValueString())
+
+	if length < v.minLength || length > v.maxLength {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value Length",
+			v.Description(ctx),
+			strconv.Itoa(length),
+		))
+	}
+}
@@ -0,0 +1,59 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// NoneOf returns a validator which ensures that the configured value is not
+// equal to any of the given values. Values are compared case sensitively.
+// Null and unknown values are not validated.
+func NoneOf(values ...string) validator.String {
+	return noneOfValidator{
+		values: values,
+	}
+}
+
+var _ validator.String = noneOfValidator{}
+
+// noneOfValidator implements the validator.
+type noneOfValidator struct {
+	values []string
+}
+
+// Description describes the validation in plain text formatting.
+func (v noneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be none of: %q", v.values)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v noneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v noneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	for _, disallowed := range v.values {
+		if value != disallowed {
+			continue
+		}
+
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value Match",
+			v.Description(ctx),
+			req.ConfigValue.String(),
+		))
+
+		return
+	}
+}
@@ -0,0 +1,57 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// OneOf returns a validator which ensures that the configured value is equal
+// to one of the given values. Values are compared case sensitively. Null and
+// unknown values are not validated.
+func OneOf(values ...string) validator.String {
+	return oneOfValidator{
+		values: values,
+	}
+}
+
+var _ validator.String = oneOfValidator{}
+
+// oneOfValidator implements the validator.
+type oneOfValidator struct {
+	values []string
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %q", v.values)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	for _, allowed := range v.values {
+		if value == allowed {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+		req.Path,
+		"Invalid Attribute Value Match",
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
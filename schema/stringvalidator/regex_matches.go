@@ -0,0 +1,65 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// RegexMatches returns a validator which ensures that the configured value
+// matches the given regular expression. The message, if not empty, replaces
+// the default description and is intended to explain the expected format to
+// practitioners. Null and unknown values are not validated.
+func RegexMatches(regexp *regexp.Regexp, message string) validator.String {
+	return regexMatchesValidator{
+		message: message,
+		regexp:  regexp,
+	}
+}
+
+var _ validator.String = regexMatchesValidator{}
+
+// regexMatchesValidator implements the validator.
+type regexMatchesValidator struct {
+	message string
+	regexp  *regexp.Regexp
+}
+
+// Description describes the validation in plain text formatting.
+func (v regexMatchesValidator) Description(_ context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	return fmt.Sprintf("value must match regular expression '%s'", v.regexp)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v regexMatchesValidator) MarkdownDescription(ctx context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	return fmt.Sprintf("value must match regular expression `%s`", v.regexp)
+}
+
+// ValidateString performs the validation.
+func (v regexMatchesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if !v.regexp.MatchString(value) {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value Match",
+			v.Description(ctx),
+			value,
+		))
+	}
+}
@@ -0,0 +1,58 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// UTF8LengthBetween returns a validator which ensures that the configured
+// value UTF-8 character count is greater than or equal to the given minimum
+// and less than or equal to the given maximum. Null and unknown values are
+// not validated. Use LengthBetween to validate the number of bytes instead.
+func UTF8LengthBetween(minLength int, maxLength int) validator.String {
+	return utf8LengthBetweenValidator{
+		maxLength: maxLength,
+		minLength: minLength,
+	}
+}
+
+var _ validator.String = utf8LengthBetweenValidator{}
+
+// utf8LengthBetweenValidator implements the validator.
+type utf8LengthBetweenValidator struct {
+	maxLength int
+	minLength int
+}
+
+// Description describes the validation in plain text formatting.
+func (v utf8LengthBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("UTF-8 character count must be between %d and %d", v.minLength, v.maxLength)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v utf8LengthBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v utf8LengthBetweenValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	count := utf8.RuneCountInString(req.ConfigValue.ValueString())
+
+	if count < v.minLength || count > v.maxLength {
+		resp.Diagnostics.Append(schemavalidator.InvalidValueDiagnostic(
+			req.Path,
+			"Invalid Attribute Value Length",
+			v.Description(ctx),
+			strconv.Itoa(count),
+		))
+	}
+}
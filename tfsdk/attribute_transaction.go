@@ -0,0 +1,55 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// AttributeTransaction batches multiple attribute value changes, which can be
+// applied together with the Plan or State type ApplyTransaction method. The
+// changes are applied in the order they were added and either all changes are
+// applied or, if any change returns an error diagnostic, none of them are.
+//
+// The zero value is an empty transaction ready for use.
+type AttributeTransaction struct {
+	changes []attributeTransactionChange
+}
+
+// attributeTransactionChange is a single SetAttribute call within an
+// AttributeTransaction.
+type attributeTransactionChange struct {
+	path path.Path
+	val  interface{}
+}
+
+// SetAttribute adds a change to the transaction which sets the attribute at
+// `path` using the supplied Go value when the transaction is applied. The
+// same path and value rules as the Plan and State type SetAttribute methods
+// apply, however they are not checked until the transaction is applied.
+func (t *AttributeTransaction) SetAttribute(path path.Path, val interface{}) {
+	t.changes = append(t.changes, attributeTransactionChange{
+		path: path,
+		val:  val,
+	})
+}
+
+// Len returns the number of changes in the transaction.
+func (t AttributeTransaction) Len() int {
+	return len(t.changes)
+}
+
+// apply runs all changes against the given data. All changes are attempted so
+// that diagnostics are aggregated across the entire transaction. Callers must
+// discard the resulting data if the diagnostics contain an error.
+func (t AttributeTransaction) apply(ctx context.Context, data *fwschemadata.Data) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, change := range t.changes {
+		diags.Append(data.SetAtPath(ctx, change.path, change.val)...)
+	}
+
+	return diags
+}
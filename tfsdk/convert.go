@@ -11,6 +11,8 @@ import (
 // ConvertValue creates a new attr.Value of the attr.Type `typ`, using the data
 // in `val`, which can be of any attr.Type so long as its TerraformType method
 // returns a tftypes.Type that `typ`'s ValueFromTerraform method can accept.
+// An error diagnostic is returned if the underlying Terraform types differ,
+// such as converting a string value to a number type.
 func ConvertValue(ctx context.Context, val attr.Value, typ attr.Type) (attr.Value, diag.Diagnostics) {
 	newVal, err := val.ToTerraformValue(ctx)
 	if err != nil {
@@ -18,6 +20,15 @@ func ConvertValue(ctx context.Context, val attr.Value, typ attr.Type) (attr.Valu
 			fmt.Sprintf("An unexpected error was encountered converting a %T to a %s. This is always a problem with the provider. Please tell the provider developers that %T ran into the following error during ToTerraformValue: %s", val, typ, val, err),
 		)}
 	}
+	if targetType := typ.TerraformType(ctx); !newVal.Type().Equal(targetType) {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Error converting value",
+			"An unexpected error was encountered converting a value, because its type is not compatible with the target type. "+
+				"Values can only be converted between types with the same underlying Terraform type, such as a string and a custom string type. "+
+				"This is always a problem with the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Value Type: %s (%s)\n", val.Type(ctx), newVal.Type())+
+				fmt.Sprintf("Target Type: %s (%s)", typ, targetType),
+		)}
+	}
 	res, err := typ.ValueFromTerraform(ctx, newVal)
 	if err != nil {
 		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Error converting value",
@@ -30,6 +30,26 @@ func TestConvert(t *testing.T) {
 				CreatedBy:      testtypes.StringType{},
 			},
 		},
+		"string-unknown-to-testtype-string": {
+			val: types.StringUnknown(),
+			typ: testtypes.StringType{},
+			expected: testtypes.String{
+				InternalString: types.StringUnknown(),
+				CreatedBy:      testtypes.StringType{},
+			},
+		},
+		"list-to-set": {
+			val: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("hello")}),
+			typ: types.SetType{ElemType: types.StringType},
+			expectedDiags: diag.Diagnostics{diag.NewErrorDiagnostic(
+				"Error converting value",
+				"An unexpected error was encountered converting a value, because its type is not compatible with the target type. "+
+					"Values can only be converted between types with the same underlying Terraform type, such as a string and a custom string type. "+
+					"This is always a problem with the provider. Please report the following to the provider developer:\n\n"+
+					"Value Type: types.ListType[basetypes.StringType] (tftypes.List[tftypes.String])\n"+
+					"Target Type: types.SetType[basetypes.StringType] (tftypes.Set[tftypes.String])",
+			)},
+		},
 		"testtype-string-to-string": {
 			val: testtypes.String{
 				InternalString: types.StringValue("hello"),
@@ -43,7 +63,11 @@ func TestConvert(t *testing.T) {
 			typ: types.NumberType,
 			expectedDiags: diag.Diagnostics{diag.NewErrorDiagnostic(
 				"Error converting value",
-				"An unexpected error was encountered converting a basetypes.StringValue to a basetypes.NumberType. This is always a problem with the provider. Please tell the provider developers that basetypes.NumberType returned the following error when calling ValueFromTerraform: can't unmarshal tftypes.String into *big.Float, expected *big.Float",
+				"An unexpected error was encountered converting a value, because its type is not compatible with the target type. "+
+					"Values can only be converted between types with the same underlying Terraform type, such as a string and a custom string type. "+
+					"This is always a problem with the provider. Please report the following to the provider developer:\n\n"+
+					"Value Type: basetypes.StringType (tftypes.String)\n"+
+					"Target Type: basetypes.NumberType (tftypes.Number)",
 			)},
 		},
 	}
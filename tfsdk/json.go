@@ -0,0 +1,214 @@
+package tfsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// NewStateFromJSON returns a State for the given schema using a JSON object
+// of attribute values, such as a resource "values" object in the Terraform
+// CLI JSON output format of the terraform show -json command. This enables
+// tooling, such as state analyzers or migration scripts, to read Terraform
+// data with the same schema and types as the provider.
+//
+// Attributes which are missing from the JSON object are null. An error
+// diagnostic is returned if the JSON object contains attributes which are
+// not defined in the schema or values which do not match the schema types.
+func NewStateFromJSON(ctx context.Context, schema fwschema.Schema, values []byte) (State, diag.Diagnostics) {
+	state := State{
+		Schema: schema,
+	}
+
+	raw, diags := jsonValue(ctx, schema, values, nil)
+
+	state.Raw = raw
+
+	return state, diags
+}
+
+// NewPlanFromJSON returns a Plan for the given schema using a JSON object of
+// planned attribute values and an optional JSON object which marks unknown
+// values, such as the resource change "after" and "after_unknown" objects in
+// the Terraform CLI JSON output format of the terraform show -json command.
+// This enables tooling, such as plan analyzers, to read Terraform data with
+// the same schema and types as the provider.
+//
+// Attributes which are missing from the values JSON object are null, unless
+// marked as unknown with a true value in the unknown JSON object. An error
+// diagnostic is returned if the values JSON object contains attributes which
+// are not defined in the schema or values which do not match the schema
+// types.
+func NewPlanFromJSON(ctx context.Context, schema fwschema.Schema, values []byte, unknown []byte) (Plan, diag.Diagnostics) {
+	plan := Plan{
+		Schema: schema,
+	}
+
+	raw, diags := jsonValue(ctx, schema, values, unknown)
+
+	plan.Raw = raw
+
+	return plan, diags
+}
+
+// jsonValue returns the tftypes.Value of the schema type using the JSON
+// values and unknown value marks.
+func jsonValue(ctx context.Context, schema fwschema.Schema, values []byte, unknown []byte) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schemaType := schema.Type().TerraformType(ctx)
+
+	value, err := tftypes.ValueFromJSONWithOpts(values, schemaType, tftypes.ValueFromJSONOpts{})
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Read JSON Data",
+			"An unexpected error was encountered reading the JSON data with the schema. "+
+				"Verify the data was created with the same schema.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return tftypes.NewValue(schemaType, nil), diags
+	}
+
+	if len(unknown) == 0 {
+		return value, diags
+	}
+
+	var unknownMarks any
+
+	if err := json.Unmarshal(unknown, &unknownMarks); err != nil {
+		diags.AddError(
+			"Unable to Read JSON Data",
+			"An unexpected error was encountered reading the JSON unknown value marks.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return tftypes.NewValue(schemaType, nil), diags
+	}
+
+	value, err = jsonMarkUnknown(value, unknownMarks)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Read JSON Data",
+			"An unexpected error was encountered applying the JSON unknown value marks.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return tftypes.NewValue(schemaType, nil), diags
+	}
+
+	return value, diags
+}
+
+// jsonMarkUnknown returns the value with all values marked as unknown. The
+// marks are the decoded JSON, where true marks the value as unknown and
+// objects or arrays mark the underlying attribute or element values.
+func jsonMarkUnknown(value tftypes.Value, marks any) (tftypes.Value, error) {
+	if marks == true {
+		return tftypes.NewValue(value.Type(), tftypes.UnknownValue), nil
+	}
+
+	if value.IsNull() || !value.IsKnown() {
+		return value, nil
+	}
+
+	switch valueType := value.Type().(type) {
+	case tftypes.Object:
+		attributeMarks, ok := marks.(map[string]any)
+
+		if !ok {
+			return value, nil
+		}
+
+		var attributes map[string]tftypes.Value
+
+		if err := value.As(&attributes); err != nil {
+			return value, err
+		}
+
+		for name, attributeMark := range attributeMarks {
+			attribute, ok := attributes[name]
+
+			if !ok {
+				return value, fmt.Errorf("unsupported attribute %q", name)
+			}
+
+			markedAttribute, err := jsonMarkUnknown(attribute, attributeMark)
+
+			if err != nil {
+				return value, fmt.Errorf("%s: %w", name, err)
+			}
+
+			attributes[name] = markedAttribute
+		}
+
+		return tftypes.NewValue(valueType, attributes), nil
+	case tftypes.Map:
+		elementMarks, ok := marks.(map[string]any)
+
+		if !ok {
+			return value, nil
+		}
+
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return value, err
+		}
+
+		for key, elementMark := range elementMarks {
+			element, ok := elements[key]
+
+			if !ok {
+				continue
+			}
+
+			markedElement, err := jsonMarkUnknown(element, elementMark)
+
+			if err != nil {
+				return value, fmt.Errorf("%q: %w", key, err)
+			}
+
+			elements[key] = markedElement
+		}
+
+		return tftypes.NewValue(valueType, elements), nil
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		elementMarks, ok := marks.([]any)
+
+		if !ok {
+			return value, nil
+		}
+
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return value, err
+		}
+
+		for index, elementMark := range elementMarks {
+			if index >= len(elements) {
+				break
+			}
+
+			markedElement, err := jsonMarkUnknown(elements[index], elementMark)
+
+			if err != nil {
+				return value, fmt.Errorf("%d: %w", index, err)
+			}
+
+			elements[index] = markedElement
+		}
+
+		return tftypes.NewValue(valueType, elements), nil
+	default:
+		return value, nil
+	}
+}
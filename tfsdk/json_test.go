@@ -0,0 +1,223 @@
+package tfsdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNewStateFromJSON(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"tags": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"tags": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testCases := map[string]struct {
+		values        string
+		expected      tfsdk.State
+		expectedDiags diag.Diagnostics
+	}{
+		"values": {
+			values: `{"id": "test-id", "tags": ["one", "two"]}`,
+			expected: tfsdk.State{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, "test-id"),
+					"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "one"),
+						tftypes.NewValue(tftypes.String, "two"),
+					}),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"values-missing": {
+			values: `{"id": "test-id"}`,
+			expected: tfsdk.State{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, "test-id"),
+					"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"values-undefined-attribute": {
+			values: `{"id": "test-id", "nonexistent": true}`,
+			expected: tfsdk.State{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Read JSON Data",
+					"An unexpected error was encountered reading the JSON data with the schema. "+
+						"Verify the data was created with the same schema.\n\n"+
+						"Error: AttributeName(\"nonexistent\"): unsupported attribute \"nonexistent\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tfsdk.NewStateFromJSON(context.Background(), testSchema, []byte(testCase.values))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewPlanFromJSON(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"rules": testschema.Attribute{
+				Optional: true,
+				Type: types.ListType{
+					ElemType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"port": types.Int64Type,
+						},
+					},
+				},
+			},
+		},
+	}
+	testRuleType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"port": tftypes.Number,
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":    tftypes.String,
+			"rules": tftypes.List{ElementType: testRuleType},
+		},
+	}
+
+	testCases := map[string]struct {
+		values        string
+		unknown       string
+		expected      tfsdk.Plan
+		expectedDiags diag.Diagnostics
+	}{
+		"no-unknown": {
+			values: `{"id": "test-id", "rules": [{"port": 80}]}`,
+			expected: tfsdk.Plan{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, "test-id"),
+					"rules": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, []tftypes.Value{
+						tftypes.NewValue(testRuleType, map[string]tftypes.Value{
+							"port": tftypes.NewValue(tftypes.Number, 80),
+						}),
+					}),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"unknown-attribute": {
+			values:  `{"rules": [{"port": 80}]}`,
+			unknown: `{"id": true, "rules": [{}]}`,
+			expected: tfsdk.Plan{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"rules": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, []tftypes.Value{
+						tftypes.NewValue(testRuleType, map[string]tftypes.Value{
+							"port": tftypes.NewValue(tftypes.Number, 80),
+						}),
+					}),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"unknown-nested-attribute": {
+			values:  `{"id": "test-id", "rules": [{"port": 80}, {}]}`,
+			unknown: `{"rules": [false, {"port": true}]}`,
+			expected: tfsdk.Plan{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, "test-id"),
+					"rules": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, []tftypes.Value{
+						tftypes.NewValue(testRuleType, map[string]tftypes.Value{
+							"port": tftypes.NewValue(tftypes.Number, 80),
+						}),
+						tftypes.NewValue(testRuleType, map[string]tftypes.Value{
+							"port": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+						}),
+					}),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"unknown-undefined-attribute": {
+			values:  `{"id": "test-id"}`,
+			unknown: `{"nonexistent": true}`,
+			expected: tfsdk.Plan{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Read JSON Data",
+					"An unexpected error was encountered applying the JSON unknown value marks.\n\n"+
+						"Error: unsupported attribute \"nonexistent\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tfsdk.NewPlanFromJSON(context.Background(), testSchema, []byte(testCase.values), []byte(testCase.unknown))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -82,6 +82,23 @@ func (p *Plan) SetAttribute(ctx context.Context, path path.Path, val interface{}
 	return diags
 }
 
+// ApplyTransaction applies all attribute changes in the transaction to the
+// plan. Diagnostics from every change are returned. If any change returns an
+// error diagnostic, the plan is left unmodified, which prevents partially
+// updated plan data from being returned to Terraform.
+func (p *Plan) ApplyTransaction(ctx context.Context, tx AttributeTransaction) diag.Diagnostics {
+	data := p.data()
+	diags := tx.apply(ctx, data)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Raw = data.TerraformValue
+
+	return diags
+}
+
 func (p Plan) data() *fwschemadata.Data {
 	return &fwschemadata.Data{
 		Description:    fwschemadata.DataDescriptionPlan,
@@ -93,6 +93,23 @@ func (s *State) SetAttribute(ctx context.Context, path path.Path, val interface{
 	return diags
 }
 
+// ApplyTransaction applies all attribute changes in the transaction to the
+// state. Diagnostics from every change are returned. If any change returns an
+// error diagnostic, the state is left unmodified, which prevents partially
+// updated state data from being returned to Terraform.
+func (s *State) ApplyTransaction(ctx context.Context, tx AttributeTransaction) diag.Diagnostics {
+	data := s.data()
+	diags := tx.apply(ctx, &data)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	s.Raw = data.TerraformValue
+
+	return diags
+}
+
 // RemoveResource removes the entire resource from state.
 //
 // If a Resource type Delete method is completed without error, this is
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -389,3 +390,141 @@ func TestStateSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestStateApplyTransaction(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":  tftypes.String,
+			"other": tftypes.String,
+			"test":  tftypes.String,
+		},
+	}
+
+	testRaw := tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+		"name":  tftypes.NewValue(tftypes.String, "originalname"),
+		"other": tftypes.NewValue(tftypes.String, "should be untouched"),
+		"test":  tftypes.NewValue(tftypes.String, "originalvalue"),
+	})
+
+	testSchema := func(nameType attr.Type) testschema.Schema {
+		return testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"name": testschema.Attribute{
+					Type:     nameType,
+					Required: true,
+				},
+				"other": testschema.Attribute{
+					Type:     types.StringType,
+					Required: true,
+				},
+				"test": testschema.Attribute{
+					Type:     types.StringType,
+					Required: true,
+				},
+			},
+		}
+	}
+
+	type testCase struct {
+		state         tfsdk.State
+		tx            func() tfsdk.AttributeTransaction
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataSetAtPath for more exhaustive unit
+		// testing. These test cases are to ensure all transaction changes are
+		// applied together.
+		"empty": {
+			state: tfsdk.State{
+				Raw:    testRaw,
+				Schema: testSchema(types.StringType),
+			},
+			tx: func() tfsdk.AttributeTransaction {
+				return tfsdk.AttributeTransaction{}
+			},
+			expected: testRaw,
+		},
+		"valid": {
+			state: tfsdk.State{
+				Raw:    testRaw,
+				Schema: testSchema(types.StringType),
+			},
+			tx: func() tfsdk.AttributeTransaction {
+				var tx tfsdk.AttributeTransaction
+
+				tx.SetAttribute(path.Root("name"), "newname")
+				tx.SetAttribute(path.Root("test"), "newvalue")
+
+				return tx
+			},
+			expected: tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, "newname"),
+				"other": tftypes.NewValue(tftypes.String, "should be untouched"),
+				"test":  tftypes.NewValue(tftypes.String, "newvalue"),
+			}),
+		},
+		"warning-diagnostics": {
+			state: tfsdk.State{
+				Raw:    testRaw,
+				Schema: testSchema(testtypes.StringTypeWithValidateWarning{}),
+			},
+			tx: func() tfsdk.AttributeTransaction {
+				var tx tfsdk.AttributeTransaction
+
+				tx.SetAttribute(path.Root("name"), "newname")
+				tx.SetAttribute(path.Root("test"), "newvalue")
+
+				return tx
+			},
+			expected: tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, "newname"),
+				"other": tftypes.NewValue(tftypes.String, "should be untouched"),
+				"test":  tftypes.NewValue(tftypes.String, "newvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				testtypes.TestWarningDiagnostic(path.Root("name")),
+			},
+		},
+		"error-diagnostics": {
+			state: tfsdk.State{
+				Raw:    testRaw,
+				Schema: testSchema(testtypes.StringTypeWithValidateError{}),
+			},
+			tx: func() tfsdk.AttributeTransaction {
+				var tx tfsdk.AttributeTransaction
+
+				tx.SetAttribute(path.Root("test"), "newvalue")
+				tx.SetAttribute(path.Root("name"), "newname")
+				tx.SetAttribute(path.Root("other"), "newother")
+				tx.SetAttribute(path.Root("name"), "anothername")
+
+				return tx
+			},
+			expected: testRaw,
+			expectedDiags: diag.Diagnostics{
+				testtypes.TestErrorDiagnostic(path.Root("name")),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.state.ApplyTransaction(context.Background(), tc.tx())
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package base64types
+
+import (
+	"encoding/base64"
+	"strings"
+	"unicode"
+)
+
+// decodeBase64 decodes the given standard base64 encoded string. Whitespace,
+// such as line wrapping, and padding characters are ignored.
+func decodeBase64(value string) ([]byte, error) {
+	normalized := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+
+		return r
+	}, value)
+
+	return base64.RawStdEncoding.DecodeString(strings.TrimRight(normalized, "="))
+}
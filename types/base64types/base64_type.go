@@ -0,0 +1,133 @@
+package base64types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = Base64Type{}
+	_ xattr.TypeWithValidate  = Base64Type{}
+)
+
+// Base64Type is an attribute type that represents a valid standard base64
+// encoded string, as defined by RFC 4648. Semantic equality logic is defined
+// for Base64Type such that encodings of the same binary content, which differ
+// only in padding or whitespace such as line wrapping, are considered equal.
+// Base64 is the associated value type.
+type Base64Type struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t Base64Type) String() string {
+	return "base64types.Base64Type"
+}
+
+// ValueType returns the Value type.
+func (t Base64Type) ValueType(ctx context.Context) attr.Value {
+	return Base64{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t Base64Type) Equal(o attr.Type) bool {
+	other, ok := o.(Base64Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// Validate implements type validation. This type requires the value provided
+// to be a String value that is a valid base64 encoding.
+func (t Base64Type) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Is(tftypes.String) {
+		err := fmt.Errorf("expected String value, received %T with value: %v", in, in)
+		diags.AddAttributeError(
+			path,
+			"Base64 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var valueString string
+
+	if err := in.As(&valueString); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Base64 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	if _, err := decodeBase64(valueString); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Base64 String Value",
+			"A string value was provided that is not valid base64 encoding (RFC 4648).\n\n"+
+				"Given Value: "+valueString+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	return diags
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t Base64Type) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Base64{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t Base64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
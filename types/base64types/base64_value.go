@@ -0,0 +1,148 @@
+package base64types
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = Base64{}
+	_ basetypes.StringValuableWithSemanticEquals = Base64{}
+)
+
+// Base64 represents a valid standard base64 encoded string. Access the
+// decoded binary content via the ValueBytes method.
+type Base64 struct {
+	basetypes.StringValue
+}
+
+// Type returns a Base64Type.
+func (v Base64) Type(_ context.Context) attr.Type {
+	return Base64Type{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Base64) Equal(o attr.Value) bool {
+	other, ok := o.(Base64)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given base64 string value encodes
+// the same binary content as the current value, regardless of padding or
+// whitespace, such as line wrapping.
+func (v Base64) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Base64)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorBytes, err := decodeBase64(v.ValueString())
+
+	// Invalid values are never semantically equal.
+	if err != nil {
+		return false, diags
+	}
+
+	newBytes, err := decodeBase64(newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return bytes.Equal(priorBytes, newBytes), diags
+}
+
+// ValueBytes returns the decoded binary content of the base64 string value.
+// An error diagnostic is returned if the value is null, unknown, or not valid
+// base64 encoding.
+func (v Base64) ValueBytes() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError(
+			"Base64 ValueBytes Error",
+			"A base64 string value cannot be decoded to []byte, as the value is null.",
+		)
+
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError(
+			"Base64 ValueBytes Error",
+			"A base64 string value cannot be decoded to []byte, as the value is unknown.",
+		)
+
+		return nil, diags
+	}
+
+	decoded, err := decodeBase64(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Base64 ValueBytes Error",
+			"A base64 string value cannot be decoded to []byte.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return decoded, diags
+}
+
+// NewBase64Null creates a Base64 with a null value. Determine whether the
+// value is null via the Base64 type IsNull method.
+func NewBase64Null() Base64 {
+	return Base64{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewBase64Unknown creates a Base64 with an unknown value. Determine whether
+// the value is unknown via the Base64 type IsUnknown method.
+func NewBase64Unknown() Base64 {
+	return Base64{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewBase64Value creates a Base64 with a known value. Access the value via the
+// Base64 type ValueString method. The value is validated when the associated
+// attribute value is validated.
+func NewBase64Value(value string) Base64 {
+	return Base64{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewBase64BytesValue creates a Base64 with a known value from the given
+// binary content, encoded with padded standard base64 encoding.
+func NewBase64BytesValue(value []byte) Base64 {
+	return Base64{
+		StringValue: basetypes.NewStringValue(base64.StdEncoding.EncodeToString(value)),
+	}
+}
@@ -0,0 +1,157 @@
+package base64types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/base64types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestBase64StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current       base64types.Base64
+		given         basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact-match": {
+			current:       base64types.NewBase64Value("dGVzdA=="),
+			given:         base64types.NewBase64Value("dGVzdA=="),
+			expectedMatch: true,
+		},
+		"padding": {
+			current:       base64types.NewBase64Value("dGVzdA=="),
+			given:         base64types.NewBase64Value("dGVzdA"),
+			expectedMatch: true,
+		},
+		"line-wrapping": {
+			current:       base64types.NewBase64Value("dGVzdCB2YWx1ZQ=="),
+			given:         base64types.NewBase64Value("dGVzdCB2\nYWx1ZQ==\n"),
+			expectedMatch: true,
+		},
+		"different-content": {
+			current:       base64types.NewBase64Value("dGVzdA=="),
+			given:         base64types.NewBase64Value("b3RoZXI="),
+			expectedMatch: false,
+		},
+		"invalid": {
+			current:       base64types.NewBase64Value("not-base64!"),
+			given:         base64types.NewBase64Value("not-base64!"),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			current:       base64types.NewBase64Value("dGVzdA=="),
+			given:         basetypes.NewStringValue("dGVzdA=="),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: base64types.Base64\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBase64ValueBytes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         base64types.Base64
+		expected      []byte
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			value:    base64types.NewBase64Value("dGVzdA=="),
+			expected: []byte("test"),
+		},
+		"value-wrapped": {
+			value:    base64types.NewBase64Value("dGVz\r\ndA=="),
+			expected: []byte("test"),
+		},
+		"null": {
+			value: base64types.NewBase64Null(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Base64 ValueBytes Error",
+					"A base64 string value cannot be decoded to []byte, as the value is null.",
+				),
+			},
+		},
+		"unknown": {
+			value: base64types.NewBase64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Base64 ValueBytes Error",
+					"A base64 string value cannot be decoded to []byte, as the value is unknown.",
+				),
+			},
+		},
+		"invalid": {
+			value: base64types.NewBase64Value("not-base64!"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Base64 ValueBytes Error",
+					"A base64 string value cannot be decoded to []byte.\n\n"+
+						"Given Value: not-base64!\n"+
+						"Error: illegal base64 data at input byte 3",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueBytes()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewBase64BytesValue(t *testing.T) {
+	t.Parallel()
+
+	got := base64types.NewBase64BytesValue([]byte("test"))
+	expected := base64types.NewBase64Value("dGVzdA==")
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected value difference: %s", diff)
+	}
+}
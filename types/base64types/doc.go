@@ -0,0 +1,5 @@
+// Package base64types contains custom attribute types and values for base64
+// encoded binary data, such as certificates and key material. These types
+// build on the types/basetypes package and can be used with the CustomType
+// field of schema attributes.
+package base64types
@@ -0,0 +1,29 @@
+package basetypes
+
+// copySlice returns a shallow copy of the slice, which prevents callers from
+// mutating the underlying data of values. A nil slice returns nil.
+func copySlice[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+
+	result := make([]T, 0, len(s))
+
+	return append(result, s...)
+}
+
+// copyMap returns a shallow copy of the map, which prevents callers from
+// mutating the underlying data of values. A nil map returns nil.
+func copyMap[K comparable, V any](m map[K]V) map[K]V {
+	if m == nil {
+		return nil
+	}
+
+	result := make(map[K]V, len(m))
+
+	for k, v := range m {
+		result[k] = v
+	}
+
+	return result
+}
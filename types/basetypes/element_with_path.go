@@ -0,0 +1,19 @@
+package basetypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ElementWithPath is a collection element value along with its path, as
+// returned by the ListValue, MapValue, and SetValue type ElementsWithPaths
+// methods. The path can be used to report diagnostics against the exact
+// element, such as in validators and plan modifiers.
+type ElementWithPath struct {
+	// Path is the path of the element, which is the given base path with the
+	// element step appended.
+	Path path.Path
+
+	// Value is the element value.
+	Value attr.Value
+}
@@ -0,0 +1,93 @@
+package basetypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// EqualOptions are options for the ListValue, MapValue, and SetValue type
+// EqualWithOptions methods. The zero value has the same behavior as the
+// Equal method. Options only apply to the collection itself, element values
+// are always compared with their Equal method.
+type EqualOptions struct {
+	// IgnoreNullEmpty treats a null collection as equal to a known
+	// collection without elements. Unknown collections are still only
+	// equal to other unknown collections.
+	IgnoreNullEmpty bool
+
+	// IgnoreOrder compares list elements regardless of their position. Each
+	// element must match a distinct element in the other list, so the number
+	// of duplicate elements is still compared. Set and map values are always
+	// compared regardless of order.
+	IgnoreOrder bool
+
+	// Subset returns true when all elements of the receiver are contained in
+	// the other collection, rather than requiring both collections to have
+	// the same elements. List elements must appear in the same relative order
+	// in the other list, unless IgnoreOrder is also enabled. Map elements
+	// must have the same key and an equal value in the other map.
+	Subset bool
+}
+
+// valueState returns the collection value state to compare, which treats
+// null collections as known collections without elements when
+// IgnoreNullEmpty is enabled.
+func (o EqualOptions) valueState(state attr.ValueState) attr.ValueState {
+	if o.IgnoreNullEmpty && state == attr.ValueStateNull {
+		return attr.ValueStateKnown
+	}
+
+	return state
+}
+
+// equalElements returns true if the elements are equal to the other elements
+// with the given options.
+func (o EqualOptions) equalElements(elements []attr.Value, other []attr.Value) bool {
+	if len(elements) > len(other) {
+		return false
+	}
+
+	if !o.Subset && len(elements) != len(other) {
+		return false
+	}
+
+	if !o.IgnoreOrder {
+		otherIdx := 0
+
+		for _, elem := range elements {
+			for otherIdx < len(other) && !elem.Equal(other[otherIdx]) {
+				otherIdx++
+			}
+
+			if otherIdx == len(other) {
+				return false
+			}
+
+			otherIdx++
+		}
+
+		return true
+	}
+
+	matched := make([]bool, len(other))
+
+	for _, elem := range elements {
+		found := false
+
+		for otherIdx, otherElem := range other {
+			if matched[otherIdx] || !elem.Equal(otherElem) {
+				continue
+			}
+
+			matched[otherIdx] = true
+			found = true
+
+			break
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -233,7 +233,7 @@ func NewListValue(elementType attr.Type, elements []attr.Value) (ListValue, diag
 
 	return ListValue{
 		elementType: elementType,
-		elements:    elements,
+		elements:    copySlice(elements),
 		state:       attr.ValueStateKnown,
 	}, nil
 }
@@ -309,10 +309,30 @@ type ListValue struct {
 	state attr.ValueState
 }
 
-// Elements returns the collection of elements for the List. Returns nil if the
-// List is null or unknown.
+// Elements returns a copy of the collection of elements for the List. Returns
+// nil if the List is null or unknown.
 func (l ListValue) Elements() []attr.Value {
-	return l.elements
+	return copySlice(l.elements)
+}
+
+// ElementsWithPaths returns the elements for the List along with their paths,
+// which are the given base path, typically the path of the List, with the
+// list index appended. Returns nil if the List is null or unknown.
+func (l ListValue) ElementsWithPaths(basePath path.Path) []ElementWithPath {
+	if l.elements == nil {
+		return nil
+	}
+
+	result := make([]ElementWithPath, 0, len(l.elements))
+
+	for index, element := range l.elements {
+		result = append(result, ElementWithPath{
+			Path:  basePath.AtListIndex(index),
+			Value: element,
+		})
+	}
+
+	return result
 }
 
 // ElementsAs populates `target` with the elements of the ListValue, throwing an
@@ -413,6 +433,32 @@ func (l ListValue) Equal(o attr.Value) bool {
 	return true
 }
 
+// EqualWithOptions returns true if the List is considered semantically
+// equal (same type and same value) to the attr.Value passed as an argument,
+// with the comparison adjusted by the given options. Refer to the
+// EqualOptions type for details about each option.
+func (l ListValue) EqualWithOptions(o attr.Value, opts EqualOptions) bool {
+	other, ok := o.(ListValue)
+
+	if !ok {
+		return false
+	}
+
+	if !l.elementType.Equal(other.elementType) {
+		return false
+	}
+
+	if opts.valueState(l.state) != opts.valueState(other.state) {
+		return false
+	}
+
+	if opts.valueState(l.state) != attr.ValueStateKnown {
+		return true
+	}
+
+	return opts.equalElements(l.elements, other.elements)
+}
+
 // IsNull returns true if the List represents a null value.
 func (l ListValue) IsNull() bool {
 	return l.state == attr.ValueStateNull
@@ -440,7 +486,7 @@ func (l ListValue) String() string {
 	var res strings.Builder
 
 	res.WriteString("[")
-	for i, e := range l.Elements() {
+	for i, e := range l.elements {
 		if i != 0 {
 			res.WriteString(",")
 		}
@@ -824,6 +824,257 @@ func TestListValueEqual(t *testing.T) {
 	}
 }
 
+func TestListValueEqualWithOptions(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver ListValue
+		input    attr.Value
+		opts     EqualOptions
+		expected bool
+	}
+	tests := map[string]testCase{
+		"known-known-no-options": {
+			receiver: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			opts:     EqualOptions{},
+			expected: true,
+		},
+		"known-known-diff-order-no-options": {
+			receiver: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("b"),
+					NewStringValue("a"),
+				},
+			),
+			opts:     EqualOptions{},
+			expected: false,
+		},
+		"known-known-diff-order-ignore-order": {
+			receiver: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("b"),
+					NewStringValue("a"),
+				},
+			),
+			opts:     EqualOptions{IgnoreOrder: true},
+			expected: true,
+		},
+		"known-known-diff-duplicates-ignore-order": {
+			receiver: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringValue("b"),
+				},
+			),
+			opts:     EqualOptions{IgnoreOrder: true},
+			expected: false,
+		},
+		"null-empty-no-options": {
+			receiver: NewListNull(StringType{}),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{},
+			),
+			opts:     EqualOptions{},
+			expected: false,
+		},
+		"null-empty-ignore-null-empty": {
+			receiver: NewListNull(StringType{}),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{},
+			),
+			opts:     EqualOptions{IgnoreNullEmpty: true},
+			expected: true,
+		},
+		"empty-null-ignore-null-empty": {
+			receiver: NewListValueMust(
+				StringType{},
+				[]attr.Value{},
+			),
+			input:    NewListNull(StringType{}),
+			opts:     EqualOptions{IgnoreNullEmpty: true},
+			expected: true,
+		},
+		"null-known-ignore-null-empty": {
+			receiver: NewListNull(StringType{}),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			opts:     EqualOptions{IgnoreNullEmpty: true},
+			expected: false,
+		},
+		"null-unknown-ignore-null-empty": {
+			receiver: NewListNull(StringType{}),
+			input:    NewListUnknown(StringType{}),
+			opts:     EqualOptions{IgnoreNullEmpty: true},
+			expected: false,
+		},
+		"unknown-unknown-subset": {
+			receiver: NewListUnknown(StringType{}),
+			input:    NewListUnknown(StringType{}),
+			opts:     EqualOptions{Subset: true},
+			expected: true,
+		},
+		"known-known-subset": {
+			receiver: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("c"),
+				},
+			),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringValue("c"),
+				},
+			),
+			opts:     EqualOptions{Subset: true},
+			expected: true,
+		},
+		"known-known-subset-diff-order": {
+			receiver: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("c"),
+					NewStringValue("a"),
+				},
+			),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringValue("c"),
+				},
+			),
+			opts:     EqualOptions{Subset: true},
+			expected: false,
+		},
+		"known-known-subset-diff-order-ignore-order": {
+			receiver: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("c"),
+					NewStringValue("a"),
+				},
+			),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringValue("c"),
+				},
+			),
+			opts:     EqualOptions{Subset: true, IgnoreOrder: true},
+			expected: true,
+		},
+		"known-known-superset": {
+			receiver: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringValue("c"),
+				},
+			),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("c"),
+				},
+			),
+			opts:     EqualOptions{Subset: true},
+			expected: false,
+		},
+		"null-known-subset-ignore-null-empty": {
+			receiver: NewListNull(StringType{}),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			opts:     EqualOptions{IgnoreNullEmpty: true, Subset: true},
+			expected: true,
+		},
+		"wrong-type": {
+			receiver: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			opts:     EqualOptions{IgnoreOrder: true},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.receiver.EqualWithOptions(test.input, test.opts)
+			if got != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
 func TestListValueIsNull(t *testing.T) {
 	t.Parallel()
 
@@ -1089,3 +1340,68 @@ func TestListTypeValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestListValueElements_Immutable(t *testing.T) {
+	t.Parallel()
+
+	elements := []attr.Value{NewStringValue("original")}
+	list := NewListValueMust(StringType{}, elements)
+
+	elements[0] = NewStringValue("modified-input")
+	list.Elements()[0] = NewStringValue("modified-output")
+
+	expected := []attr.Value{NewStringValue("original")}
+
+	if diff := cmp.Diff(list.Elements(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestListValueElementsWithPaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ListValue
+		expected []ElementWithPath
+	}{
+		"known": {
+			input: NewListValueMust(StringType{}, []attr.Value{NewStringValue("first"), NewStringValue("second")}),
+			expected: []ElementWithPath{
+				{
+					Path:  path.Root("test").AtListIndex(0),
+					Value: NewStringValue("first"),
+				},
+				{
+					Path:  path.Root("test").AtListIndex(1),
+					Value: NewStringValue("second"),
+				},
+			},
+		},
+		"known-empty": {
+			input:    NewListValueMust(StringType{}, []attr.Value{}),
+			expected: []ElementWithPath{},
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ElementsWithPaths(path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
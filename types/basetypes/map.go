@@ -237,7 +237,7 @@ func NewMapValue(elementType attr.Type, elements map[string]attr.Value) (MapValu
 
 	return MapValue{
 		elementType: elementType,
-		elements:    elements,
+		elements:    copyMap(elements),
 		state:       attr.ValueStateKnown,
 	}, nil
 }
@@ -314,14 +314,48 @@ type MapValue struct {
 	state attr.ValueState
 }
 
-// Elements returns the mapping of elements for the Map. Returns nil if the
-// Map is null or unknown.
+// Elements returns a copy of the mapping of elements for the Map. Returns nil
+// if the Map is null or unknown.
 func (m MapValue) Elements() map[string]attr.Value {
-	return m.elements
+	return copyMap(m.elements)
+}
+
+// ElementsWithPaths returns the elements for the Map along with their paths,
+// which are the given base path, typically the path of the Map, with the map
+// key appended. Elements are sorted by key for consistent ordering. Returns
+// nil if the Map is null or unknown.
+func (m MapValue) ElementsWithPaths(basePath path.Path) []ElementWithPath {
+	if m.elements == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(m.elements))
+
+	for key := range m.elements {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	result := make([]ElementWithPath, 0, len(keys))
+
+	for _, key := range keys {
+		result = append(result, ElementWithPath{
+			Path:  basePath.AtMapKey(key),
+			Value: m.elements[key],
+		})
+	}
+
+	return result
 }
 
 // ElementsAs populates `target` with the elements of the MapValue, throwing an
-// error if the elements cannot be stored in `target`.
+// error if the elements cannot be stored in `target`. The target is typically
+// a pointer to a Go map with string keys, such as map[string]string,
+// map[string]attr.Value, or a map of structs with tfsdk field tags. If
+// allowUnhandled is true, null and unknown elements which the target element
+// type cannot represent are set to the Go zero value instead of returning an
+// error.
 func (m MapValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
 	// we need a tftypes.Value for this Map to be able to use it with our
 	// reflection code
@@ -420,6 +454,48 @@ func (m MapValue) Equal(o attr.Value) bool {
 	return true
 }
 
+// EqualWithOptions returns true if the Map is considered semantically
+// equal (same type and same value) to the attr.Value passed as an argument,
+// with the comparison adjusted by the given options. Refer to the
+// EqualOptions type for details about each option.
+func (m MapValue) EqualWithOptions(o attr.Value, opts EqualOptions) bool {
+	other, ok := o.(MapValue)
+
+	if !ok {
+		return false
+	}
+
+	if !m.elementType.Equal(other.elementType) {
+		return false
+	}
+
+	if opts.valueState(m.state) != opts.valueState(other.state) {
+		return false
+	}
+
+	if opts.valueState(m.state) != attr.ValueStateKnown {
+		return true
+	}
+
+	if len(m.elements) > len(other.elements) {
+		return false
+	}
+
+	if !opts.Subset && len(m.elements) != len(other.elements) {
+		return false
+	}
+
+	for key, mElem := range m.elements {
+		otherElem, ok := other.elements[key]
+
+		if !ok || !mElem.Equal(otherElem) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // IsNull returns true if the Map represents a null value.
 func (m MapValue) IsNull() bool {
 	return m.state == attr.ValueStateNull
@@ -445,8 +521,8 @@ func (m MapValue) String() string {
 	}
 
 	// We want the output to be consistent, so we sort the output by key
-	keys := make([]string, 0, len(m.Elements()))
-	for k := range m.Elements() {
+	keys := make([]string, 0, len(m.elements))
+	for k := range m.elements {
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
@@ -458,7 +534,7 @@ func (m MapValue) String() string {
 		if i != 0 {
 			res.WriteString(",")
 		}
-		res.WriteString(fmt.Sprintf("%q:%s", k, m.Elements()[k].String()))
+		res.WriteString(fmt.Sprintf("%q:%s", k, m.elements[k].String()))
 	}
 	res.WriteString("}")
 
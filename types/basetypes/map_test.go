@@ -470,6 +470,145 @@ func TestMapElementsAs_mapStringAttributeValue(t *testing.T) {
 	}
 }
 
+func TestMapElementsAs_mapStringGenericAttributeValue(t *testing.T) {
+	t.Parallel()
+
+	var target map[string]attr.Value
+	expected := map[string]attr.Value{
+		"h": NewStringValue("hello"),
+		"w": NewStringValue("world"),
+	}
+
+	diags := NewMapValueMust(
+		StringType{},
+		map[string]attr.Value{
+			"h": NewStringValue("hello"),
+			"w": NewStringValue("world"),
+		},
+	).ElementsAs(context.Background(), &target, false)
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	if diff := cmp.Diff(target, expected); diff != "" {
+		t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+	}
+}
+
+func TestMapElementsAs_mapStringStruct(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name  string      `tfsdk:"name"`
+		Count Int64Value  `tfsdk:"count"`
+		Tags  []string    `tfsdk:"tags"`
+		Extra StringValue `tfsdk:"extra"`
+	}
+
+	elementType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  StringType{},
+			"count": Int64Type{},
+			"tags":  ListType{ElemType: StringType{}},
+			"extra": StringType{},
+		},
+	}
+
+	var target map[string]testStruct
+	expected := map[string]testStruct{
+		"first": {
+			Name:  "one",
+			Count: NewInt64Value(1),
+			Tags:  []string{"a", "b"},
+			Extra: NewStringNull(),
+		},
+		"second": {
+			Name:  "two",
+			Count: NewInt64Unknown(),
+			Tags:  []string{},
+			Extra: NewStringValue("extra"),
+		},
+	}
+
+	diags := NewMapValueMust(
+		elementType,
+		map[string]attr.Value{
+			"first": NewObjectValueMust(
+				elementType.AttrTypes,
+				map[string]attr.Value{
+					"name":  NewStringValue("one"),
+					"count": NewInt64Value(1),
+					"tags":  NewListValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+					"extra": NewStringNull(),
+				},
+			),
+			"second": NewObjectValueMust(
+				elementType.AttrTypes,
+				map[string]attr.Value{
+					"name":  NewStringValue("two"),
+					"count": NewInt64Unknown(),
+					"tags":  NewListValueMust(StringType{}, []attr.Value{}),
+					"extra": NewStringValue("extra"),
+				},
+			),
+		},
+	).ElementsAs(context.Background(), &target, false)
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	if diff := cmp.Diff(target, expected); diff != "" {
+		t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+	}
+}
+
+func TestMapElementsAs_unhandledNullAndUnknown(t *testing.T) {
+	t.Parallel()
+
+	value := NewMapValueMust(
+		StringType{},
+		map[string]attr.Value{
+			"h": NewStringValue("hello"),
+			"n": NewStringNull(),
+			"u": NewStringUnknown(),
+		},
+	)
+
+	var target map[string]string
+
+	diags := value.ElementsAs(context.Background(), &target, false)
+	if !diags.HasError() {
+		t.Errorf("Expected error for unhandled null and unknown elements, got none")
+	}
+
+	var allowedTarget map[string]string
+	expected := map[string]string{
+		"h": "hello",
+		"n": "",
+		"u": "",
+	}
+
+	diags = value.ElementsAs(context.Background(), &allowedTarget, true)
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	if diff := cmp.Diff(allowedTarget, expected); diff != "" {
+		t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+	}
+}
+
+func TestMapElementsAs_nullMap(t *testing.T) {
+	t.Parallel()
+
+	target := map[string]string{"existing": "value"}
+
+	diags := NewMapNull(StringType{}).ElementsAs(context.Background(), &target, false)
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	if target != nil {
+		t.Errorf("Expected nil map, got: %v", target)
+	}
+}
+
 func TestMapValueToTerraformValue(t *testing.T) {
 	t.Parallel()
 
@@ -806,6 +945,140 @@ func TestMapValueEqual(t *testing.T) {
 	}
 }
 
+func TestMapValueEqualWithOptions(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver MapValue
+		input    attr.Value
+		opts     EqualOptions
+		expected bool
+	}
+	tests := map[string]testCase{
+		"known-known-no-options": {
+			receiver: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("1"),
+					"b": NewStringValue("2"),
+				},
+			),
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"b": NewStringValue("2"),
+					"a": NewStringValue("1"),
+				},
+			),
+			opts:     EqualOptions{},
+			expected: true,
+		},
+		"null-empty-no-options": {
+			receiver: NewMapNull(StringType{}),
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{},
+			),
+			opts:     EqualOptions{},
+			expected: false,
+		},
+		"null-empty-ignore-null-empty": {
+			receiver: NewMapNull(StringType{}),
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{},
+			),
+			opts:     EqualOptions{IgnoreNullEmpty: true},
+			expected: true,
+		},
+		"null-unknown-ignore-null-empty": {
+			receiver: NewMapNull(StringType{}),
+			input:    NewMapUnknown(StringType{}),
+			opts:     EqualOptions{IgnoreNullEmpty: true},
+			expected: false,
+		},
+		"known-known-subset": {
+			receiver: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("1"),
+				},
+			),
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("1"),
+					"b": NewStringValue("2"),
+				},
+			),
+			opts:     EqualOptions{Subset: true},
+			expected: true,
+		},
+		"known-known-subset-diff-value": {
+			receiver: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("2"),
+				},
+			),
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("1"),
+					"b": NewStringValue("2"),
+				},
+			),
+			opts:     EqualOptions{Subset: true},
+			expected: false,
+		},
+		"known-known-subset-diff-key": {
+			receiver: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"c": NewStringValue("1"),
+				},
+			),
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("1"),
+					"b": NewStringValue("2"),
+				},
+			),
+			opts:     EqualOptions{Subset: true},
+			expected: false,
+		},
+		"known-known-superset": {
+			receiver: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("1"),
+					"b": NewStringValue("2"),
+				},
+			),
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("1"),
+				},
+			),
+			opts:     EqualOptions{Subset: true},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.receiver.EqualWithOptions(test.input, test.opts)
+			if got != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
 func TestMapValueIsNull(t *testing.T) {
 	t.Parallel()
 
@@ -1083,3 +1356,68 @@ func TestMapTypeValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestMapValueElements_Immutable(t *testing.T) {
+	t.Parallel()
+
+	elements := map[string]attr.Value{"key": NewStringValue("original")}
+	m := NewMapValueMust(StringType{}, elements)
+
+	elements["key"] = NewStringValue("modified-input")
+	m.Elements()["key"] = NewStringValue("modified-output")
+
+	expected := map[string]attr.Value{"key": NewStringValue("original")}
+
+	if diff := cmp.Diff(m.Elements(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestMapValueElementsWithPaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    MapValue
+		expected []ElementWithPath
+	}{
+		"known": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{"b": NewStringValue("second"), "a": NewStringValue("first")}),
+			expected: []ElementWithPath{
+				{
+					Path:  path.Root("test").AtMapKey("a"),
+					Value: NewStringValue("first"),
+				},
+				{
+					Path:  path.Root("test").AtMapKey("b"),
+					Value: NewStringValue("second"),
+				},
+			},
+		},
+		"known-empty": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			expected: []ElementWithPath{},
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NewMapUnknown(StringType{}),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ElementsWithPaths(path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -53,6 +54,38 @@ func NewNumberValue(value *big.Float) NumberValue {
 	}
 }
 
+// NewNumberInt64Value creates a Number with a known value from the given
+// int64. Access the value via the Number type ValueInt64 method.
+func NewNumberInt64Value(value int64) NumberValue {
+	return NumberValue{
+		state: attr.ValueStateKnown,
+		value: new(big.Float).SetInt64(value),
+	}
+}
+
+// NewNumberFloat64Value creates a Number with a known value from the given
+// float64. Access the value via the Number type ValueFloat64 method. An error
+// diagnostic is returned and an unknown Number is created if the value is NaN
+// or infinite, since those cannot be represented in Terraform.
+func NewNumberFloat64Value(value float64) (NumberValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		diags.AddError(
+			"Number Conversion Error",
+			fmt.Sprintf("A float64 value of %g cannot be represented as a Number. ", value)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return NewNumberUnknown(), diags
+	}
+
+	return NumberValue{
+		state: attr.ValueStateKnown,
+		value: big.NewFloat(value),
+	}, diags
+}
+
 // NumberValue represents a number value, exposed as a *big.Float. Numbers can be
 // floats or integers.
 type NumberValue struct {
@@ -141,6 +174,74 @@ func (n NumberValue) ValueBigFloat() *big.Float {
 	return n.value
 }
 
+// ValueInt64 returns the known value as an int64. If Number is null or
+// unknown, returns 0. An error diagnostic is returned if the value is not an
+// integer or overflows int64, since it cannot be represented without loss.
+func (n NumberValue) ValueInt64() (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if n.state != attr.ValueStateKnown || n.value == nil {
+		return 0, diags
+	}
+
+	if !n.value.IsInt() {
+		diags.AddError(
+			"Number Conversion Error",
+			"A Number value of "+n.value.String()+" cannot be converted to int64 without loss, as it is not an integer.",
+		)
+
+		return 0, diags
+	}
+
+	result, accuracy := n.value.Int64()
+
+	if accuracy != big.Exact {
+		diags.AddError(
+			"Number Conversion Error",
+			"A Number value of "+n.value.String()+" cannot be converted to int64 without loss, as it overflows int64.",
+		)
+
+		return 0, diags
+	}
+
+	return result, diags
+}
+
+// ValueFloat64 returns the known value as a float64. If Number is null or
+// unknown, returns 0.0. Values are rounded to the nearest float64, such as
+// for decimal fractions. An error diagnostic is returned if the value
+// overflows or underflows float64, since it cannot be represented without
+// loss.
+func (n NumberValue) ValueFloat64() (float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if n.state != attr.ValueStateKnown || n.value == nil {
+		return 0, diags
+	}
+
+	result, _ := n.value.Float64()
+
+	if math.IsInf(result, 0) && !n.value.IsInf() {
+		diags.AddError(
+			"Number Conversion Error",
+			"A Number value of "+n.value.String()+" cannot be converted to float64 without loss, as it overflows float64.",
+		)
+
+		return 0, diags
+	}
+
+	if result == 0 && n.value.Sign() != 0 {
+		diags.AddError(
+			"Number Conversion Error",
+			"A Number value of "+n.value.String()+" cannot be converted to float64 without loss, as it underflows float64.",
+		)
+
+		return 0, diags
+	}
+
+	return result, diags
+}
+
 // ToNumberValue returns Number.
 func (n NumberValue) ToNumberValue(context.Context) (NumberValue, diag.Diagnostics) {
 	return n, nil
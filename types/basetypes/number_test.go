@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -415,3 +416,232 @@ func TestNumberValueValueBigFloat(t *testing.T) {
 		})
 	}
 }
+
+func TestNewNumberInt64Value(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    int64
+		expected NumberValue
+	}{
+		"zero": {
+			input:    0,
+			expected: NewNumberValue(big.NewFloat(0)),
+		},
+		"max": {
+			input:    math.MaxInt64,
+			expected: NewNumberValue(new(big.Float).SetInt64(math.MaxInt64)),
+		},
+		"min": {
+			input:    math.MinInt64,
+			expected: NewNumberValue(new(big.Float).SetInt64(math.MinInt64)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewNumberInt64Value(testCase.input)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewNumberFloat64Value(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         float64
+		expected      NumberValue
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			input:    2.4,
+			expected: NewNumberValue(big.NewFloat(2.4)),
+		},
+		"nan": {
+			input:    math.NaN(),
+			expected: NewNumberUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Conversion Error",
+					"A float64 value of NaN cannot be represented as a Number. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"infinity": {
+			input:    math.Inf(1),
+			expected: NewNumberUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Conversion Error",
+					"A float64 value of +Inf cannot be represented as a Number. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewNumberFloat64Value(testCase.input)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNumberValueValueInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         NumberValue
+		expected      int64
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewNumberValue(big.NewFloat(24)),
+			expected: 24,
+		},
+		"known-max": {
+			input:    NewNumberInt64Value(math.MaxInt64),
+			expected: math.MaxInt64,
+		},
+		"known-nil": {
+			input:    NewNumberValue(nil),
+			expected: 0,
+		},
+		"null": {
+			input:    NewNumberNull(),
+			expected: 0,
+		},
+		"unknown": {
+			input:    NewNumberUnknown(),
+			expected: 0,
+		},
+		"not-integer": {
+			input:    NewNumberValue(big.NewFloat(2.5)),
+			expected: 0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Conversion Error",
+					"A Number value of 2.5 cannot be converted to int64 without loss, as it is not an integer.",
+				),
+			},
+		},
+		"overflow": {
+			input:    NewNumberValue(new(big.Float).Mul(big.NewFloat(math.MaxInt64), big.NewFloat(2))),
+			expected: 0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Conversion Error",
+					"A Number value of 1.844674407e+19 cannot be converted to int64 without loss, as it overflows int64.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ValueInt64()
+
+			if got != testCase.expected {
+				t.Errorf("expected %d, got %d", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNumberValueValueFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         NumberValue
+		expected      float64
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewNumberValue(big.NewFloat(2.4)),
+			expected: 2.4,
+		},
+		"known-decimal-fraction": {
+			input:    NewNumberValue(new(big.Float).SetPrec(512).Quo(big.NewFloat(1), big.NewFloat(10))),
+			expected: 0.1,
+		},
+		"known-nil": {
+			input:    NewNumberValue(nil),
+			expected: 0,
+		},
+		"null": {
+			input:    NewNumberNull(),
+			expected: 0,
+		},
+		"unknown": {
+			input:    NewNumberUnknown(),
+			expected: 0,
+		},
+		"overflow": {
+			input:    NewNumberValue(new(big.Float).SetMantExp(big.NewFloat(1), 2000)),
+			expected: 0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Conversion Error",
+					"A Number value of 1.148130695e+602 cannot be converted to float64 without loss, as it overflows float64.",
+				),
+			},
+		},
+		"underflow": {
+			input:    NewNumberValue(new(big.Float).SetMantExp(big.NewFloat(1), -2000)),
+			expected: 0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Conversion Error",
+					"A Number value of 8.709809816e-603 cannot be converted to float64 without loss, as it underflows float64.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ValueFloat64()
+
+			if got != testCase.expected {
+				t.Errorf("expected %f, got %f", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -174,7 +174,7 @@ func (o ObjectType) ValueFromObject(_ context.Context, obj ObjectValue) (ObjectV
 // null via the Object type IsNull method.
 func NewObjectNull(attributeTypes map[string]attr.Type) ObjectValue {
 	return ObjectValue{
-		attributeTypes: attributeTypes,
+		attributeTypes: copyMap(attributeTypes),
 		state:          attr.ValueStateNull,
 	}
 }
@@ -183,7 +183,7 @@ func NewObjectNull(attributeTypes map[string]attr.Type) ObjectValue {
 // value is unknown via the Object type IsUnknown method.
 func NewObjectUnknown(attributeTypes map[string]attr.Type) ObjectValue {
 	return ObjectValue{
-		attributeTypes: attributeTypes,
+		attributeTypes: copyMap(attributeTypes),
 		state:          attr.ValueStateUnknown,
 	}
 }
@@ -242,8 +242,8 @@ func NewObjectValue(attributeTypes map[string]attr.Type, attributes map[string]a
 	}
 
 	return ObjectValue{
-		attributeTypes: attributeTypes,
-		attributes:     attributes,
+		attributeTypes: copyMap(attributeTypes),
+		attributes:     copyMap(attributes),
 		state:          attr.ValueStateKnown,
 	}, nil
 }
@@ -356,15 +356,16 @@ func (o ObjectValue) As(ctx context.Context, target interface{}, opts ObjectAsOp
 	}, path.Empty())
 }
 
-// Attributes returns the mapping of known attribute values for the Object.
-// Returns nil if the Object is null or unknown.
+// Attributes returns a copy of the mapping of known attribute values for the
+// Object. Returns nil if the Object is null or unknown.
 func (o ObjectValue) Attributes() map[string]attr.Value {
-	return o.attributes
+	return copyMap(o.attributes)
 }
 
-// AttributeTypes returns the mapping of attribute types for the Object.
+// AttributeTypes returns a copy of the mapping of attribute types for the
+// Object.
 func (o ObjectValue) AttributeTypes(_ context.Context) map[string]attr.Type {
-	return o.attributeTypes
+	return copyMap(o.attributeTypes)
 }
 
 // Type returns an ObjectType with the same attribute types as `o`.
@@ -376,7 +377,7 @@ func (o ObjectValue) Type(ctx context.Context) attr.Type {
 // a tftypes.Value.
 func (o ObjectValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	attrTypes := map[string]tftypes.Type{}
-	for attr, typ := range o.AttributeTypes(ctx) {
+	for attr, typ := range o.attributeTypes {
 		attrTypes[attr] = typ.TerraformType(ctx)
 	}
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
@@ -484,8 +485,8 @@ func (o ObjectValue) String() string {
 	}
 
 	// We want the output to be consistent, so we sort the output by key
-	keys := make([]string, 0, len(o.Attributes()))
-	for k := range o.Attributes() {
+	keys := make([]string, 0, len(o.attributes))
+	for k := range o.attributes {
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
@@ -497,7 +498,7 @@ func (o ObjectValue) String() string {
 		if i != 0 {
 			res.WriteString(",")
 		}
-		res.WriteString(fmt.Sprintf(`"%s":%s`, k, o.Attributes()[k].String()))
+		res.WriteString(fmt.Sprintf(`"%s":%s`, k, o.attributes[k].String()))
 	}
 	res.WriteString("}")
 
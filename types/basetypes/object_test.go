@@ -2066,3 +2066,27 @@ func TestObjectValueType(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectValueAttributes_Immutable(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{"test_attr": StringType{}}
+	attributes := map[string]attr.Value{"test_attr": NewStringValue("original")}
+	object := NewObjectValueMust(attributeTypes, attributes)
+
+	attributeTypes["test_attr"] = BoolType{}
+	attributes["test_attr"] = NewStringValue("modified-input")
+	object.AttributeTypes(context.Background())["test_attr"] = BoolType{}
+	object.Attributes()["test_attr"] = NewStringValue("modified-output")
+
+	expectedAttributeTypes := map[string]attr.Type{"test_attr": StringType{}}
+	expectedAttributes := map[string]attr.Value{"test_attr": NewStringValue("original")}
+
+	if diff := cmp.Diff(object.AttributeTypes(context.Background()), expectedAttributeTypes); diff != "" {
+		t.Errorf("unexpected attribute types difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(object.Attributes(), expectedAttributes); diff != "" {
+		t.Errorf("unexpected attributes difference: %s", diff)
+	}
+}
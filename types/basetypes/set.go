@@ -43,6 +43,13 @@ type SetValuable interface {
 // property.
 type SetType struct {
 	ElemType attr.Type
+
+	// UniqueElements enables returning an error from ValueFromTerraform when
+	// fully known elements are duplicated, according to their Equal method,
+	// instead of only returning an error diagnostic during validation. This
+	// is opt-in, since existing state may contain duplicate elements. It is
+	// not considered by the Equal method.
+	UniqueElements bool
 }
 
 // ElementType returns the attr.Type elements will be created from.
@@ -53,7 +60,7 @@ func (st SetType) ElementType() attr.Type {
 // WithElementType returns a SetType that is identical to `l`, but with the
 // element type set to `typ`.
 func (st SetType) WithElementType(typ attr.Type) attr.TypeWithElementType {
-	return SetType{ElemType: typ}
+	return SetType{ElemType: typ, UniqueElements: st.UniqueElements}
 }
 
 // TerraformType returns the tftypes.Type that should be used to
@@ -96,6 +103,11 @@ func (st SetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 		}
 		elems = append(elems, av)
 	}
+	if st.UniqueElements {
+		if duplicateIndex, ok := setDuplicateElementIndex(ctx, elems); ok {
+			return nil, fmt.Errorf("set contains duplicate element at index %d: %s", duplicateIndex, elems[duplicateIndex])
+		}
+	}
 	// ValueFromTerraform above on each element should make this safe.
 	// Otherwise, this will need to do some Diagnostics to error conversion.
 	return NewSetValueMust(st.ElemType, elems), nil
@@ -166,16 +178,25 @@ func (st SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 
 	// Attempting to use map[tftypes.Value]struct{} for duplicate detection yields:
 	//   panic: runtime error: hash of unhashable type tftypes.primitive
-	// Instead, use for loops.
-	for indexOuter, elemOuter := range elems {
+	// Instead, group elements by their string representation, which is the
+	// same for equal values, and only compare elements within each group.
+	knownElems := make(map[string][]tftypes.Value, len(elems))
+
+	for _, elem := range elems {
 		// Only evaluate fully known values for duplicates and validation.
-		if !elemOuter.IsFullyKnown() {
+		if !elem.IsFullyKnown() {
 			continue
 		}
 
+		var elemValue attr.Value
+		var err error
+
+		if st.ElemType != nil {
+			elemValue, err = st.ElemType.ValueFromTerraform(ctx, elem)
+		}
+
 		// Validate the element first
 		if isValidatable {
-			elemValue, err := st.ElemType.ValueFromTerraform(ctx, elemOuter)
 			if err != nil {
 				diags.AddAttributeError(
 					path,
@@ -184,25 +205,38 @@ func (st SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 				)
 				return diags
 			}
-			diags = append(diags, validatableType.Validate(ctx, elemOuter, path.AtSetValue(elemValue))...)
+			diags = append(diags, validatableType.Validate(ctx, elem, path.AtSetValue(elemValue))...)
 		}
 
 		// Then check for duplicates
-		for indexInner := indexOuter + 1; indexInner < len(elems); indexInner++ {
-			elemInner := elems[indexInner]
+		key := elem.String()
+		duplicate := false
+
+		for _, knownElem := range knownElems[key] {
+			if knownElem.Equal(elem) {
+				duplicate = true
 
-			if !elemInner.Equal(elemOuter) {
-				continue
+				break
 			}
+		}
 
-			// TODO: Point at element attr.Value when Validate method is converted to attr.Value
-			// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/172
-			diags.AddAttributeError(
-				path,
-				"Duplicate Set Element",
-				fmt.Sprintf("This attribute contains duplicate values of: %s", elemInner),
-			)
+		if !duplicate {
+			knownElems[key] = append(knownElems[key], elem)
+
+			continue
+		}
+
+		elemPath := path
+
+		if err == nil && elemValue != nil {
+			elemPath = path.AtSetValue(elemValue)
 		}
+
+		diags.AddAttributeError(
+			elemPath,
+			"Duplicate Set Element",
+			fmt.Sprintf("This attribute contains duplicate values of: %s", elem),
+		)
 	}
 
 	return diags
@@ -265,11 +299,69 @@ func NewSetValue(elementType attr.Type, elements []attr.Value) (SetValue, diag.D
 
 	return SetValue{
 		elementType: elementType,
-		elements:    elements,
+		elements:    copySlice(elements),
 		state:       attr.ValueStateKnown,
 	}, nil
 }
 
+// NewSetValueUnique creates a Set with a known value, the same as
+// NewSetValue, except an error diagnostic is returned if fully known elements
+// are duplicated, according to their Equal method.
+func NewSetValueUnique(elementType attr.Type, elements []attr.Value) (SetValue, diag.Diagnostics) {
+	set, diags := NewSetValue(elementType, elements)
+
+	if diags.HasError() {
+		return set, diags
+	}
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	if duplicateIndex, ok := setDuplicateElementIndex(ctx, elements); ok {
+		diags.AddError(
+			"Duplicate Set Element",
+			"While creating a Set value, a duplicate element was detected. "+
+				"A Set must contain unique elements. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Set Index (%d) Duplicate Element: %s", duplicateIndex, elements[duplicateIndex]),
+		)
+
+		return NewSetUnknown(elementType), diags
+	}
+
+	return set, diags
+}
+
+// setDuplicateElementIndex returns the index of the first element which is
+// equal to an earlier element and true, or false if there are no duplicates.
+// Elements which are not fully known are skipped, since they may resolve to
+// different values. Elements are grouped by their Terraform string
+// representation, which is the same for equal values, so only elements
+// within each group are compared.
+func setDuplicateElementIndex(ctx context.Context, elements []attr.Value) (int, bool) {
+	knownElements := make(map[string][]attr.Value, len(elements))
+
+	for index, element := range elements {
+		tfValue, err := element.ToTerraformValue(ctx)
+
+		if err != nil || !tfValue.IsFullyKnown() {
+			continue
+		}
+
+		key := tfValue.String()
+
+		for _, knownElement := range knownElements[key] {
+			if knownElement.Equal(element) {
+				return index, true
+			}
+		}
+
+		knownElements[key] = append(knownElements[key], element)
+	}
+
+	return 0, false
+}
+
 // NewSetValueFrom creates a Set with a known value, using reflection rules.
 // The elements must be a slice which can convert into the given element type.
 // Access the value via the Set type Elements or ElementsAs methods.
@@ -341,10 +433,30 @@ type SetValue struct {
 	state attr.ValueState
 }
 
-// Elements returns the collection of elements for the Set. Returns nil if the
-// Set is null or unknown.
+// Elements returns a copy of the collection of elements for the Set. Returns
+// nil if the Set is null or unknown.
 func (s SetValue) Elements() []attr.Value {
-	return s.elements
+	return copySlice(s.elements)
+}
+
+// ElementsWithPaths returns the elements for the Set along with their paths,
+// which are the given base path, typically the path of the Set, with the set
+// value appended. Returns nil if the Set is null or unknown.
+func (s SetValue) ElementsWithPaths(basePath path.Path) []ElementWithPath {
+	if s.elements == nil {
+		return nil
+	}
+
+	result := make([]ElementWithPath, 0, len(s.elements))
+
+	for _, element := range s.elements {
+		result = append(result, ElementWithPath{
+			Path:  basePath.AtSetValue(element),
+			Value: element,
+		})
+	}
+
+	return result
 }
 
 // ElementsAs populates `target` with the elements of the SetValue, throwing an
@@ -444,7 +556,7 @@ func (s SetValue) Equal(o attr.Value) bool {
 }
 
 func (s SetValue) contains(v attr.Value) bool {
-	for _, elem := range s.Elements() {
+	for _, elem := range s.elements {
 		if elem.Equal(v) {
 			return true
 		}
@@ -453,6 +565,34 @@ func (s SetValue) contains(v attr.Value) bool {
 	return false
 }
 
+// EqualWithOptions returns true if the Set is considered semantically
+// equal (same type and same value) to the attr.Value passed as an argument,
+// with the comparison adjusted by the given options. Refer to the
+// EqualOptions type for details about each option.
+func (s SetValue) EqualWithOptions(o attr.Value, opts EqualOptions) bool {
+	other, ok := o.(SetValue)
+
+	if !ok {
+		return false
+	}
+
+	if !s.elementType.Equal(other.elementType) {
+		return false
+	}
+
+	if opts.valueState(s.state) != opts.valueState(other.state) {
+		return false
+	}
+
+	if opts.valueState(s.state) != attr.ValueStateKnown {
+		return true
+	}
+
+	opts.IgnoreOrder = true
+
+	return opts.equalElements(s.elements, other.elements)
+}
+
 // IsNull returns true if the Set represents a null value.
 func (s SetValue) IsNull() bool {
 	return s.state == attr.ValueStateNull
@@ -480,7 +620,7 @@ func (s SetValue) String() string {
 	var res strings.Builder
 
 	res.WriteString("[")
-	for i, e := range s.Elements() {
+	for i, e := range s.elements {
 		if i != 0 {
 			res.WriteString(",")
 		}
@@ -118,6 +118,20 @@ func TestSetTypeValueFromTerraform(t *testing.T) {
 				},
 			),
 		},
+		"set-of-duplicate-strings-unique-elements": {
+			receiver: SetType{
+				ElemType:       StringType{},
+				UniqueElements: true,
+			},
+			input: tftypes.NewValue(tftypes.Set{
+				ElementType: tftypes.String,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.String, "world"),
+				tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			expectedErr: `set contains duplicate element at index 2: "hello"`,
+		},
 		"unknown-set": {
 			receiver: SetType{
 				ElemType: StringType{},
@@ -245,6 +259,11 @@ func TestSetTypeEqual(t *testing.T) {
 			input:    SetType{ElemType: StringType{}},
 			expected: true,
 		},
+		"equal-unique-elements": {
+			receiver: SetType{ElemType: StringType{}, UniqueElements: true},
+			input:    SetType{ElemType: StringType{}},
+			expected: true,
+		},
 		"diff": {
 			receiver: SetType{ElemType: StringType{}},
 			input:    SetType{ElemType: NumberType{}},
@@ -381,6 +400,7 @@ func TestSetTypeValidate(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
+		setType       SetType
 		in            tftypes.Value
 		expectedDiags diag.Diagnostics
 	}{
@@ -513,6 +533,26 @@ func TestSetTypeValidate(t *testing.T) {
 				),
 			},
 		},
+		"values-duplicates-element-type": {
+			setType: SetType{ElemType: StringType{}},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "world"),
+					tftypes.NewValue(tftypes.String, "hello"),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("hello")),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"hello\">",
+				),
+			},
+		},
 		"values-duplicates-and-unknowns": {
 			in: tftypes.NewValue(
 				tftypes.Set{
@@ -554,7 +594,7 @@ func TestSetTypeValidate(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := SetType{}.Validate(context.Background(), testCase.in, path.Root("test"))
+			diags := testCase.setType.Validate(context.Background(), testCase.in, path.Root("test"))
 
 			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
 				t.Errorf("Unexpected diagnostics (+got, -expected): %s", diff)
@@ -632,6 +672,70 @@ func TestNewSetValue(t *testing.T) {
 	}
 }
 
+func TestNewSetValueUnique(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		elements      []attr.Value
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"valid-elements": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringUnknown(),
+				NewStringUnknown(),
+				NewStringValue("test"),
+			},
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringUnknown(),
+					NewStringUnknown(),
+					NewStringValue("test"),
+				},
+			),
+		},
+		"duplicate-elements": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringValue("test"),
+				NewStringValue("other"),
+				NewStringValue("test"),
+			},
+			expected: NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Duplicate Set Element",
+					"While creating a Set value, a duplicate element was detected. "+
+						"A Set must contain unique elements. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						`Set Index (2) Duplicate Element: "test"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewSetValueUnique(testCase.elementType, testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNewSetValueFrom(t *testing.T) {
 	t.Parallel()
 
@@ -1101,6 +1205,162 @@ func TestSetValueEqual(t *testing.T) {
 	}
 }
 
+func TestSetValueEqualWithOptions(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver SetValue
+		input    attr.Value
+		opts     EqualOptions
+		expected bool
+	}
+	tests := map[string]testCase{
+		"known-known-diff-order-no-options": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("b"),
+					NewStringValue("a"),
+				},
+			),
+			opts:     EqualOptions{},
+			expected: true,
+		},
+		"known-known-diff-value": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("c"),
+				},
+			),
+			opts:     EqualOptions{},
+			expected: false,
+		},
+		"null-empty-no-options": {
+			receiver: NewSetNull(StringType{}),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{},
+			),
+			opts:     EqualOptions{},
+			expected: false,
+		},
+		"null-empty-ignore-null-empty": {
+			receiver: NewSetNull(StringType{}),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{},
+			),
+			opts:     EqualOptions{IgnoreNullEmpty: true},
+			expected: true,
+		},
+		"null-unknown-ignore-null-empty": {
+			receiver: NewSetNull(StringType{}),
+			input:    NewSetUnknown(StringType{}),
+			opts:     EqualOptions{IgnoreNullEmpty: true},
+			expected: false,
+		},
+		"known-known-subset": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("c"),
+					NewStringValue("a"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringValue("c"),
+				},
+			),
+			opts:     EqualOptions{Subset: true},
+			expected: true,
+		},
+		"known-known-superset": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringValue("c"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("c"),
+				},
+			),
+			opts:     EqualOptions{Subset: true},
+			expected: false,
+		},
+		"known-known-subset-diff-value": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("d"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringValue("c"),
+				},
+			),
+			opts:     EqualOptions{Subset: true},
+			expected: false,
+		},
+		"wrong-type": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			opts:     EqualOptions{},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.receiver.EqualWithOptions(test.input, test.opts)
+			if got != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
 func TestSetValueIsNull(t *testing.T) {
 	t.Parallel()
 
@@ -1310,3 +1570,68 @@ func TestSetValueType(t *testing.T) {
 		})
 	}
 }
+
+func TestSetValueElements_Immutable(t *testing.T) {
+	t.Parallel()
+
+	elements := []attr.Value{NewStringValue("original")}
+	set := NewSetValueMust(StringType{}, elements)
+
+	elements[0] = NewStringValue("modified-input")
+	set.Elements()[0] = NewStringValue("modified-output")
+
+	expected := []attr.Value{NewStringValue("original")}
+
+	if diff := cmp.Diff(set.Elements(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestSetValueElementsWithPaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetValue
+		expected []ElementWithPath
+	}{
+		"known": {
+			input: NewSetValueMust(StringType{}, []attr.Value{NewStringValue("first"), NewStringValue("second")}),
+			expected: []ElementWithPath{
+				{
+					Path:  path.Root("test").AtSetValue(NewStringValue("first")),
+					Value: NewStringValue("first"),
+				},
+				{
+					Path:  path.Root("test").AtSetValue(NewStringValue("second")),
+					Value: NewStringValue("second"),
+				},
+			},
+		},
+		"known-empty": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			expected: []ElementWithPath{},
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ElementsWithPaths(path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -23,6 +23,22 @@ type StringValuable interface {
 	ToStringValue(ctx context.Context) (StringValue, diag.Diagnostics)
 }
 
+// StringValuableWithSemanticEquals extends StringValuable with semantic
+// equality logic.
+type StringValuableWithSemanticEquals interface {
+	StringValuable
+
+	// StringSemanticEquals should return true if the given value is
+	// semantically equal to the current value. This logic is used to prevent
+	// Terraform data consistency errors and resource drift where a value change
+	// may have inconsequential differences, such as spacing character removal
+	// in JSON formatted strings.
+	//
+	// Only known values are compared with this method as changing a value's
+	// state implicitly represents a different value.
+	StringSemanticEquals(context.Context, StringValuable) (bool, diag.Diagnostics)
+}
+
 // NewStringNull creates a String with a null value. Determine whether the value is
 // null via the String type IsNull method.
 //
@@ -57,6 +73,16 @@ func NewStringValue(value string) StringValue {
 	}
 }
 
+// NewStringPointerValue creates a String with a null value if nil or a known
+// value. Access the value via the String type ValueStringPointer method.
+func NewStringPointerValue(value *string) StringValue {
+	if value == nil {
+		return NewStringNull()
+	}
+
+	return NewStringValue(*value)
+}
+
 // StringValue represents a UTF-8 string value.
 type StringValue struct {
 	// state represents whether the value is null, unknown, or known. The
@@ -142,6 +168,16 @@ func (s StringValue) ValueString() string {
 	return s.value
 }
 
+// ValueStringPointer returns a pointer to the known string value, nil for a null
+// value, or a pointer to "" for an unknown value.
+func (s StringValue) ValueStringPointer() *string {
+	if s.IsNull() {
+		return nil
+	}
+
+	return &s.value
+}
+
 // ToStringValue returns String.
 func (s StringValue) ToStringValue(context.Context) (StringValue, diag.Diagnostics) {
 	return s, nil
@@ -21,3 +21,9 @@ func BoolUnknown() basetypes.BoolValue {
 func BoolValue(value bool) basetypes.BoolValue {
 	return basetypes.NewBoolValue(value)
 }
+
+// BoolPointerValue creates a Bool with a null value if nil or a known value.
+// Access the value via the Bool type ValueBoolPointer method.
+func BoolPointerValue(value *bool) basetypes.BoolValue {
+	return basetypes.NewBoolPointerValue(value)
+}
@@ -21,3 +21,9 @@ func Int64Unknown() basetypes.Int64Value {
 func Int64Value(value int64) basetypes.Int64Value {
 	return basetypes.NewInt64Value(value)
 }
+
+// Int64PointerValue creates a Int64 with a null value if nil or a known value.
+// Access the value via the Int64 type ValueInt64Pointer method.
+func Int64PointerValue(value *int64) basetypes.Int64Value {
+	return basetypes.NewInt64PointerValue(value)
+}
@@ -0,0 +1,5 @@
+// Package jsontypes contains custom attribute types and values for JSON
+// strings, such as IAM policy documents. These types build on the
+// types/basetypes package and can be used with the CustomType field of schema
+// attributes.
+package jsontypes
@@ -0,0 +1,67 @@
+package jsontypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable = Exact{}
+)
+
+// Exact represents a valid JSON string (RFC 7159). Unmarshal the value via
+// the Unmarshal method. No semantic equality logic is defined for Exact.
+type Exact struct {
+	basetypes.StringValue
+}
+
+// Type returns a ExactType.
+func (v Exact) Type(_ context.Context) attr.Type {
+	return ExactType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Exact) Equal(o attr.Value) bool {
+	other, ok := o.(Exact)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the JSON string value and
+// the target. An error diagnostic is returned if the value is null, unknown,
+// or cannot be unmarshalled into the target.
+func (v Exact) Unmarshal(target any) diag.Diagnostics {
+	return unmarshalJSON(v.ValueString(), v.IsNull(), v.IsUnknown(), "JSON Exact", target)
+}
+
+// NewExactNull creates a Exact with a null value. Determine whether the value is
+// null via the Exact type IsNull method.
+func NewExactNull() Exact {
+	return Exact{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewExactUnknown creates a Exact with an unknown value. Determine whether the
+// value is unknown via the Exact type IsUnknown method.
+func NewExactUnknown() Exact {
+	return Exact{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewExactValue creates a Exact with a known value. Access the value via the
+// Exact type ValueString method. The value is validated when the associated
+// attribute value is validated.
+func NewExactValue(value string) Exact {
+	return Exact{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
@@ -0,0 +1,66 @@
+package jsontypes_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+)
+
+func TestExactSemanticEqualsNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	var value basetypes.StringValuable = jsontypes.NewExactValue(`{"hello":"world"}`)
+
+	if _, ok := value.(basetypes.StringValuableWithSemanticEquals); ok {
+		t.Fatal("expected Exact to not implement semantic equality")
+	}
+}
+
+func TestExactUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.Exact
+		expected      map[string]any
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			json: jsontypes.NewExactValue(`{"hello":"world"}`),
+			expected: map[string]any{
+				"hello": "world",
+			},
+		},
+		"null": {
+			json: jsontypes.NewExactNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSON Exact Unmarshal Error",
+					"A JSON string value cannot be unmarshalled, as the value is null.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got map[string]any
+
+			diags := testCase.json.Unmarshal(&got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// validateJSON returns an error diagnostic if the given value is not a valid
+// JSON string. Null and unknown values are always valid.
+func validateJSON(_ context.Context, in tftypes.Value, typeName string, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Is(tftypes.String) {
+		err := fmt.Errorf("expected String value, received %T with value: %v", in, in)
+		diags.AddAttributeError(
+			path,
+			typeName+" Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var valueString string
+
+	if err := in.As(&valueString); err != nil {
+		diags.AddAttributeError(
+			path,
+			typeName+" Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	if ok := json.Valid([]byte(valueString)); !ok {
+		diags.AddAttributeError(
+			path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				"Given Value: "+valueString,
+		)
+
+		return diags
+	}
+
+	return diags
+}
+
+// unmarshalJSON unmarshals the given known JSON string into the target,
+// returning error diagnostics if the value is null, unknown, or invalid.
+func unmarshalJSON(valueString string, isNull bool, isUnknown bool, typeName string, target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if isNull {
+		diags.AddError(
+			typeName+" Unmarshal Error",
+			"A JSON string value cannot be unmarshalled, as the value is null.",
+		)
+
+		return diags
+	}
+
+	if isUnknown {
+		diags.AddError(
+			typeName+" Unmarshal Error",
+			"A JSON string value cannot be unmarshalled, as the value is unknown.",
+		)
+
+		return diags
+	}
+
+	if err := json.Unmarshal([]byte(valueString), target); err != nil {
+		diags.AddError(
+			typeName+" Unmarshal Error",
+			"An unexpected error occurred while unmarshalling a JSON string value.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,142 @@
+package jsontypes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = Normalized{}
+	_ basetypes.StringValuableWithSemanticEquals = Normalized{}
+)
+
+// Normalized represents a valid JSON string (RFC 7159). Unmarshal the value
+// via the Unmarshal method. Semantic equality logic ignores inconsequential
+// differences, such as whitespace and object key ordering.
+type Normalized struct {
+	basetypes.StringValue
+}
+
+// Type returns a NormalizedType.
+func (v Normalized) Type(_ context.Context) attr.Type {
+	return NormalizedType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Normalized) Equal(o attr.Value) bool {
+	other, ok := o.(Normalized)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given JSON string value is
+// semantically equal to the current JSON string value. When compared, the
+// JSON string values are marshalled, which ignores inconsequential
+// differences such as whitespace and object key ordering.
+//
+// For example, `{"hello": "world", "foo": "bar"}` and
+// `{"foo":"bar","hello":"world"}` are semantically equal.
+func (v Normalized) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Normalized)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorNormalized, err := normalizeJSONString(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	newNormalized, err := normalizeJSONString(newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return bytes.Equal(priorNormalized, newNormalized), diags
+}
+
+// normalizeJSONString returns the given JSON string marshalled without
+// insignificant whitespace and with sorted object keys. Numbers are preserved
+// as-is to prevent precision loss of large values.
+func normalizeJSONString(jsonStr string) ([]byte, error) {
+	var temp any
+
+	decoder := json.NewDecoder(strings.NewReader(jsonStr))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&temp); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&temp)
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the JSON string value and
+// the target. An error diagnostic is returned if the value is null, unknown,
+// or cannot be unmarshalled into the target.
+func (v Normalized) Unmarshal(target any) diag.Diagnostics {
+	return unmarshalJSON(v.ValueString(), v.IsNull(), v.IsUnknown(), "JSON Normalized", target)
+}
+
+// NewNormalizedNull creates a Normalized with a null value. Determine whether the value is
+// null via the Normalized type IsNull method.
+func NewNormalizedNull() Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewNormalizedUnknown creates a Normalized with an unknown value. Determine whether the
+// value is unknown via the Normalized type IsUnknown method.
+func NewNormalizedUnknown() Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewNormalizedValue creates a Normalized with a known value. Access the value via the
+// Normalized type ValueString method. The value is validated when the associated
+// attribute value is validated.
+func NewNormalizedValue(value string) Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
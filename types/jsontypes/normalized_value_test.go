@@ -0,0 +1,168 @@
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+)
+
+func TestNormalizedStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentJson   jsontypes.Normalized
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact-match": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"hello":"world"}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"hello":"world"}`),
+			expectedMatch: true,
+		},
+		"whitespace": {
+			currentJson: jsontypes.NewNormalizedValue(`{"hello":"world","nums":[1,2,3]}`),
+			givenJson: jsontypes.NewNormalizedValue(`{
+				"hello": "world",
+				"nums": [ 1, 2, 3 ]
+			}`),
+			expectedMatch: true,
+		},
+		"key-ordering": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"hello":"world","foo":{"b":2,"a":1}}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"foo":{"a":1,"b":2},"hello":"world"}`),
+			expectedMatch: true,
+		},
+		"array-ordering": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"nums":[1,2,3]}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"nums":[3,2,1]}`),
+			expectedMatch: false,
+		},
+		"large-numbers": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"num":12345678901234567890}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"num": 12345678901234567891}`),
+			expectedMatch: false,
+		},
+		"different-values": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"hello":"world"}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"hello":"there"}`),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"hello":"world"}`),
+			givenJson:     basetypes.NewStringValue(`{"hello":"world"}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.Normalized\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"invalid-json": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"hello":"world"}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"hello":`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: unexpected EOF",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	type testTarget struct {
+		Hello string `json:"hello"`
+	}
+
+	testCases := map[string]struct {
+		json          jsontypes.Normalized
+		expected      testTarget
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			json:     jsontypes.NewNormalizedValue(`{"hello":"world"}`),
+			expected: testTarget{Hello: "world"},
+		},
+		"null": {
+			json: jsontypes.NewNormalizedNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSON Normalized Unmarshal Error",
+					"A JSON string value cannot be unmarshalled, as the value is null.",
+				),
+			},
+		},
+		"unknown": {
+			json: jsontypes.NewNormalizedUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSON Normalized Unmarshal Error",
+					"A JSON string value cannot be unmarshalled, as the value is unknown.",
+				),
+			},
+		},
+		"invalid": {
+			json: jsontypes.NewNormalizedValue(`{"hello":`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSON Normalized Unmarshal Error",
+					"An unexpected error occurred while unmarshalling a JSON string value.\n\n"+
+						"Error: unexpected end of JSON input",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got testTarget
+
+			diags := testCase.json.Unmarshal(&got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package nettypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = CIDRType{}
+	_ xattr.TypeWithValidate  = CIDRType{}
+)
+
+// CIDRType is an attribute type that represents a valid IPv4 or IPv6 CIDR
+// notation prefix string, such as 192.0.2.0/24 or 2001:db8::/32. Semantic
+// equality logic is defined for CIDRType such that differing representations
+// of the same prefix, such as IPv6 zero compression, are considered equal.
+// CIDR is the associated value type.
+type CIDRType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t CIDRType) String() string {
+	return "nettypes.CIDRType"
+}
+
+// ValueType returns the Value type.
+func (t CIDRType) ValueType(ctx context.Context) attr.Value {
+	return CIDR{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t CIDRType) Equal(o attr.Type) bool {
+	other, ok := o.(CIDRType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// Validate implements type validation. This type requires the value provided
+// to be a String value that is a valid CIDR.
+func (t CIDRType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Is(tftypes.String) {
+		err := fmt.Errorf("expected String value, received %T with value: %v", in, in)
+		diags.AddAttributeError(
+			path,
+			"CIDR Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var valueString string
+
+	if err := in.As(&valueString); err != nil {
+		diags.AddAttributeError(
+			path,
+			"CIDR Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	if _, err := parseCIDR(valueString); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid CIDR String Value",
+			"A string value was provided that is not a valid CIDR.\n\n"+
+				"Given Value: "+valueString+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	return diags
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t CIDRType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CIDR{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t CIDRType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,147 @@
+package nettypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = CIDR{}
+	_ basetypes.StringValuableWithSemanticEquals = CIDR{}
+)
+
+// CIDR represents a valid CIDR string. Access the value as a
+// netip.Prefix via the ValueCIDR method.
+type CIDR struct {
+	basetypes.StringValue
+}
+
+// Type returns a CIDRType.
+func (v CIDR) Type(_ context.Context) attr.Type {
+	return CIDRType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v CIDR) Equal(o attr.Value) bool {
+	other, ok := o.(CIDR)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given CIDR string value
+// represents the same prefix as the current value, regardless of
+// representation, such as IPv6 zero compression.
+func (v CIDR) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CIDR)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorParsed, err := parseCIDR(v.ValueString())
+
+	// Invalid values are never semantically equal.
+	if err != nil {
+		return false, diags
+	}
+
+	newParsed, err := parseCIDR(newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return priorParsed == newParsed, diags
+}
+
+// ValueCIDR returns the CIDR string value as a netip.Prefix. An error
+// diagnostic is returned if the value is null, unknown, or not a valid
+// CIDR.
+func (v CIDR) ValueCIDR() (netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError(
+			"CIDR ValueCIDR Error",
+			"A CIDR string value cannot be converted to netip.Prefix, as the value is null.",
+		)
+
+		return netip.Prefix{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError(
+			"CIDR ValueCIDR Error",
+			"A CIDR string value cannot be converted to netip.Prefix, as the value is unknown.",
+		)
+
+		return netip.Prefix{}, diags
+	}
+
+	prefix, err := parseCIDR(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"CIDR ValueCIDR Error",
+			"A CIDR string value cannot be converted to netip.Prefix.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return netip.Prefix{}, diags
+	}
+
+	return prefix, diags
+}
+
+// NewCIDRNull creates a CIDR with a null value. Determine whether the
+// value is null via the CIDR type IsNull method.
+func NewCIDRNull() CIDR {
+	return CIDR{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewCIDRUnknown creates a CIDR with an unknown value. Determine whether
+// the value is unknown via the CIDR type IsUnknown method.
+func NewCIDRUnknown() CIDR {
+	return CIDR{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewCIDRValue creates a CIDR with a known value. Access the value via the
+// CIDR type ValueString method. The value is validated when the associated
+// attribute value is validated.
+func NewCIDRValue(value string) CIDR {
+	return CIDR{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewCIDRPrefixValue creates a CIDR with a known value from the given
+// netip.Prefix, using its normalized string representation.
+func NewCIDRPrefixValue(value netip.Prefix) CIDR {
+	return CIDR{
+		StringValue: basetypes.NewStringValue(value.String()),
+	}
+}
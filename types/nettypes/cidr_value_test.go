@@ -0,0 +1,152 @@
+package nettypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+)
+
+func TestCIDRStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current       nettypes.CIDR
+		given         basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact-match": {
+			current:       nettypes.NewCIDRValue("192.0.2.0/24"),
+			given:         nettypes.NewCIDRValue("192.0.2.0/24"),
+			expectedMatch: true,
+		},
+		"semantically-equal": {
+			current:       nettypes.NewCIDRValue("2001:0db8::/32"),
+			given:         nettypes.NewCIDRValue("2001:db8::/32"),
+			expectedMatch: true,
+		},
+		"not-equal": {
+			current:       nettypes.NewCIDRValue("2001:db8::/32"),
+			given:         nettypes.NewCIDRValue("2001:db8::/48"),
+			expectedMatch: false,
+		},
+		"invalid": {
+			current:       nettypes.NewCIDRValue("192.0.2.0"),
+			given:         nettypes.NewCIDRValue("192.0.2.0"),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			current:       nettypes.NewCIDRValue("192.0.2.0/24"),
+			given:         basetypes.NewStringValue("192.0.2.0/24"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: nettypes.CIDR\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCIDRValueCIDR(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         nettypes.CIDR
+		expected      netip.Prefix
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			value:    nettypes.NewCIDRValue("192.0.2.0/24"),
+			expected: netip.MustParsePrefix("192.0.2.0/24"),
+		},
+		"null": {
+			value:    nettypes.NewCIDRNull(),
+			expected: netip.Prefix{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CIDR ValueCIDR Error",
+					"A CIDR string value cannot be converted to netip.Prefix, as the value is null.",
+				),
+			},
+		},
+		"unknown": {
+			value:    nettypes.NewCIDRUnknown(),
+			expected: netip.Prefix{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CIDR ValueCIDR Error",
+					"A CIDR string value cannot be converted to netip.Prefix, as the value is unknown.",
+				),
+			},
+		},
+		"invalid": {
+			value:    nettypes.NewCIDRValue("192.0.2.0"),
+			expected: netip.Prefix{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CIDR ValueCIDR Error",
+					"A CIDR string value cannot be converted to netip.Prefix.\n\n"+
+						"Given Value: 192.0.2.0\n"+
+						"Error: netip.ParsePrefix(\"192.0.2.0\"): no '/'",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueCIDR()
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewCIDRPrefixValue(t *testing.T) {
+	t.Parallel()
+
+	got := nettypes.NewCIDRPrefixValue(netip.MustParsePrefix("192.0.2.0/24"))
+	expected := nettypes.NewCIDRValue("192.0.2.0/24")
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected value difference: %s", diff)
+	}
+}
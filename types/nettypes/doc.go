@@ -0,0 +1,5 @@
+// Package nettypes contains custom attribute types and values for network
+// related data, such as IP addresses and CIDR notation prefixes. These types
+// build on the types/basetypes package and can be used with the CustomType
+// field of schema attributes. Value accessors return net/netip types.
+package nettypes
@@ -0,0 +1,147 @@
+package nettypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = IPAddress{}
+	_ basetypes.StringValuableWithSemanticEquals = IPAddress{}
+)
+
+// IPAddress represents a valid IP address string. Access the value as a
+// netip.Addr via the ValueIPAddress method.
+type IPAddress struct {
+	basetypes.StringValue
+}
+
+// Type returns an IPAddressType.
+func (v IPAddress) Type(_ context.Context) attr.Type {
+	return IPAddressType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPAddress) Equal(o attr.Value) bool {
+	other, ok := o.(IPAddress)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given IP address string value
+// represents the same address as the current value, regardless of
+// representation, such as IPv6 zero compression.
+func (v IPAddress) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPAddress)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorParsed, err := parseIPAddress(v.ValueString())
+
+	// Invalid values are never semantically equal.
+	if err != nil {
+		return false, diags
+	}
+
+	newParsed, err := parseIPAddress(newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return priorParsed == newParsed, diags
+}
+
+// ValueIPAddress returns the IP address string value as a netip.Addr. An error
+// diagnostic is returned if the value is null, unknown, or not a valid
+// IP address.
+func (v IPAddress) ValueIPAddress() (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError(
+			"IPAddress ValueIPAddress Error",
+			"An IP address string value cannot be converted to netip.Addr, as the value is null.",
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError(
+			"IPAddress ValueIPAddress Error",
+			"An IP address string value cannot be converted to netip.Addr, as the value is unknown.",
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	addr, err := parseIPAddress(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"IPAddress ValueIPAddress Error",
+			"An IP address string value cannot be converted to netip.Addr.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	return addr, diags
+}
+
+// NewIPAddressNull creates an IPAddress with a null value. Determine whether the
+// value is null via the IPAddress type IsNull method.
+func NewIPAddressNull() IPAddress {
+	return IPAddress{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPAddressUnknown creates an IPAddress with an unknown value. Determine whether
+// the value is unknown via the IPAddress type IsUnknown method.
+func NewIPAddressUnknown() IPAddress {
+	return IPAddress{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPAddressValue creates an IPAddress with a known value. Access the value via the
+// IPAddress type ValueString method. The value is validated when the associated
+// attribute value is validated.
+func NewIPAddressValue(value string) IPAddress {
+	return IPAddress{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPAddressAddrValue creates an IPAddress with a known value from the given
+// netip.Addr, using its normalized string representation.
+func NewIPAddressAddrValue(value netip.Addr) IPAddress {
+	return IPAddress{
+		StringValue: basetypes.NewStringValue(value.String()),
+	}
+}
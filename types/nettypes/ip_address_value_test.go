@@ -0,0 +1,152 @@
+package nettypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+)
+
+func TestIPAddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current       nettypes.IPAddress
+		given         basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact-match": {
+			current:       nettypes.NewIPAddressValue("2001:db8::1"),
+			given:         nettypes.NewIPAddressValue("2001:db8::1"),
+			expectedMatch: true,
+		},
+		"semantically-equal": {
+			current:       nettypes.NewIPAddressValue("2001:DB8::1"),
+			given:         nettypes.NewIPAddressValue("2001:db8::1"),
+			expectedMatch: true,
+		},
+		"not-equal": {
+			current:       nettypes.NewIPAddressValue("2001:db8::1"),
+			given:         nettypes.NewIPAddressValue("192.0.2.1"),
+			expectedMatch: false,
+		},
+		"invalid": {
+			current:       nettypes.NewIPAddressValue("not-an-ip"),
+			given:         nettypes.NewIPAddressValue("not-an-ip"),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			current:       nettypes.NewIPAddressValue("2001:db8::1"),
+			given:         basetypes.NewStringValue("2001:db8::1"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: nettypes.IPAddress\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPAddressValueIPAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         nettypes.IPAddress
+		expected      netip.Addr
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			value:    nettypes.NewIPAddressValue("2001:db8::1"),
+			expected: netip.MustParseAddr("2001:db8::1"),
+		},
+		"null": {
+			value:    nettypes.NewIPAddressNull(),
+			expected: netip.Addr{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPAddress ValueIPAddress Error",
+					"An IP address string value cannot be converted to netip.Addr, as the value is null.",
+				),
+			},
+		},
+		"unknown": {
+			value:    nettypes.NewIPAddressUnknown(),
+			expected: netip.Addr{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPAddress ValueIPAddress Error",
+					"An IP address string value cannot be converted to netip.Addr, as the value is unknown.",
+				),
+			},
+		},
+		"invalid": {
+			value:    nettypes.NewIPAddressValue("not-an-ip"),
+			expected: netip.Addr{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPAddress ValueIPAddress Error",
+					"An IP address string value cannot be converted to netip.Addr.\n\n"+
+						"Given Value: not-an-ip\n"+
+						"Error: ParseAddr(\"not-an-ip\"): unable to parse IP",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueIPAddress()
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewIPAddressAddrValue(t *testing.T) {
+	t.Parallel()
+
+	got := nettypes.NewIPAddressAddrValue(netip.MustParseAddr("2001:db8::1"))
+	expected := nettypes.NewIPAddressValue("2001:db8::1")
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected value difference: %s", diff)
+	}
+}
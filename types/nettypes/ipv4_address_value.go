@@ -0,0 +1,148 @@
+package nettypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = IPv4Address{}
+	_ basetypes.StringValuableWithSemanticEquals = IPv4Address{}
+)
+
+// IPv4Address represents a valid IPv4 address string. Access the value as a
+// netip.Addr via the ValueIPv4Address method.
+type IPv4Address struct {
+	basetypes.StringValue
+}
+
+// Type returns an IPv4AddressType.
+func (v IPv4Address) Type(_ context.Context) attr.Type {
+	return IPv4AddressType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPv4Address) Equal(o attr.Value) bool {
+	other, ok := o.(IPv4Address)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given IPv4 address string value
+// represents the same address as the current value. IPv4 addresses with
+// leading zeros are not valid, so this is equivalent to comparing the
+// string values and is implemented for consistency with IPAddress.
+func (v IPv4Address) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPv4Address)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorParsed, err := parseIPv4Address(v.ValueString())
+
+	// Invalid values are never semantically equal.
+	if err != nil {
+		return false, diags
+	}
+
+	newParsed, err := parseIPv4Address(newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return priorParsed == newParsed, diags
+}
+
+// ValueIPv4Address returns the IPv4 address string value as a netip.Addr. An error
+// diagnostic is returned if the value is null, unknown, or not a valid
+// IPv4 address.
+func (v IPv4Address) ValueIPv4Address() (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError(
+			"IPv4Address ValueIPv4Address Error",
+			"An IPv4 address string value cannot be converted to netip.Addr, as the value is null.",
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError(
+			"IPv4Address ValueIPv4Address Error",
+			"An IPv4 address string value cannot be converted to netip.Addr, as the value is unknown.",
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	addr, err := parseIPv4Address(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"IPv4Address ValueIPv4Address Error",
+			"An IPv4 address string value cannot be converted to netip.Addr.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	return addr, diags
+}
+
+// NewIPv4AddressNull creates an IPv4Address with a null value. Determine whether the
+// value is null via the IPv4Address type IsNull method.
+func NewIPv4AddressNull() IPv4Address {
+	return IPv4Address{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPv4AddressUnknown creates an IPv4Address with an unknown value. Determine whether
+// the value is unknown via the IPv4Address type IsUnknown method.
+func NewIPv4AddressUnknown() IPv4Address {
+	return IPv4Address{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPv4AddressValue creates an IPv4Address with a known value. Access the value via the
+// IPv4Address type ValueString method. The value is validated when the associated
+// attribute value is validated.
+func NewIPv4AddressValue(value string) IPv4Address {
+	return IPv4Address{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPv4AddressAddrValue creates an IPv4Address with a known value from the given
+// netip.Addr, using its normalized string representation.
+func NewIPv4AddressAddrValue(value netip.Addr) IPv4Address {
+	return IPv4Address{
+		StringValue: basetypes.NewStringValue(value.String()),
+	}
+}
@@ -0,0 +1,152 @@
+package nettypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+)
+
+func TestIPv4AddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current       nettypes.IPv4Address
+		given         basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact-match": {
+			current:       nettypes.NewIPv4AddressValue("192.0.2.1"),
+			given:         nettypes.NewIPv4AddressValue("192.0.2.1"),
+			expectedMatch: true,
+		},
+		"semantically-equal": {
+			current:       nettypes.NewIPv4AddressValue("192.0.2.1"),
+			given:         nettypes.NewIPv4AddressValue("192.0.2.1"),
+			expectedMatch: true,
+		},
+		"not-equal": {
+			current:       nettypes.NewIPv4AddressValue("192.0.2.1"),
+			given:         nettypes.NewIPv4AddressValue("192.0.2.2"),
+			expectedMatch: false,
+		},
+		"invalid": {
+			current:       nettypes.NewIPv4AddressValue("2001:db8::1"),
+			given:         nettypes.NewIPv4AddressValue("2001:db8::1"),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			current:       nettypes.NewIPv4AddressValue("192.0.2.1"),
+			given:         basetypes.NewStringValue("192.0.2.1"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: nettypes.IPv4Address\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4AddressValueIPv4Address(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         nettypes.IPv4Address
+		expected      netip.Addr
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			value:    nettypes.NewIPv4AddressValue("192.0.2.1"),
+			expected: netip.MustParseAddr("192.0.2.1"),
+		},
+		"null": {
+			value:    nettypes.NewIPv4AddressNull(),
+			expected: netip.Addr{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4Address ValueIPv4Address Error",
+					"An IPv4 address string value cannot be converted to netip.Addr, as the value is null.",
+				),
+			},
+		},
+		"unknown": {
+			value:    nettypes.NewIPv4AddressUnknown(),
+			expected: netip.Addr{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4Address ValueIPv4Address Error",
+					"An IPv4 address string value cannot be converted to netip.Addr, as the value is unknown.",
+				),
+			},
+		},
+		"invalid": {
+			value:    nettypes.NewIPv4AddressValue("2001:db8::1"),
+			expected: netip.Addr{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4Address ValueIPv4Address Error",
+					"An IPv4 address string value cannot be converted to netip.Addr.\n\n"+
+						"Given Value: 2001:db8::1\n"+
+						"Error: \"2001:db8::1\" is not an IPv4 address",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueIPv4Address()
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewIPv4AddressAddrValue(t *testing.T) {
+	t.Parallel()
+
+	got := nettypes.NewIPv4AddressAddrValue(netip.MustParseAddr("192.0.2.1"))
+	expected := nettypes.NewIPv4AddressValue("192.0.2.1")
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected value difference: %s", diff)
+	}
+}
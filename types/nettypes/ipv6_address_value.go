@@ -0,0 +1,147 @@
+package nettypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = IPv6Address{}
+	_ basetypes.StringValuableWithSemanticEquals = IPv6Address{}
+)
+
+// IPv6Address represents a valid IPv6 address string. Access the value as a
+// netip.Addr via the ValueIPv6Address method.
+type IPv6Address struct {
+	basetypes.StringValue
+}
+
+// Type returns an IPv6AddressType.
+func (v IPv6Address) Type(_ context.Context) attr.Type {
+	return IPv6AddressType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPv6Address) Equal(o attr.Value) bool {
+	other, ok := o.(IPv6Address)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given IPv6 address string value
+// represents the same address as the current value, regardless of
+// representation, such as IPv6 zero compression.
+func (v IPv6Address) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPv6Address)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorParsed, err := parseIPv6Address(v.ValueString())
+
+	// Invalid values are never semantically equal.
+	if err != nil {
+		return false, diags
+	}
+
+	newParsed, err := parseIPv6Address(newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return priorParsed == newParsed, diags
+}
+
+// ValueIPv6Address returns the IPv6 address string value as a netip.Addr. An error
+// diagnostic is returned if the value is null, unknown, or not a valid
+// IPv6 address.
+func (v IPv6Address) ValueIPv6Address() (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError(
+			"IPv6Address ValueIPv6Address Error",
+			"An IPv6 address string value cannot be converted to netip.Addr, as the value is null.",
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError(
+			"IPv6Address ValueIPv6Address Error",
+			"An IPv6 address string value cannot be converted to netip.Addr, as the value is unknown.",
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	addr, err := parseIPv6Address(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"IPv6Address ValueIPv6Address Error",
+			"An IPv6 address string value cannot be converted to netip.Addr.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	return addr, diags
+}
+
+// NewIPv6AddressNull creates an IPv6Address with a null value. Determine whether the
+// value is null via the IPv6Address type IsNull method.
+func NewIPv6AddressNull() IPv6Address {
+	return IPv6Address{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPv6AddressUnknown creates an IPv6Address with an unknown value. Determine whether
+// the value is unknown via the IPv6Address type IsUnknown method.
+func NewIPv6AddressUnknown() IPv6Address {
+	return IPv6Address{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPv6AddressValue creates an IPv6Address with a known value. Access the value via the
+// IPv6Address type ValueString method. The value is validated when the associated
+// attribute value is validated.
+func NewIPv6AddressValue(value string) IPv6Address {
+	return IPv6Address{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPv6AddressAddrValue creates an IPv6Address with a known value from the given
+// netip.Addr, using its normalized string representation.
+func NewIPv6AddressAddrValue(value netip.Addr) IPv6Address {
+	return IPv6Address{
+		StringValue: basetypes.NewStringValue(value.String()),
+	}
+}
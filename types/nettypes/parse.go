@@ -0,0 +1,47 @@
+package nettypes
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// parseIPv4Address parses the given string as an IPv4 address.
+func parseIPv4Address(value string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(value)
+
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	if !addr.Is4() {
+		return netip.Addr{}, fmt.Errorf("%q is not an IPv4 address", value)
+	}
+
+	return addr, nil
+}
+
+// parseIPv6Address parses the given string as an IPv6 address. IPv4-mapped
+// IPv6 addresses, such as ::ffff:192.0.2.1, are considered IPv6 addresses.
+func parseIPv6Address(value string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(value)
+
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	if !addr.Is6() {
+		return netip.Addr{}, fmt.Errorf("%q is not an IPv6 address", value)
+	}
+
+	return addr, nil
+}
+
+// parseIPAddress parses the given string as an IPv4 or IPv6 address.
+func parseIPAddress(value string) (netip.Addr, error) {
+	return netip.ParseAddr(value)
+}
+
+// parseCIDR parses the given string as an IPv4 or IPv6 CIDR notation prefix.
+func parseCIDR(value string) (netip.Prefix, error) {
+	return netip.ParsePrefix(value)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"math/big"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
@@ -25,3 +26,16 @@ func NumberUnknown() basetypes.NumberValue {
 func NumberValue(value *big.Float) basetypes.NumberValue {
 	return basetypes.NewNumberValue(value)
 }
+
+// NumberInt64Value creates a Number with a known value from the given int64.
+// Access the value via the Number type ValueInt64 method.
+func NumberInt64Value(value int64) basetypes.NumberValue {
+	return basetypes.NewNumberInt64Value(value)
+}
+
+// NumberFloat64Value creates a Number with a known value from the given
+// float64. Access the value via the Number type ValueFloat64 method. An error
+// diagnostic is returned if the value is NaN or infinite.
+func NumberFloat64Value(value float64) (basetypes.NumberValue, diag.Diagnostics) {
+	return basetypes.NewNumberFloat64Value(value)
+}
@@ -28,6 +28,14 @@ func SetValue(elementType attr.Type, elements []attr.Value) (basetypes.SetValue,
 	return basetypes.NewSetValue(elementType, elements)
 }
 
+// SetValueUnique creates a Set with a known value, the same as SetValue,
+// except an error diagnostic is returned if fully known elements are
+// duplicated. Access the value via the Set type Elements or ElementsAs
+// methods.
+func SetValueUnique(elementType attr.Type, elements []attr.Value) (basetypes.SetValue, diag.Diagnostics) {
+	return basetypes.NewSetValueUnique(elementType, elements)
+}
+
 // SetValueFrom creates a Set with a known value, using reflection rules.
 // The elements must be a slice which can convert into the given element type.
 // Access the value via the Set type Elements or ElementsAs methods.
@@ -21,3 +21,9 @@ func StringUnknown() basetypes.StringValue {
 func StringValue(value string) basetypes.StringValue {
 	return basetypes.NewStringValue(value)
 }
+
+// StringPointerValue creates a String with a null value if nil or a known value.
+// Access the value via the String type ValueStringPointer method.
+func StringPointerValue(value *string) basetypes.StringValue {
+	return basetypes.NewStringPointerValue(value)
+}
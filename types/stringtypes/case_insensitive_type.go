@@ -0,0 +1,75 @@
+package stringtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = CaseInsensitiveType{}
+
+// CaseInsensitiveType is an attribute type that represents a string whose
+// letter case is not significant, such as identifiers which are lowercased by
+// the remote system. Semantic equality logic is defined for
+// CaseInsensitiveType such that strings differing only in letter case are
+// considered equal. CaseInsensitive is the associated value type.
+type CaseInsensitiveType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t CaseInsensitiveType) String() string {
+	return "stringtypes.CaseInsensitiveType"
+}
+
+// ValueType returns the Value type.
+func (t CaseInsensitiveType) ValueType(ctx context.Context) attr.Value {
+	return CaseInsensitive{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t CaseInsensitiveType) Equal(o attr.Type) bool {
+	other, ok := o.(CaseInsensitiveType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t CaseInsensitiveType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CaseInsensitive{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t CaseInsensitiveType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
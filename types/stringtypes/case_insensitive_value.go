@@ -0,0 +1,85 @@
+package stringtypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = CaseInsensitive{}
+	_ basetypes.StringValuableWithSemanticEquals = CaseInsensitive{}
+)
+
+// CaseInsensitive represents a string whose letter case is not significant.
+type CaseInsensitive struct {
+	basetypes.StringValue
+}
+
+// Type returns a CaseInsensitiveType.
+func (v CaseInsensitive) Type(_ context.Context) attr.Type {
+	return CaseInsensitiveType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v CaseInsensitive) Equal(o attr.Value) bool {
+	other, ok := o.(CaseInsensitive)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given string value is equal to the
+// current value, ignoring letter case, such as "Example" and "example".
+func (v CaseInsensitive) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CaseInsensitive)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), diags
+}
+
+// NewCaseInsensitiveNull creates a CaseInsensitive with a null value.
+// Determine whether the value is null via the CaseInsensitive type IsNull
+// method.
+func NewCaseInsensitiveNull() CaseInsensitive {
+	return CaseInsensitive{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewCaseInsensitiveUnknown creates a CaseInsensitive with an unknown value.
+// Determine whether the value is unknown via the CaseInsensitive type
+// IsUnknown method.
+func NewCaseInsensitiveUnknown() CaseInsensitive {
+	return CaseInsensitive{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewCaseInsensitiveValue creates a CaseInsensitive with a known value.
+// Access the value via the CaseInsensitive type ValueString method.
+func NewCaseInsensitiveValue(value string) CaseInsensitive {
+	return CaseInsensitive{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
@@ -0,0 +1,70 @@
+package stringtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/stringtypes"
+)
+
+func TestCaseInsensitiveStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current       stringtypes.CaseInsensitive
+		given         basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact-match": {
+			current:       stringtypes.NewCaseInsensitiveValue("Example"),
+			given:         stringtypes.NewCaseInsensitiveValue("Example"),
+			expectedMatch: true,
+		},
+		"different-case": {
+			current:       stringtypes.NewCaseInsensitiveValue("Example"),
+			given:         stringtypes.NewCaseInsensitiveValue("eXAMPLE"),
+			expectedMatch: true,
+		},
+		"different-value": {
+			current:       stringtypes.NewCaseInsensitiveValue("Example"),
+			given:         stringtypes.NewCaseInsensitiveValue("Examples"),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			current:       stringtypes.NewCaseInsensitiveValue("Example"),
+			given:         basetypes.NewStringValue("example"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: stringtypes.CaseInsensitive\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
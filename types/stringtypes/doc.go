@@ -0,0 +1,5 @@
+// Package stringtypes contains custom attribute types and values for string
+// data which requires special comparison logic, such as case-insensitive
+// strings. These types build on the types/basetypes package and can be used
+// with the CustomType field of schema attributes.
+package stringtypes
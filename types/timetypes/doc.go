@@ -0,0 +1,5 @@
+// Package timetypes contains custom attribute types and values for time
+// related data, such as RFC 3339 timestamps and Go durations. These types
+// build on the types/basetypes package and can be used with the CustomType
+// field of schema attributes.
+package timetypes
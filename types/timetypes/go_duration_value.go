@@ -0,0 +1,174 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = GoDuration{}
+	_ basetypes.StringValuableWithSemanticEquals = GoDuration{}
+)
+
+// GoDuration represents a valid Go duration string. Access the value as a
+// time.Duration via the ValueGoDuration method.
+type GoDuration struct {
+	basetypes.StringValue
+}
+
+// Type returns a GoDurationType.
+func (v GoDuration) Type(_ context.Context) attr.Type {
+	return GoDurationType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v GoDuration) Equal(o attr.Value) bool {
+	other, ok := o.(GoDuration)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given Go duration string value
+// represents the same length of time as the current value, such as "90s" and
+// "1m30s", or "1h" and "60m".
+func (v GoDuration) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(GoDuration)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorDuration, err := time.ParseDuration(v.ValueString())
+
+	// Invalid values are never semantically equal.
+	if err != nil {
+		return false, diags
+	}
+
+	newDuration, err := time.ParseDuration(newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return priorDuration == newDuration, diags
+}
+
+// ValueGoDuration creates a new time.Duration instance with the Go duration
+// string value. An error diagnostic is returned if the value is null,
+// unknown, or not a valid Go duration string format.
+func (v GoDuration) ValueGoDuration() (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError(
+			"GoDuration ValueGoDuration Error",
+			"A Go duration string value cannot be converted to time.Duration, as the value is null.",
+		)
+
+		return 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError(
+			"GoDuration ValueGoDuration Error",
+			"A Go duration string value cannot be converted to time.Duration, as the value is unknown.",
+		)
+
+		return 0, diags
+	}
+
+	duration, err := time.ParseDuration(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"GoDuration ValueGoDuration Error",
+			"A Go duration string value cannot be converted to time.Duration.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return 0, diags
+	}
+
+	return duration, diags
+}
+
+// NewGoDurationNull creates a GoDuration with a null value. Determine whether
+// the value is null via the GoDuration type IsNull method.
+func NewGoDurationNull() GoDuration {
+	return GoDuration{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewGoDurationUnknown creates a GoDuration with an unknown value. Determine
+// whether the value is unknown via the GoDuration type IsUnknown method.
+func NewGoDurationUnknown() GoDuration {
+	return GoDuration{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewGoDurationValue creates a GoDuration with a known value or raises an
+// error diagnostic if the string is not a valid Go duration string format.
+func NewGoDurationValue(value string) (GoDuration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := time.ParseDuration(value); err != nil {
+		diags.AddError(
+			"Invalid Go Duration String Value",
+			"A string value was provided that is not a valid Go duration string format.\n\n"+
+				"Given Value: "+value+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return NewGoDurationUnknown(), diags
+	}
+
+	return GoDuration{
+		StringValue: basetypes.NewStringValue(value),
+	}, diags
+}
+
+// NewGoDurationValueMust creates a GoDuration with a known value or panics if
+// the string is not a valid Go duration string format. This creation function
+// is only recommended to create GoDuration values which will not potentially
+// affect practitioners, such as testing, or exhaustively tested provider
+// logic.
+func NewGoDurationValueMust(value string) GoDuration {
+	goDurationValue, diags := NewGoDurationValue(value)
+
+	if diags.HasError() {
+		panic(fmt.Sprintf("NewGoDurationValueMust received error: %v", diags))
+	}
+
+	return goDurationValue
+}
+
+// NewGoDurationTimeDurationValue creates a GoDuration with a known value from
+// the given time.Duration, formatted with the time.Duration String method.
+func NewGoDurationTimeDurationValue(value time.Duration) GoDuration {
+	return GoDuration{
+		StringValue: basetypes.NewStringValue(value.String()),
+	}
+}
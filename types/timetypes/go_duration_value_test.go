@@ -0,0 +1,177 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestNewGoDurationValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         string
+		expected      timetypes.GoDuration
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value:    "1h30m0s",
+			expected: timetypes.NewGoDurationTimeDurationValue(90 * time.Minute),
+		},
+		"invalid": {
+			value:    "not-a-duration",
+			expected: timetypes.NewGoDurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Go Duration String Value",
+					"A string value was provided that is not a valid Go duration string format.\n\n"+
+						"Given Value: not-a-duration\n"+
+						"Error: time: invalid duration \"not-a-duration\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.NewGoDurationValue(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentGoDuration timetypes.GoDuration
+		givenGoDuration   basetypes.StringValuable
+		expectedMatch     bool
+		expectedDiags     diag.Diagnostics
+	}{
+		"exact-match": {
+			currentGoDuration: timetypes.NewGoDurationValueMust("90s"),
+			givenGoDuration:   timetypes.NewGoDurationValueMust("90s"),
+			expectedMatch:     true,
+		},
+		"seconds-minutes": {
+			currentGoDuration: timetypes.NewGoDurationValueMust("90s"),
+			givenGoDuration:   timetypes.NewGoDurationValueMust("1m30s"),
+			expectedMatch:     true,
+		},
+		"hours-minutes": {
+			currentGoDuration: timetypes.NewGoDurationValueMust("1h"),
+			givenGoDuration:   timetypes.NewGoDurationValueMust("60m0s"),
+			expectedMatch:     true,
+		},
+		"different-duration": {
+			currentGoDuration: timetypes.NewGoDurationValueMust("90s"),
+			givenGoDuration:   timetypes.NewGoDurationValueMust("1m"),
+			expectedMatch:     false,
+		},
+		"invalid": {
+			currentGoDuration: timetypes.GoDuration{StringValue: basetypes.NewStringValue("invalid")},
+			givenGoDuration:   timetypes.GoDuration{StringValue: basetypes.NewStringValue("invalid")},
+			expectedMatch:     false,
+		},
+		"wrong-type": {
+			currentGoDuration: timetypes.NewGoDurationValueMust("90s"),
+			givenGoDuration:   basetypes.NewStringValue("90s"),
+			expectedMatch:     false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.GoDuration\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentGoDuration.StringSemanticEquals(context.Background(), testCase.givenGoDuration)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationValueGoDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		goDuration    timetypes.GoDuration
+		expected      time.Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			goDuration: timetypes.NewGoDurationValueMust("1h30m"),
+			expected:   90 * time.Minute,
+		},
+		"null": {
+			goDuration: timetypes.NewGoDurationNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"GoDuration ValueGoDuration Error",
+					"A Go duration string value cannot be converted to time.Duration, as the value is null.",
+				),
+			},
+		},
+		"unknown": {
+			goDuration: timetypes.NewGoDurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"GoDuration ValueGoDuration Error",
+					"A Go duration string value cannot be converted to time.Duration, as the value is unknown.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.goDuration.ValueGoDuration()
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = RFC3339{}
+	_ basetypes.StringValuableWithSemanticEquals = RFC3339{}
+)
+
+// RFC3339 represents a valid RFC 3339 string. Access the value as a
+// time.Time via the ValueRFC3339Time method.
+type RFC3339 struct {
+	basetypes.StringValue
+}
+
+// Type returns an RFC3339Type.
+func (v RFC3339) Type(_ context.Context) attr.Type {
+	return RFC3339Type{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v RFC3339) Equal(o attr.Value) bool {
+	other, ok := o.(RFC3339)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given RFC 3339 string value
+// represents the same instant in time as the current value, such as
+// "2023-07-25T20:43:16+00:00" and "2023-07-25T20:43:16Z", or
+// "2023-07-25T20:43:16-05:00" and "2023-07-26T01:43:16Z".
+func (v RFC3339) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(RFC3339)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorTime, err := time.Parse(time.RFC3339, v.ValueString())
+
+	// Invalid values are never semantically equal.
+	if err != nil {
+		return false, diags
+	}
+
+	newTime, err := time.Parse(time.RFC3339, newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return priorTime.Equal(newTime), diags
+}
+
+// ValueRFC3339Time creates a new time.Time instance with the RFC 3339 string
+// value. An error diagnostic is returned if the value is null, unknown, or
+// not valid RFC 3339 format.
+func (v RFC3339) ValueRFC3339Time() (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError(
+			"RFC3339 ValueRFC3339Time Error",
+			"An RFC 3339 string value cannot be converted to time.Time, as the value is null.",
+		)
+
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError(
+			"RFC3339 ValueRFC3339Time Error",
+			"An RFC 3339 string value cannot be converted to time.Time, as the value is unknown.",
+		)
+
+		return time.Time{}, diags
+	}
+
+	rfc3339Time, err := time.Parse(time.RFC3339, v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"RFC3339 ValueRFC3339Time Error",
+			"An RFC 3339 string value cannot be converted to time.Time.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return time.Time{}, diags
+	}
+
+	return rfc3339Time, diags
+}
+
+// NewRFC3339Null creates an RFC3339 with a null value. Determine whether the
+// value is null via the RFC3339 type IsNull method.
+func NewRFC3339Null() RFC3339 {
+	return RFC3339{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewRFC3339Unknown creates an RFC3339 with an unknown value. Determine whether
+// the value is unknown via the RFC3339 type IsUnknown method.
+func NewRFC3339Unknown() RFC3339 {
+	return RFC3339{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewRFC3339Value creates an RFC3339 with a known value or raises an error
+// diagnostic if the string is not RFC 3339 format.
+func NewRFC3339Value(value string) (RFC3339, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		diags.AddError(
+			"Invalid RFC 3339 String Value",
+			"A string value was provided that is not valid RFC 3339 string format.\n\n"+
+				"Given Value: "+value+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return NewRFC3339Unknown(), diags
+	}
+
+	return RFC3339{
+		StringValue: basetypes.NewStringValue(value),
+	}, diags
+}
+
+// NewRFC3339ValueMust creates an RFC3339 with a known value or panics if the
+// string is not RFC 3339 format. This creation function is only recommended
+// to create RFC3339 values which will not potentially affect practitioners,
+// such as testing, or exhaustively tested provider logic.
+func NewRFC3339ValueMust(value string) RFC3339 {
+	rfc3339Value, diags := NewRFC3339Value(value)
+
+	if diags.HasError() {
+		panic(fmt.Sprintf("NewRFC3339ValueMust received error: %v", diags))
+	}
+
+	return rfc3339Value
+}
+
+// NewRFC3339TimeValue creates an RFC3339 with a known value from the given
+// time.Time, formatted with time.RFC3339.
+func NewRFC3339TimeValue(value time.Time) RFC3339 {
+	return RFC3339{
+		StringValue: basetypes.NewStringValue(value.Format(time.RFC3339)),
+	}
+}
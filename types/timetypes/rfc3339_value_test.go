@@ -0,0 +1,182 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestNewRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         string
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value:    "2023-07-25T20:43:16Z",
+			expected: timetypes.NewRFC3339TimeValue(time.Date(2023, time.July, 25, 20, 43, 16, 0, time.UTC)),
+		},
+		"invalid": {
+			value:    "not-a-timestamp",
+			expected: timetypes.NewRFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid RFC 3339 String Value",
+					"A string value was provided that is not valid RFC 3339 string format.\n\n"+
+						"Given Value: not-a-timestamp\n"+
+						"Error: parsing time \"not-a-timestamp\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"not-a-timestamp\" as \"2006\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.NewRFC3339Value(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentRFC3339 timetypes.RFC3339
+		givenRFC3339   basetypes.StringValuable
+		expectedMatch  bool
+		expectedDiags  diag.Diagnostics
+	}{
+		"exact-match": {
+			currentRFC3339: timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16Z"),
+			givenRFC3339:   timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16Z"),
+			expectedMatch:  true,
+		},
+		"zero-offset": {
+			currentRFC3339: timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16Z"),
+			givenRFC3339:   timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16+00:00"),
+			expectedMatch:  true,
+		},
+		"different-offsets": {
+			currentRFC3339: timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16-05:00"),
+			givenRFC3339:   timetypes.NewRFC3339ValueMust("2023-07-26T01:43:16Z"),
+			expectedMatch:  true,
+		},
+		"different-instant": {
+			currentRFC3339: timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16-05:00"),
+			givenRFC3339:   timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16Z"),
+			expectedMatch:  false,
+		},
+		"invalid": {
+			currentRFC3339: timetypes.RFC3339{StringValue: basetypes.NewStringValue("invalid")},
+			givenRFC3339:   timetypes.RFC3339{StringValue: basetypes.NewStringValue("invalid")},
+			expectedMatch:  false,
+		},
+		"invalid-given": {
+			currentRFC3339: timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16Z"),
+			givenRFC3339:   timetypes.RFC3339{StringValue: basetypes.NewStringValue("invalid")},
+			expectedMatch:  false,
+		},
+		"wrong-type": {
+			currentRFC3339: timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16Z"),
+			givenRFC3339:   basetypes.NewStringValue("2023-07-25T20:43:16Z"),
+			expectedMatch:  false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.RFC3339\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentRFC3339.StringSemanticEquals(context.Background(), testCase.givenRFC3339)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339ValueRFC3339Time(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rfc3339       timetypes.RFC3339
+		expectedTime  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			rfc3339:      timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16-05:00"),
+			expectedTime: time.Date(2023, time.July, 25, 20, 43, 16, 0, time.FixedZone("", -5*60*60)),
+		},
+		"null": {
+			rfc3339: timetypes.NewRFC3339Null(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC3339 ValueRFC3339Time Error",
+					"An RFC 3339 string value cannot be converted to time.Time, as the value is null.",
+				),
+			},
+		},
+		"unknown": {
+			rfc3339: timetypes.NewRFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC3339 ValueRFC3339Time Error",
+					"An RFC 3339 string value cannot be converted to time.Time, as the value is unknown.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.rfc3339.ValueRFC3339Time()
+
+			if !got.Equal(testCase.expectedTime) {
+				t.Errorf("expected time %s, got %s", testCase.expectedTime, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Package unittypes contains custom attribute types and values for numbers
+// which are measured in a unit, such as seconds or mebibytes. These types
+// build on the types/basetypes package and can be used with the CustomType
+// field of schema attributes. Value accessors convert the value into a
+// requested unit, which helps prevent unit mismatches between configuration
+// and remote APIs.
+package unittypes
@@ -0,0 +1,86 @@
+package unittypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.Float64Typable = Float64Type{}
+)
+
+// Float64Type is an attribute type that represents a float64 value measured in
+// the given Unit, such as a number of seconds. Float64 is the associated value
+// type, which can convert the value to other units of the same Dimension.
+type Float64Type struct {
+	basetypes.Float64Type
+
+	// Unit is the unit of measurement for values in configuration, plan, and
+	// state data.
+	Unit Unit
+}
+
+// String returns a human readable string of the type name.
+func (t Float64Type) String() string {
+	return fmt.Sprintf("unittypes.Float64Type[%s]", t.Unit.Symbol)
+}
+
+// ValueType returns the Value type.
+func (t Float64Type) ValueType(ctx context.Context) attr.Value {
+	return Float64{
+		Unit: t.Unit,
+	}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t Float64Type) Equal(o attr.Type) bool {
+	other, ok := o.(Float64Type)
+
+	if !ok {
+		return false
+	}
+
+	if t.Unit != other.Unit {
+		return false
+	}
+
+	return t.Float64Type.Equal(other.Float64Type)
+}
+
+// ValueFromFloat64 returns a Float64Valuable type given a Float64Value.
+func (t Float64Type) ValueFromFloat64(ctx context.Context, in basetypes.Float64Value) (basetypes.Float64Valuable, diag.Diagnostics) {
+	return Float64{
+		Float64Value: in,
+		Unit:         t.Unit,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t Float64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.Float64Type.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	float64Value, ok := attrValue.(basetypes.Float64Value)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	float64Valuable, diags := t.ValueFromFloat64(ctx, float64Value)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting Float64Value to Float64Valuable: %v", diags)
+	}
+
+	return float64Valuable, nil
+}
@@ -0,0 +1,104 @@
+package unittypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/unittypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFloat64TypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		other    attr.Type
+		expected bool
+	}{
+		"same-unit": {
+			other:    unittypes.Float64Type{Unit: unittypes.Seconds},
+			expected: true,
+		},
+		"different-unit": {
+			other:    unittypes.Float64Type{Unit: unittypes.Milliseconds},
+			expected: false,
+		},
+		"different-type": {
+			other:    unittypes.Int64Type{Unit: unittypes.Seconds},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := unittypes.Float64Type{Unit: unittypes.Seconds}.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64TypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}{
+		"value": {
+			in:          tftypes.NewValue(tftypes.Number, 1.5),
+			expectation: unittypes.NewFloat64Value(1.5, unittypes.Seconds),
+		},
+		"unknown": {
+			in:          tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expectation: unittypes.NewFloat64Unknown(unittypes.Seconds),
+		},
+		"null": {
+			in:          tftypes.NewValue(tftypes.Number, nil),
+			expectation: unittypes.NewFloat64Null(unittypes.Seconds),
+		},
+		"wrongType": {
+			in:          tftypes.NewValue(tftypes.String, "oops"),
+			expectedErr: "can't unmarshal tftypes.String into *big.Float, expected *big.Float",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := unittypes.Float64Type{Unit: unittypes.Seconds}.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectation); diff != "" {
+				t.Errorf("Unexpected response (+got, -expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package unittypes
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.Float64Valuable = Float64{}
+)
+
+// Float64 represents a float64 value measured in a Unit. Access the value in
+// another unit of the same Dimension via the ValueFloat64In method.
+type Float64 struct {
+	basetypes.Float64Value
+
+	// Unit is the unit of measurement for the value.
+	Unit Unit
+}
+
+// Type returns a Float64Type with the same Unit.
+func (v Float64) Type(_ context.Context) attr.Type {
+	return Float64Type{
+		Unit: v.Unit,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Float64) Equal(o attr.Value) bool {
+	other, ok := o.(Float64)
+
+	if !ok {
+		return false
+	}
+
+	if v.Unit != other.Unit {
+		return false
+	}
+
+	return v.Float64Value.Equal(other.Float64Value)
+}
+
+// ValueFloat64In returns the known value converted to the target unit. An
+// error diagnostic is returned if the value is null, unknown, or cannot be
+// converted to the target unit, such as a different Dimension.
+func (v Float64) ValueFloat64In(target Unit) (float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError(
+			"Float64 ValueFloat64In Error",
+			"A Float64 value cannot be converted to "+target.Name+", as the value is null.",
+		)
+
+		return 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError(
+			"Float64 ValueFloat64In Error",
+			"A Float64 value cannot be converted to "+target.Name+", as the value is unknown.",
+		)
+
+		return 0, diags
+	}
+
+	converted, err := v.Unit.ConvertFloat64(v.ValueFloat64(), target)
+
+	if err != nil {
+		diags.AddError(
+			"Float64 ValueFloat64In Error",
+			"A Float64 value cannot be converted to "+target.Name+".\n\n"+
+				"Given Value: "+strconv.FormatFloat(v.ValueFloat64(), 'g', -1, 64)+" "+v.Unit.Symbol+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return 0, diags
+	}
+
+	return converted, diags
+}
+
+// NewFloat64Null creates a Float64 with a null value measured in the given unit.
+// Determine whether the value is null via the Float64 type IsNull method.
+func NewFloat64Null(unit Unit) Float64 {
+	return Float64{
+		Float64Value: basetypes.NewFloat64Null(),
+		Unit:         unit,
+	}
+}
+
+// NewFloat64Unknown creates a Float64 with an unknown value measured in the
+// given unit. Determine whether the value is unknown via the Float64 type
+// IsUnknown method.
+func NewFloat64Unknown(unit Unit) Float64 {
+	return Float64{
+		Float64Value: basetypes.NewFloat64Unknown(),
+		Unit:         unit,
+	}
+}
+
+// NewFloat64Value creates a Float64 with a known value measured in the given
+// unit. Access the value via the Float64 type ValueFloat64 or ValueFloat64In
+// methods.
+func NewFloat64Value(value float64, unit Unit) Float64 {
+	return Float64{
+		Float64Value: basetypes.NewFloat64Value(value),
+		Unit:         unit,
+	}
+}
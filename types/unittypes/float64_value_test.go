@@ -0,0 +1,76 @@
+package unittypes_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/unittypes"
+)
+
+func TestFloat64ValueFloat64In(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         unittypes.Float64
+		target        unittypes.Unit
+		expected      float64
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			value:    unittypes.NewFloat64Value(90, unittypes.Seconds),
+			target:   unittypes.Minutes,
+			expected: 1.5,
+		},
+		"null": {
+			value:  unittypes.NewFloat64Null(unittypes.Seconds),
+			target: unittypes.Minutes,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Float64 ValueFloat64In Error",
+					"A Float64 value cannot be converted to minutes, as the value is null.",
+				),
+			},
+		},
+		"unknown": {
+			value:  unittypes.NewFloat64Unknown(unittypes.Seconds),
+			target: unittypes.Minutes,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Float64 ValueFloat64In Error",
+					"A Float64 value cannot be converted to minutes, as the value is unknown.",
+				),
+			},
+		},
+		"different-dimension": {
+			value:  unittypes.NewFloat64Value(1.5, unittypes.Seconds),
+			target: unittypes.Bytes,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Float64 ValueFloat64In Error",
+					"A Float64 value cannot be converted to bytes.\n\n"+
+						"Given Value: 1.5 s\n"+
+						"Error: cannot convert seconds, which measures duration, to bytes, which measures data size",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueFloat64In(testCase.target)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package unittypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.Int64Typable = Int64Type{}
+)
+
+// Int64Type is an attribute type that represents an int64 value measured in
+// the given Unit, such as a number of seconds. Int64 is the associated value
+// type, which can convert the value to other units of the same Dimension.
+type Int64Type struct {
+	basetypes.Int64Type
+
+	// Unit is the unit of measurement for values in configuration, plan, and
+	// state data.
+	Unit Unit
+}
+
+// String returns a human readable string of the type name.
+func (t Int64Type) String() string {
+	return fmt.Sprintf("unittypes.Int64Type[%s]", t.Unit.Symbol)
+}
+
+// ValueType returns the Value type.
+func (t Int64Type) ValueType(ctx context.Context) attr.Value {
+	return Int64{
+		Unit: t.Unit,
+	}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t Int64Type) Equal(o attr.Type) bool {
+	other, ok := o.(Int64Type)
+
+	if !ok {
+		return false
+	}
+
+	if t.Unit != other.Unit {
+		return false
+	}
+
+	return t.Int64Type.Equal(other.Int64Type)
+}
+
+// ValueFromInt64 returns an Int64Valuable type given an Int64Value.
+func (t Int64Type) ValueFromInt64(ctx context.Context, in basetypes.Int64Value) (basetypes.Int64Valuable, diag.Diagnostics) {
+	return Int64{
+		Int64Value: in,
+		Unit:       t.Unit,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t Int64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.Int64Type.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	int64Value, ok := attrValue.(basetypes.Int64Value)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	int64Valuable, diags := t.ValueFromInt64(ctx, int64Value)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting Int64Value to Int64Valuable: %v", diags)
+	}
+
+	return int64Valuable, nil
+}
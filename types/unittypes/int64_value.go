@@ -0,0 +1,115 @@
+package unittypes
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.Int64Valuable = Int64{}
+)
+
+// Int64 represents an int64 value measured in a Unit. Access the value in
+// another unit of the same Dimension via the ValueInt64In method.
+type Int64 struct {
+	basetypes.Int64Value
+
+	// Unit is the unit of measurement for the value.
+	Unit Unit
+}
+
+// Type returns an Int64Type with the same Unit.
+func (v Int64) Type(_ context.Context) attr.Type {
+	return Int64Type{
+		Unit: v.Unit,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Int64) Equal(o attr.Value) bool {
+	other, ok := o.(Int64)
+
+	if !ok {
+		return false
+	}
+
+	if v.Unit != other.Unit {
+		return false
+	}
+
+	return v.Int64Value.Equal(other.Int64Value)
+}
+
+// ValueInt64In returns the known value converted to the target unit. An
+// error diagnostic is returned if the value is null, unknown, or cannot be
+// converted to the target unit, such as a different Dimension or a result
+// which is not a whole number.
+func (v Int64) ValueInt64In(target Unit) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError(
+			"Int64 ValueInt64In Error",
+			"An Int64 value cannot be converted to "+target.Name+", as the value is null.",
+		)
+
+		return 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError(
+			"Int64 ValueInt64In Error",
+			"An Int64 value cannot be converted to "+target.Name+", as the value is unknown.",
+		)
+
+		return 0, diags
+	}
+
+	converted, err := v.Unit.ConvertInt64(v.ValueInt64(), target)
+
+	if err != nil {
+		diags.AddError(
+			"Int64 ValueInt64In Error",
+			"An Int64 value cannot be converted to "+target.Name+".\n\n"+
+				"Given Value: "+strconv.FormatInt(v.ValueInt64(), 10)+" "+v.Unit.Symbol+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return 0, diags
+	}
+
+	return converted, diags
+}
+
+// NewInt64Null creates an Int64 with a null value measured in the given unit.
+// Determine whether the value is null via the Int64 type IsNull method.
+func NewInt64Null(unit Unit) Int64 {
+	return Int64{
+		Int64Value: basetypes.NewInt64Null(),
+		Unit:       unit,
+	}
+}
+
+// NewInt64Unknown creates an Int64 with an unknown value measured in the
+// given unit. Determine whether the value is unknown via the Int64 type
+// IsUnknown method.
+func NewInt64Unknown(unit Unit) Int64 {
+	return Int64{
+		Int64Value: basetypes.NewInt64Unknown(),
+		Unit:       unit,
+	}
+}
+
+// NewInt64Value creates an Int64 with a known value measured in the given
+// unit. Access the value via the Int64 type ValueInt64 or ValueInt64In
+// methods.
+func NewInt64Value(value int64, unit Unit) Int64 {
+	return Int64{
+		Int64Value: basetypes.NewInt64Value(value),
+		Unit:       unit,
+	}
+}
@@ -0,0 +1,76 @@
+package unittypes_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/unittypes"
+)
+
+func TestInt64ValueInt64In(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         unittypes.Int64
+		target        unittypes.Unit
+		expected      int64
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			value:    unittypes.NewInt64Value(2, unittypes.Minutes),
+			target:   unittypes.Seconds,
+			expected: 120,
+		},
+		"null": {
+			value:  unittypes.NewInt64Null(unittypes.Minutes),
+			target: unittypes.Seconds,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Int64 ValueInt64In Error",
+					"An Int64 value cannot be converted to seconds, as the value is null.",
+				),
+			},
+		},
+		"unknown": {
+			value:  unittypes.NewInt64Unknown(unittypes.Minutes),
+			target: unittypes.Seconds,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Int64 ValueInt64In Error",
+					"An Int64 value cannot be converted to seconds, as the value is unknown.",
+				),
+			},
+		},
+		"not-whole-number": {
+			value:  unittypes.NewInt64Value(90, unittypes.Seconds),
+			target: unittypes.Minutes,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Int64 ValueInt64In Error",
+					"An Int64 value cannot be converted to minutes.\n\n"+
+						"Given Value: 90 s\n"+
+						"Error: 90 seconds is not a whole number of minutes",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueInt64In(testCase.target)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package unittypes
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Dimension is the physical quantity a Unit measures. Values can only be
+// converted between units of the same Dimension.
+type Dimension string
+
+const (
+	// DimensionDataSize is the Dimension of digital information sizes, such
+	// as bytes or mebibytes. The canonical unit is Bytes.
+	DimensionDataSize Dimension = "data size"
+
+	// DimensionDuration is the Dimension of elapsed time, such as seconds or
+	// hours. The canonical unit is Nanoseconds.
+	DimensionDuration Dimension = "duration"
+)
+
+// Unit describes a unit of measurement for a numeric attribute value.
+//
+// Units are comparable and are typically one of the predefined package
+// variables, however providers can declare their own Unit by setting all
+// fields.
+type Unit struct {
+	// Name is the plural, human readable name of the unit, such as
+	// "seconds". It is used in descriptions and diagnostics.
+	Name string
+
+	// Symbol is the abbreviated name of the unit, such as "s" or "MiB".
+	Symbol string
+
+	// Dimension is the physical quantity measured by the unit.
+	Dimension Dimension
+
+	// Factor is the number of canonical units of the Dimension in one of this
+	// unit, such as 1000000000 for seconds. It must be greater than zero.
+	Factor int64
+}
+
+// Predefined DimensionDuration units.
+var (
+	Nanoseconds  = Unit{Name: "nanoseconds", Symbol: "ns", Dimension: DimensionDuration, Factor: 1}
+	Microseconds = Unit{Name: "microseconds", Symbol: "us", Dimension: DimensionDuration, Factor: 1_000}
+	Milliseconds = Unit{Name: "milliseconds", Symbol: "ms", Dimension: DimensionDuration, Factor: 1_000_000}
+	Seconds      = Unit{Name: "seconds", Symbol: "s", Dimension: DimensionDuration, Factor: 1_000_000_000}
+	Minutes      = Unit{Name: "minutes", Symbol: "m", Dimension: DimensionDuration, Factor: 60 * 1_000_000_000}
+	Hours        = Unit{Name: "hours", Symbol: "h", Dimension: DimensionDuration, Factor: 60 * 60 * 1_000_000_000}
+	Days         = Unit{Name: "days", Symbol: "d", Dimension: DimensionDuration, Factor: 24 * 60 * 60 * 1_000_000_000}
+)
+
+// Predefined DimensionDataSize units.
+var (
+	Bytes     = Unit{Name: "bytes", Symbol: "B", Dimension: DimensionDataSize, Factor: 1}
+	Kilobytes = Unit{Name: "kilobytes", Symbol: "kB", Dimension: DimensionDataSize, Factor: 1_000}
+	Megabytes = Unit{Name: "megabytes", Symbol: "MB", Dimension: DimensionDataSize, Factor: 1_000_000}
+	Gigabytes = Unit{Name: "gigabytes", Symbol: "GB", Dimension: DimensionDataSize, Factor: 1_000_000_000}
+	Terabytes = Unit{Name: "terabytes", Symbol: "TB", Dimension: DimensionDataSize, Factor: 1_000_000_000_000}
+	Kibibytes = Unit{Name: "kibibytes", Symbol: "KiB", Dimension: DimensionDataSize, Factor: 1 << 10}
+	Mebibytes = Unit{Name: "mebibytes", Symbol: "MiB", Dimension: DimensionDataSize, Factor: 1 << 20}
+	Gibibytes = Unit{Name: "gibibytes", Symbol: "GiB", Dimension: DimensionDataSize, Factor: 1 << 30}
+	Tebibytes = Unit{Name: "tebibytes", Symbol: "TiB", Dimension: DimensionDataSize, Factor: 1 << 40}
+)
+
+// String returns a human readable representation of the unit, such as
+// "seconds (s)".
+func (u Unit) String() string {
+	return fmt.Sprintf("%s (%s)", u.Name, u.Symbol)
+}
+
+// ConvertInt64 converts the given value in this unit to the target unit. An
+// error is returned if the units have a different Dimension, if the
+// converted value is not a whole number, or if the converted value overflows
+// int64.
+func (u Unit) ConvertInt64(value int64, target Unit) (int64, error) {
+	if err := u.validateConversion(target); err != nil {
+		return 0, err
+	}
+
+	canonical := new(big.Int).Mul(big.NewInt(value), big.NewInt(u.Factor))
+	converted, remainder := new(big.Int).QuoRem(canonical, big.NewInt(target.Factor), new(big.Int))
+
+	if remainder.Sign() != 0 {
+		return 0, fmt.Errorf("%d %s is not a whole number of %s", value, u.Name, target.Name)
+	}
+
+	if !converted.IsInt64() {
+		return 0, fmt.Errorf("%d %s converted to %s overflows int64", value, u.Name, target.Name)
+	}
+
+	return converted.Int64(), nil
+}
+
+// ConvertFloat64 converts the given value in this unit to the target unit. An
+// error is returned if the units have a different Dimension or if the
+// converted value overflows float64.
+func (u Unit) ConvertFloat64(value float64, target Unit) (float64, error) {
+	if err := u.validateConversion(target); err != nil {
+		return 0, err
+	}
+
+	if u == target {
+		return value, nil
+	}
+
+	converted := value * float64(u.Factor) / float64(target.Factor)
+
+	if math.IsInf(converted, 0) {
+		return 0, fmt.Errorf("%g %s converted to %s overflows float64", value, u.Name, target.Name)
+	}
+
+	return converted, nil
+}
+
+// validateConversion returns an error if values cannot be converted between
+// the units.
+func (u Unit) validateConversion(target Unit) error {
+	if u.Factor <= 0 {
+		return fmt.Errorf("unit %q has invalid factor %d", u.Name, u.Factor)
+	}
+
+	if target.Factor <= 0 {
+		return fmt.Errorf("unit %q has invalid factor %d", target.Name, target.Factor)
+	}
+
+	if u.Dimension != target.Dimension {
+		return fmt.Errorf("cannot convert %s, which measures %s, to %s, which measures %s", u.Name, u.Dimension, target.Name, target.Dimension)
+	}
+
+	return nil
+}
+
+// Description returns the given attribute description with a sentence
+// describing the unit appended, such as "Request timeout. Value is in
+// seconds (s).". This is intended for use in the schema attribute
+// Description and MarkdownDescription fields, so practitioners know which
+// unit to use in configuration.
+func Description(description string, unit Unit) string {
+	unitDescription := "Value is in " + unit.String() + "."
+
+	if description == "" {
+		return unitDescription
+	}
+
+	return description + " " + unitDescription
+}
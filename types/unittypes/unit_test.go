@@ -0,0 +1,208 @@
+package unittypes_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types/unittypes"
+)
+
+func TestUnitConvertInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		unit        unittypes.Unit
+		value       int64
+		target      unittypes.Unit
+		expected    int64
+		expectedErr string
+	}{
+		"same-unit": {
+			unit:     unittypes.Seconds,
+			value:    30,
+			target:   unittypes.Seconds,
+			expected: 30,
+		},
+		"larger-to-smaller": {
+			unit:     unittypes.Minutes,
+			value:    2,
+			target:   unittypes.Seconds,
+			expected: 120,
+		},
+		"smaller-to-larger": {
+			unit:     unittypes.Kibibytes,
+			value:    2048,
+			target:   unittypes.Mebibytes,
+			expected: 2,
+		},
+		"negative": {
+			unit:     unittypes.Hours,
+			value:    -1,
+			target:   unittypes.Minutes,
+			expected: -60,
+		},
+		"not-whole-number": {
+			unit:        unittypes.Seconds,
+			value:       90,
+			target:      unittypes.Minutes,
+			expectedErr: "90 seconds is not a whole number of minutes",
+		},
+		"overflow": {
+			unit:        unittypes.Days,
+			value:       math.MaxInt64,
+			target:      unittypes.Nanoseconds,
+			expectedErr: "9223372036854775807 days converted to nanoseconds overflows int64",
+		},
+		"different-dimension": {
+			unit:        unittypes.Seconds,
+			value:       1,
+			target:      unittypes.Bytes,
+			expectedErr: "cannot convert seconds, which measures duration, to bytes, which measures data size",
+		},
+		"invalid-factor": {
+			unit:        unittypes.Unit{Name: "invalid", Dimension: unittypes.DimensionDuration},
+			value:       1,
+			target:      unittypes.Seconds,
+			expectedErr: "unit \"invalid\" has invalid factor 0",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.unit.ConvertInt64(testCase.value, testCase.target)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnitConvertFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		unit        unittypes.Unit
+		value       float64
+		target      unittypes.Unit
+		expected    float64
+		expectedErr string
+	}{
+		"same-unit": {
+			unit:     unittypes.Seconds,
+			value:    1.5,
+			target:   unittypes.Seconds,
+			expected: 1.5,
+		},
+		"larger-to-smaller": {
+			unit:     unittypes.Minutes,
+			value:    1.5,
+			target:   unittypes.Seconds,
+			expected: 90,
+		},
+		"smaller-to-larger": {
+			unit:     unittypes.Seconds,
+			value:    90,
+			target:   unittypes.Minutes,
+			expected: 1.5,
+		},
+		"overflow": {
+			unit:        unittypes.Days,
+			value:       math.MaxFloat64,
+			target:      unittypes.Nanoseconds,
+			expectedErr: "1.7976931348623157e+308 days converted to nanoseconds overflows float64",
+		},
+		"different-dimension": {
+			unit:        unittypes.Mebibytes,
+			value:       1,
+			target:      unittypes.Hours,
+			expectedErr: "cannot convert mebibytes, which measures data size, to hours, which measures duration",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.unit.ConvertFloat64(testCase.value, testCase.target)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		description string
+		unit        unittypes.Unit
+		expected    string
+	}{
+		"empty": {
+			description: "",
+			unit:        unittypes.Seconds,
+			expected:    "Value is in seconds (s).",
+		},
+		"description": {
+			description: "Size of the disk.",
+			unit:        unittypes.Gibibytes,
+			expected:    "Size of the disk. Value is in gibibytes (GiB).",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := unittypes.Description(testCase.description, testCase.unit)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+// Package uuidtypes contains custom attribute types and values for
+// universally unique identifier (UUID) strings. These types build on the
+// types/basetypes package and can be used with the CustomType field of schema
+// attributes.
+package uuidtypes
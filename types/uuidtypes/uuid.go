@@ -0,0 +1,21 @@
+package uuidtypes
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// uuidRegex matches the RFC 4122 string representation of a UUID, which is
+// 32 hexadecimal digits displayed in five groups separated by hyphens, in the
+// form 8-4-4-4-12. Both lowercase and uppercase digits are accepted.
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// validateUUID returns an error if the given string is not a valid RFC 4122
+// UUID string representation.
+func validateUUID(value string) error {
+	if !uuidRegex.MatchString(value) {
+		return fmt.Errorf("%q does not match RFC 4122 format xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", value)
+	}
+
+	return nil
+}
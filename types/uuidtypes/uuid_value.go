@@ -0,0 +1,89 @@
+package uuidtypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = UUID{}
+	_ basetypes.StringValuableWithSemanticEquals = UUID{}
+)
+
+// UUID represents a valid RFC 4122 UUID string.
+type UUID struct {
+	basetypes.StringValue
+}
+
+// Type returns an UUIDType.
+func (v UUID) Type(_ context.Context) attr.Type {
+	return UUIDType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v UUID) Equal(o attr.Value) bool {
+	other, ok := o.(UUID)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given UUID string value
+// represents the same UUID as the current value, ignoring letter case.
+func (v UUID) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUID)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Invalid values are never semantically equal.
+	if validateUUID(v.ValueString()) != nil || validateUUID(newValue.ValueString()) != nil {
+		return false, diags
+	}
+
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), diags
+}
+
+// NewUUIDNull creates an UUID with a null value. Determine whether the
+// value is null via the UUID type IsNull method.
+func NewUUIDNull() UUID {
+	return UUID{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewUUIDUnknown creates an UUID with an unknown value. Determine whether
+// the value is unknown via the UUID type IsUnknown method.
+func NewUUIDUnknown() UUID {
+	return UUID{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewUUIDValue creates an UUID with a known value. Access the value via the
+// UUID type ValueString method. The value is validated when the associated
+// attribute value is validated.
+func NewUUIDValue(value string) UUID {
+	return UUID{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
@@ -0,0 +1,75 @@
+package uuidtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/uuidtypes"
+)
+
+func TestUUIDStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current       uuidtypes.UUID
+		given         basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact-match": {
+			current:       uuidtypes.NewUUIDValue("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			given:         uuidtypes.NewUUIDValue("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			expectedMatch: true,
+		},
+		"different-case": {
+			current:       uuidtypes.NewUUIDValue("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			given:         uuidtypes.NewUUIDValue("6BA7B810-9DAD-11D1-80B4-00C04FD430C8"),
+			expectedMatch: true,
+		},
+		"not-equal": {
+			current:       uuidtypes.NewUUIDValue("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			given:         uuidtypes.NewUUIDValue("6ba7b811-9dad-11d1-80b4-00c04fd430c8"),
+			expectedMatch: false,
+		},
+		"invalid": {
+			current:       uuidtypes.NewUUIDValue("not-a-uuid"),
+			given:         uuidtypes.NewUUIDValue("NOT-A-UUID"),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			current:       uuidtypes.NewUUIDValue("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			given:         basetypes.NewStringValue("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: uuidtypes.UUID\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Package x is the namespace for experimental framework functionality, such
+// as new subsystems which depend on Terraform or protocol features that are
+// not yet considered stable. Providers can adopt this functionality before it
+// is finalized, in exchange for accepting the compatibility policy below.
+//
+// Each experimental subsystem is a separate package underneath this one, for
+// example x/action or x/list, with its own provider-facing interfaces,
+// request types, and response types. Implementing those interfaces is the
+// explicit opt-in for a provider, and the framework server only enables an
+// experimental subsystem for providers which do so.
+//
+// # Compatibility Policy
+//
+// Packages underneath x are not covered by the semantic versioning promises
+// of this Go module. Their exported identifiers and behaviors may change or be
+// removed in any minor release and such changes are noted in the CHANGELOG.
+//
+// Every Go file in a package underneath x must include the following build
+// constraint, which is verified by the tests of this package:
+//
+//	//go:build framework_experimental
+//
+// Compiling a provider which imports an experimental package therefore
+// requires the framework_experimental build tag, for example:
+//
+//	go build -tags framework_experimental
+//
+// Without the build tag, the Go toolchain reports that build constraints
+// exclude all Go files of the experimental package. This prevents providers
+// from depending on experimental functionality by accident, such as through
+// an editor auto-import.
+//
+// Once an experimental subsystem is considered stable, it is moved into a
+// regular package of this Go module. The experimental package is then
+// deprecated, with type aliases to the stable package where possible, and is
+// removed in a following minor release.
+package x
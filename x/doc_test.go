@@ -0,0 +1,83 @@
+package x_test
+
+import (
+	"go/build/constraint"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// experimentalBuildTag is the build tag required by all experimental packages.
+const experimentalBuildTag = "framework_experimental"
+
+// TestBuildConstraints verifies the compatibility policy: every Go file in a
+// package underneath x requires the experimental build tag.
+func TestBuildConstraints(t *testing.T) {
+	t.Parallel()
+
+	err := filepath.WalkDir(".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || filepath.Dir(path) == "." || filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ParseComments|parser.PackageClauseOnly)
+
+		if err != nil {
+			return err
+		}
+
+		for _, commentGroup := range file.Comments {
+			if commentGroup.Pos() > file.Package {
+				break
+			}
+
+			for _, comment := range commentGroup.List {
+				if !constraint.IsGoBuild(comment.Text) {
+					continue
+				}
+
+				expr, err := constraint.Parse(comment.Text)
+
+				if err != nil {
+					return err
+				}
+
+				// The file must be excluded without the experimental build tag.
+				if !expr.Eval(func(tag string) bool { return tag != experimentalBuildTag }) {
+					return nil
+				}
+			}
+		}
+
+		t.Errorf("%s: missing %q build constraint", path, "//go:build "+experimentalBuildTag)
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+// TestBuildConstraintsPolicy verifies the build constraint documented in the
+// package documentation matches the tag enforced by TestBuildConstraints.
+func TestBuildConstraintsPolicy(t *testing.T) {
+	t.Parallel()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "doc.go", nil, parser.ParseComments|parser.PackageClauseOnly)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.Contains(file.Doc.Text(), "//go:build "+experimentalBuildTag) {
+		t.Errorf("expected package documentation to include %q build constraint", "//go:build "+experimentalBuildTag)
+	}
+}